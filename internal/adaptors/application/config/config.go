@@ -5,6 +5,7 @@ package config
 import (
 	"runtime/debug"
 	"strings"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/inputs/flags"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -29,6 +30,22 @@ type Config struct {
 	watchdogMode                     bool
 	serverInstanceID                 string
 	initializeMATLABOnStartup        bool
+	matlabSessionPerClient           bool
+	transport                        entities.TransportMode
+	listenAddress                    string
+	authToken                        string
+	allowedOrigins                   []string
+	allowedFolders                   []string
+	warmPools                        []entities.WarmPoolSize
+	sessionIdleTimeout               time.Duration
+	persistentSessions               bool
+	maxSessionQueue                  int
+	displayMode                      entities.DisplayMode
+	startupScripts                   []string
+	matlabFlags                      []string
+	matlabEnvironment                []string
+	sourceVMCSettings                bool
+	skipVMCCompatibilityCheck        bool
 }
 
 func New(
@@ -97,6 +114,80 @@ func (c *Config) InitializeMATLABOnStartup() bool {
 	return c.initializeMATLABOnStartup
 }
 
+func (c *Config) MATLABSessionPerClient() bool {
+	return c.matlabSessionPerClient
+}
+
+func (c *Config) Transport() entities.TransportMode {
+	return c.transport
+}
+
+func (c *Config) ListenAddress() string {
+	return c.listenAddress
+}
+
+func (c *Config) AuthToken() string {
+	return c.authToken
+}
+
+func (c *Config) AllowedOrigins() []string {
+	return c.allowedOrigins
+}
+
+func (c *Config) AllowedFolders() []string {
+	return c.allowedFolders
+}
+
+func (c *Config) WarmPools() []entities.WarmPoolSize {
+	return c.warmPools
+}
+
+func (c *Config) SessionIdleTimeout() time.Duration {
+	return c.sessionIdleTimeout
+}
+
+func (c *Config) PersistentSessions() bool {
+	return c.persistentSessions
+}
+
+func (c *Config) MaxSessionQueue() int {
+	return c.maxSessionQueue
+}
+
+func (c *Config) DisplayMode() entities.DisplayMode {
+	return c.displayMode
+}
+
+func (c *Config) StartupScripts() []string {
+	return c.startupScripts
+}
+
+func (c *Config) MATLABFlags() []string {
+	return c.matlabFlags
+}
+
+func (c *Config) MATLABEnvironment() []string {
+	return c.matlabEnvironment
+}
+
+// MATLABEnvironmentNames returns the names of the MATLABEnvironment variables, so that their values, which may be secrets, are not logged.
+func (c *Config) MATLABEnvironmentNames() []string {
+	names := make([]string, 0, len(c.matlabEnvironment))
+	for _, envVar := range c.matlabEnvironment {
+		name, _, _ := strings.Cut(envVar, "=")
+		names = append(names, name)
+	}
+	return names
+}
+
+func (c *Config) SourceVMCSettings() bool {
+	return c.sourceVMCSettings
+}
+
+func (c *Config) SkipVMCCompatibilityCheck() bool {
+	return c.skipVMCCompatibilityCheck
+}
+
 func (c *Config) RecordToLogger(logger entities.Logger) {
 	logger.
 		With(flags.UseSingleMATLABSession, c.useSingleMATLABSession).
@@ -104,5 +195,20 @@ func (c *Config) RecordToLogger(logger entities.Logger) {
 		With(flags.PreferredLocalMATLABRoot, c.preferredLocalMATLABRoot).
 		With(flags.PreferredMATLABStartingDirectory, c.preferredMATLABStartingDirectory).
 		With(flags.PreferredVMCRoot, c.preferredVMCRoot).
+		With(flags.MATLABSessionPerClient, c.matlabSessionPerClient).
+		With(flags.Transport, c.transport).
+		With(flags.ListenAddress, c.listenAddress).
+		With(flags.AllowedOrigins, c.allowedOrigins).
+		With(flags.AllowedFolder, c.allowedFolders).
+		With(flags.WarmPool, c.warmPools).
+		With(flags.SessionIdleTimeout, c.sessionIdleTimeout).
+		With(flags.PersistentSessions, c.persistentSessions).
+		With(flags.MaxSessionQueue, c.maxSessionQueue).
+		With(flags.DisplayMode, c.displayMode).
+		With(flags.StartupScript, c.startupScripts).
+		With(flags.MATLABFlag, c.matlabFlags).
+		With(flags.MATLABEnv, c.MATLABEnvironmentNames()).
+		With(flags.SourceVMCSettings, c.sourceVMCSettings).
+		With(flags.SkipVMCCompatibilityCheck, c.skipVMCCompatibilityCheck).
 		Info("Configuration state")
 }
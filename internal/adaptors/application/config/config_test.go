@@ -4,8 +4,10 @@ package config_test
 
 import (
 	"path/filepath"
+	"runtime"
 	"runtime/debug"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/config"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -17,7 +19,6 @@ import (
 
 type expectedConfig struct {
 	versionMode                      bool
-	disableTelemetry                 bool
 	useSingleMATLABSession           bool
 	logLevel                         entities.LogLevel
 	preferredLocalMATLABRoot         string
@@ -26,9 +27,32 @@ type expectedConfig struct {
 	watchdogMode                     bool
 	serverInstanceID                 string
 	initializeMATLABOnStartup        bool
+	matlabSessionPerClient           bool
+	transport                        entities.TransportMode
+	listenAddress                    string
+	authToken                        string
+	allowedOrigins                   []string
+	allowedFolders                   []string
+	warmPools                        []entities.WarmPoolSize
+	sessionIdleTimeout               time.Duration
+	persistentSessions               bool
+	maxSessionQueue                  int
+	displayMode                      entities.DisplayMode
+	startupScripts                   []string
+	matlabFlags                      []string
+	matlabEnvironment                []string
+	sourceVMCSettings                bool
+	skipVMCCompatibilityCheck        bool
 }
 
 func TestNew_HappyPath(t *testing.T) {
+	workspaceFolder, err := filepath.Abs("workspace")
+	require.NoError(t, err)
+	sharedFolder, err := filepath.Abs("shared")
+	require.NoError(t, err)
+	setupScript, err := filepath.Abs(filepath.Join("project", "setup.m"))
+	require.NoError(t, err)
+
 	testConfigs := []struct {
 		name     string
 		args     []string
@@ -39,7 +63,6 @@ func TestNew_HappyPath(t *testing.T) {
 			args: []string{},
 			expected: expectedConfig{
 				versionMode:                      false,
-				disableTelemetry:                 false,
 				useSingleMATLABSession:           true,
 				logLevel:                         entities.LogLevelInfo,
 				preferredLocalMATLABRoot:         "",
@@ -48,13 +71,24 @@ func TestNew_HappyPath(t *testing.T) {
 				watchdogMode:                     false,
 				serverInstanceID:                 "",
 				initializeMATLABOnStartup:        false,
+				matlabSessionPerClient:           false,
+				transport:                        entities.TransportModeStdio,
+				listenAddress:                    "127.0.0.1:8765",
+				authToken:                        "",
+				allowedOrigins:                   []string{},
+				allowedFolders:                   []string{},
+				warmPools:                        []entities.WarmPoolSize{},
+				maxSessionQueue:                  10,
+				displayMode:                      entities.DisplayModeDesktop,
+				startupScripts:                   []string{},
+				matlabFlags:                      []string{},
+				matlabEnvironment:                []string{},
 			},
 		},
 		{
 			name: "custom values",
 			args: []string{
 				"--version=true",
-				"--disable-telemetry",
 				"--use-single-matlab-session=false",
 				"--log-level=debug",
 				"--matlab-root=" + filepath.Join("tmp", "root"),
@@ -63,10 +97,26 @@ func TestNew_HappyPath(t *testing.T) {
 				"--watchdog=true",
 				"--server-instance-id=1337",
 				"--initialize-matlab-on-startup=false",
+				"--transport=http",
+				"--listen=127.0.0.1:9000",
+				"--auth-token=secret",
+				"--allowed-origins=http://localhost:6274, http://127.0.0.1:6274",
+				"--allowed-folder=" + workspaceFolder,
+				"--allowed-folder", sharedFolder + string(filepath.Separator),
+				"--warm-pool=" + filepath.Join("tmp", "R2025a") + "=1",
+				"--warm-pool", filepath.Join("tmp", "R2024b") + "=0:2",
+				"--session-idle-timeout=30m",
+				"--persistent-sessions=true",
+				"--max-session-queue=3",
+				"--display-mode=nodesktop",
+				"--startup-script=" + setupScript,
+				"--matlab-flag=-singleCompThread",
+				"--matlab-env=LM_LICENSE_FILE=27000@licenses",
+				"--matlab-env", "XILINX_LOCAL_USER_DATA=no",
+				"--skip-vmc-compatibility-check=true",
 			},
 			expected: expectedConfig{
 				versionMode:                      true,
-				disableTelemetry:                 true,
 				useSingleMATLABSession:           false,
 				logLevel:                         entities.LogLevelDebug,
 				preferredLocalMATLABRoot:         filepath.Join("tmp", "root"),
@@ -75,6 +125,24 @@ func TestNew_HappyPath(t *testing.T) {
 				watchdogMode:                     true,
 				serverInstanceID:                 "1337",
 				initializeMATLABOnStartup:        false,
+				matlabSessionPerClient:           false,
+				transport:                        entities.TransportModeHTTP,
+				listenAddress:                    "127.0.0.1:9000",
+				authToken:                        "secret",
+				allowedOrigins:                   []string{"http://localhost:6274", "http://127.0.0.1:6274"},
+				allowedFolders:                   []string{workspaceFolder, sharedFolder},
+				warmPools: []entities.WarmPoolSize{
+					{MATLABRoot: filepath.Join("tmp", "R2025a"), Min: 1, Max: 1},
+					{MATLABRoot: filepath.Join("tmp", "R2024b"), Min: 0, Max: 2},
+				},
+				sessionIdleTimeout:        30 * time.Minute,
+				persistentSessions:        true,
+				maxSessionQueue:           3,
+				displayMode:               entities.DisplayModeNoDesktop,
+				startupScripts:            []string{setupScript},
+				matlabFlags:               []string{"-singleCompThread"},
+				matlabEnvironment:         []string{"LM_LICENSE_FILE=27000@licenses", "XILINX_LOCAL_USER_DATA=no"},
+				skipVMCCompatibilityCheck: true,
 			},
 		},
 		{
@@ -82,10 +150,10 @@ func TestNew_HappyPath(t *testing.T) {
 			args: []string{
 				"--use-single-matlab-session=false",
 				"--initialize-matlab-on-startup=true",
+				"--matlab-session-per-client=true",
 			},
 			expected: expectedConfig{
 				versionMode:                      false,
-				disableTelemetry:                 false,
 				useSingleMATLABSession:           false,
 				logLevel:                         entities.LogLevelInfo,
 				preferredLocalMATLABRoot:         "",
@@ -93,6 +161,50 @@ func TestNew_HappyPath(t *testing.T) {
 				baseDirectory:                    "",
 				watchdogMode:                     false,
 				initializeMATLABOnStartup:        false,
+				matlabSessionPerClient:           false,
+				transport:                        entities.TransportModeStdio,
+				listenAddress:                    "127.0.0.1:8765",
+				authToken:                        "",
+				allowedOrigins:                   []string{},
+				allowedFolders:                   []string{},
+				warmPools:                        []entities.WarmPoolSize{},
+				maxSessionQueue:                  10,
+				displayMode:                      entities.DisplayModeDesktop,
+				startupScripts:                   []string{},
+				matlabFlags:                      []string{},
+				matlabEnvironment:                []string{},
+			},
+		},
+		{
+			name: "session per client forces initialize on startup false",
+			args: []string{
+				"--matlab-session-per-client=true",
+				"--initialize-matlab-on-startup=true",
+				"--session-idle-timeout=30m",
+				"--persistent-sessions=true",
+				"--log-folder=" + filepath.Join("tmp", "logs"),
+			},
+			expected: expectedConfig{
+				versionMode:                      false,
+				useSingleMATLABSession:           true,
+				logLevel:                         entities.LogLevelInfo,
+				preferredLocalMATLABRoot:         "",
+				preferredMATLABStartingDirectory: "",
+				baseDirectory:                    filepath.Join("tmp", "logs"),
+				watchdogMode:                     false,
+				initializeMATLABOnStartup:        false,
+				matlabSessionPerClient:           true,
+				transport:                        entities.TransportModeStdio,
+				listenAddress:                    "127.0.0.1:8765",
+				authToken:                        "",
+				allowedOrigins:                   []string{},
+				allowedFolders:                   []string{},
+				warmPools:                        []entities.WarmPoolSize{},
+				maxSessionQueue:                  10,
+				displayMode:                      entities.DisplayModeDesktop,
+				startupScripts:                   []string{},
+				matlabFlags:                      []string{},
+				matlabEnvironment:                []string{},
 			},
 		},
 	}
@@ -119,7 +231,6 @@ func TestNew_HappyPath(t *testing.T) {
 			require.NotNil(t, cfg, "Config should not be nil")
 
 			assert.Equal(t, testConfig.expected.versionMode, cfg.VersionMode())
-			assert.Equal(t, testConfig.expected.disableTelemetry, cfg.DisableTelemetry())
 			assert.Equal(t, testConfig.expected.useSingleMATLABSession, cfg.UseSingleMATLABSession())
 			assert.Equal(t, testConfig.expected.logLevel, cfg.LogLevel())
 			assert.Equal(t, testConfig.expected.preferredLocalMATLABRoot, cfg.PreferredLocalMATLABRoot())
@@ -128,6 +239,22 @@ func TestNew_HappyPath(t *testing.T) {
 			assert.Equal(t, testConfig.expected.watchdogMode, cfg.WatchdogMode())
 			assert.Equal(t, testConfig.expected.serverInstanceID, cfg.ServerInstanceID())
 			assert.Equal(t, testConfig.expected.initializeMATLABOnStartup, cfg.InitializeMATLABOnStartup())
+			assert.Equal(t, testConfig.expected.matlabSessionPerClient, cfg.MATLABSessionPerClient())
+			assert.Equal(t, testConfig.expected.transport, cfg.Transport())
+			assert.Equal(t, testConfig.expected.listenAddress, cfg.ListenAddress())
+			assert.Equal(t, testConfig.expected.authToken, cfg.AuthToken())
+			assert.Equal(t, testConfig.expected.allowedOrigins, cfg.AllowedOrigins())
+			assert.Equal(t, testConfig.expected.allowedFolders, cfg.AllowedFolders())
+			assert.Equal(t, testConfig.expected.warmPools, cfg.WarmPools())
+			assert.Equal(t, testConfig.expected.sessionIdleTimeout, cfg.SessionIdleTimeout())
+			assert.Equal(t, testConfig.expected.persistentSessions, cfg.PersistentSessions())
+			assert.Equal(t, testConfig.expected.maxSessionQueue, cfg.MaxSessionQueue())
+			assert.Equal(t, testConfig.expected.displayMode, cfg.DisplayMode())
+			assert.Equal(t, testConfig.expected.startupScripts, cfg.StartupScripts())
+			assert.Equal(t, testConfig.expected.matlabFlags, cfg.MATLABFlags())
+			assert.Equal(t, testConfig.expected.matlabEnvironment, cfg.MATLABEnvironment())
+			assert.Equal(t, testConfig.expected.sourceVMCSettings, cfg.SourceVMCSettings())
+			assert.Equal(t, testConfig.expected.skipVMCCompatibilityCheck, cfg.SkipVMCCompatibilityCheck())
 		})
 	}
 }
@@ -240,60 +367,6 @@ func TestConfig_Version_VersionUnsetByLDFLAGS_ButReadBuildInfoNotOK(t *testing.T
 	require.Equal(t, expectedFullVersion, version)
 }
 
-func TestConfig_DisableTelemetry_HappyPath(t *testing.T) {
-	testConfigs := []struct {
-		name     string
-		args     []string
-		expected bool
-	}{
-		{
-			name:     "default value",
-			args:     []string{},
-			expected: false,
-		},
-		{
-			name:     "implicitly true",
-			args:     []string{"--disable-telemetry"},
-			expected: true,
-		},
-		{
-			name:     "explicitly true",
-			args:     []string{"--disable-telemetry=true"},
-			expected: true,
-		},
-		{
-			name:     "explicitly false",
-			args:     []string{"--disable-telemetry=false"},
-			expected: false,
-		},
-	}
-
-	for _, testConfig := range testConfigs {
-		t.Run(testConfig.name, func(t *testing.T) {
-			// Arrange
-			mockOSLayer := &configmocks.MockOSLayer{}
-			defer mockOSLayer.AssertExpectations(t)
-
-			programName := "testprocess"
-			args := append([]string{programName}, testConfig.args...)
-
-			mockOSLayer.EXPECT().
-				Args().
-				Return(args).
-				Once()
-
-			cfg, err := config.New(mockOSLayer)
-			require.NoError(t, err)
-
-			// Act
-			result := cfg.DisableTelemetry()
-
-			// Assert
-			assert.Equal(t, testConfig.expected, result)
-		})
-	}
-}
-
 func TestConfig_UseSingleMATLABSession_HappyPath(t *testing.T) {
 	testConfigs := []struct {
 		name     string
@@ -577,6 +650,461 @@ func TestConfig_LogLevel_EmptyIsInvalid(t *testing.T) {
 	assert.Empty(t, cfg)
 }
 
+func TestConfig_Transport_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected entities.TransportMode
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: entities.TransportModeStdio,
+		},
+		{
+			name:     "stdio transport",
+			args:     []string{"--transport=stdio"},
+			expected: entities.TransportModeStdio,
+		},
+		{
+			name:     "http transport",
+			args:     []string{"--transport=http"},
+			expected: entities.TransportModeHTTP,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			result := cfg.Transport()
+
+			// Assert
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+func TestConfig_Transport_Invalid(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--transport=invalid")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid transport")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_DisplayMode_HappyPath(t *testing.T) {
+	testConfigs := []struct {
+		name     string
+		args     []string
+		expected entities.DisplayMode
+	}{
+		{
+			name:     "default value",
+			args:     []string{},
+			expected: entities.DisplayModeDesktop,
+		},
+		{
+			name:     "nodesktop",
+			args:     []string{"--display-mode=nodesktop"},
+			expected: entities.DisplayModeNoDesktop,
+		},
+		{
+			name:     "virtual",
+			args:     []string{"--display-mode=virtual"},
+			expected: entities.DisplayModeVirtual,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			if testConfig.expected == entities.DisplayModeVirtual && runtime.GOOS != "linux" {
+				t.Skip("Virtual displays are only supported on Linux")
+			}
+
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, testConfig.args...)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			cfg, err := config.New(mockOSLayer)
+			require.NoError(t, err)
+
+			// Act
+			result := cfg.DisplayMode()
+
+			// Assert
+			assert.Equal(t, testConfig.expected, result)
+		})
+	}
+}
+
+func TestConfig_DisplayMode_Invalid(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--display-mode=headless")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid display-mode")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_StartupScript_MustBeAbsolute(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--startup-script="+filepath.Join("project", "setup.m"))
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid startup script")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_MATLABFlag_Invalid(t *testing.T) {
+	for _, matlabFlag := range []string{"-r", "-batch", "-R"} {
+		t.Run(matlabFlag, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, "--matlab-flag="+matlabFlag)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, "invalid MATLAB flag")
+			assert.Empty(t, cfg)
+		})
+	}
+}
+
+func TestConfig_MATLABEnv_Invalid(t *testing.T) {
+	for _, envVar := range []string{"LM_LICENSE_FILE", "=value", " =value"} {
+		t.Run(envVar, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := append([]string{programName}, "--matlab-env="+envVar)
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, "invalid MATLAB environment variable")
+			assert.Empty(t, cfg)
+		})
+	}
+}
+
+func TestConfig_SourceVMCSettings(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Settings scripts are not supported on Windows")
+	}
+
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--source-vmc-settings=true")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	cfg, err := config.New(mockOSLayer)
+	require.NoError(t, err)
+
+	// Act
+	result := cfg.SourceVMCSettings()
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestConfig_ListenAddress_InvalidWithHTTPTransport(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--transport=http", "--listen=localhost")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid listen address")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_ListenAddress_IgnoredWithStdioTransport(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--listen=localhost")
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", cfg.ListenAddress())
+}
+
+func TestConfig_AllowedFolder_MustBeAbsolute(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := append([]string{programName}, "--allowed-folder="+filepath.Join("relative", "folder"))
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid allowed folder")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_WarmPool_Invalid(t *testing.T) {
+	matlabRoot := filepath.Join("opt", "matlab")
+
+	testConfigs := []struct {
+		name          string
+		value         string
+		expectedError string
+	}{
+		{
+			name:          "missing sizes",
+			value:         matlabRoot,
+			expectedError: "must be <matlab-root>=<min>[:<max>]",
+		},
+		{
+			name:          "missing MATLAB root",
+			value:         "=1",
+			expectedError: "must be <matlab-root>=<min>[:<max>]",
+		},
+		{
+			name:          "minimum not a number",
+			value:         matlabRoot + "=many",
+			expectedError: "minimum size",
+		},
+		{
+			name:          "negative minimum",
+			value:         matlabRoot + "=-1",
+			expectedError: "minimum size",
+		},
+		{
+			name:          "maximum smaller than minimum",
+			value:         matlabRoot + "=2:1",
+			expectedError: "maximum size",
+		},
+		{
+			name:          "empty pool",
+			value:         matlabRoot + "=0",
+			expectedError: "must be at least 1",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &configmocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			programName := "testprocess"
+			args := []string{programName, "--warm-pool=" + testConfig.value}
+
+			mockOSLayer.EXPECT().
+				Args().
+				Return(args).
+				Once()
+
+			// Act
+			cfg, err := config.New(mockOSLayer)
+
+			// Assert
+			require.ErrorContains(t, err, "invalid warm pool")
+			require.ErrorContains(t, err, testConfig.expectedError)
+			assert.Empty(t, cfg)
+		})
+	}
+}
+
+func TestConfig_WarmPool_DuplicateMATLABRoot(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	matlabRoot := filepath.Join("opt", "matlab")
+	programName := "testprocess"
+	args := []string{programName, "--warm-pool=" + matlabRoot + "=1", "--warm-pool=" + matlabRoot + "=2"}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "configured more than once")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_SessionIdleTimeout_Negative(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName, "--use-single-matlab-session=false", "--session-idle-timeout=-5m"}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid session idle timeout")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_PersistentSessions_WithoutLogFolder(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName, "--use-single-matlab-session=false", "--persistent-sessions=true"}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "requires log-folder")
+	assert.Empty(t, cfg)
+}
+
+func TestConfig_MaxSessionQueue_Negative(t *testing.T) {
+	// Arrange
+	mockOSLayer := &configmocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	programName := "testprocess"
+	args := []string{programName, "--max-session-queue=-1"}
+
+	mockOSLayer.EXPECT().
+		Args().
+		Return(args).
+		Once()
+
+	// Act
+	cfg, err := config.New(mockOSLayer)
+
+	// Assert
+	require.ErrorContains(t, err, "invalid max-session-queue")
+	assert.Empty(t, cfg)
+}
+
 func TestConfig_Log_HappyPath(t *testing.T) {
 	testConfigs := []struct {
 		name                string
@@ -589,29 +1117,50 @@ func TestConfig_Log_HappyPath(t *testing.T) {
 			args:               []string{},
 			expectedLogMessage: "Configuration state",
 			expectedConfigField: map[string]any{
-				"disable-telemetry":         false,
 				"initial-working-folder":    "",
 				"log-level":                 entities.LogLevelInfo,
 				"matlab-root":               "",
 				"use-single-matlab-session": true,
+				"matlab-session-per-client": false,
+				"transport":                 entities.TransportModeStdio,
+				"listen":                    "127.0.0.1:8765",
+				"allowed-origins":           []string{},
+				"allowed-folder":            []string{},
+				"warm-pool":                 []entities.WarmPoolSize{},
+				"session-idle-timeout":      time.Duration(0),
+				"persistent-sessions":       false,
+				"max-session-queue":         10,
+				"display-mode":              entities.DisplayModeDesktop,
+				"startup-script":            []string{},
+				"matlab-flag":               []string{},
+				"matlab-env":                []string{},
+				"source-vmc-settings":       false,
 			},
 		},
 		{
 			name: "custom configuration",
 			args: []string{
-				"--disable-telemetry",
 				"--use-single-matlab-session=false",
 				"--log-level=debug",
 				"--initial-working-folder=" + filepath.Join("home", "user"),
 				"--matlab-root=" + filepath.Join("home", "matlab"),
+				"--transport=http",
+				"--listen=127.0.0.1:9000",
+				"--auth-token=secret",
+				"--allowed-origins=http://localhost:6274",
+				"--matlab-env=LM_LICENSE_FILE=27000@licenses",
 			},
 			expectedLogMessage: "Configuration state",
 			expectedConfigField: map[string]any{
-				"disable-telemetry":         true,
 				"initial-working-folder":    filepath.Join("home", "user"),
 				"log-level":                 entities.LogLevelDebug,
 				"matlab-root":               filepath.Join("home", "matlab"),
 				"use-single-matlab-session": false,
+				"matlab-session-per-client": false,
+				"transport":                 entities.TransportModeHTTP,
+				"listen":                    "127.0.0.1:9000",
+				"allowed-origins":           []string{"http://localhost:6274"},
+				"matlab-env":                []string{"LM_LICENSE_FILE"},
 			},
 		},
 	}
@@ -645,6 +1194,9 @@ func TestConfig_Log_HappyPath(t *testing.T) {
 			fields, found := infoLogs[testConfig.expectedLogMessage]
 			require.True(t, found, "Expected log message not found")
 
+			_, tokenLogged := fields["auth-token"]
+			assert.False(t, tokenLogged, "auth-token must not be logged")
+
 			for expectedField, expectedValue := range testConfig.expectedConfigField {
 				actualValue, exists := fields[expectedField]
 				require.True(t, exists, "%s field not found in log", expectedField)
@@ -5,6 +5,11 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"net"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/application/inputs/flags"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -44,6 +49,70 @@ func setupFlags(flagSet *pflag.FlagSet) error {
 		flags.InitializeMATLABOnStartupDescription,
 	)
 
+	flagSet.Bool(flags.MATLABSessionPerClient, flags.MATLABSessionPerClientDefaultValue,
+		flags.MATLABSessionPerClientDescription,
+	)
+
+	flagSet.String(flags.Transport, flags.TransportDefaultValue,
+		flags.TransportDescription,
+	)
+
+	flagSet.String(flags.ListenAddress, flags.ListenAddressDefaultValue,
+		flags.ListenAddressDescription,
+	)
+
+	flagSet.String(flags.AuthToken, flags.AuthTokenDefaultValue,
+		flags.AuthTokenDescription,
+	)
+
+	flagSet.String(flags.AllowedOrigins, flags.AllowedOriginsDefaultValue,
+		flags.AllowedOriginsDescription,
+	)
+
+	flagSet.StringArray(flags.AllowedFolder, nil,
+		flags.AllowedFolderDescription,
+	)
+
+	flagSet.StringArray(flags.WarmPool, nil,
+		flags.WarmPoolDescription,
+	)
+
+	flagSet.Duration(flags.SessionIdleTimeout, flags.SessionIdleTimeoutDefaultValue,
+		flags.SessionIdleTimeoutDescription,
+	)
+
+	flagSet.Bool(flags.PersistentSessions, flags.PersistentSessionsDefaultValue,
+		flags.PersistentSessionsDescription,
+	)
+
+	flagSet.Int(flags.MaxSessionQueue, flags.MaxSessionQueueDefaultValue,
+		flags.MaxSessionQueueDescription,
+	)
+
+	flagSet.String(flags.DisplayMode, flags.DisplayModeDefaultValue,
+		flags.DisplayModeDescription,
+	)
+
+	flagSet.StringArray(flags.StartupScript, nil,
+		flags.StartupScriptDescription,
+	)
+
+	flagSet.StringArray(flags.MATLABFlag, nil,
+		flags.MATLABFlagDescription,
+	)
+
+	flagSet.StringArray(flags.MATLABEnv, nil,
+		flags.MATLABEnvDescription,
+	)
+
+	flagSet.Bool(flags.SourceVMCSettings, flags.SourceVMCSettingsDefaultValue,
+		flags.SourceVMCSettingsDescription,
+	)
+
+	flagSet.Bool(flags.SkipVMCCompatibilityCheck, flags.SkipVMCCompatibilityCheckDefaultValue,
+		flags.SkipVMCCompatibilityCheckDescription,
+	)
+
 	// Hidden flags, for internal use only
 	flagSet.Bool(flags.WatchdogMode, flags.WatchdogModeDefaultValue,
 		flags.WatchdogModeDescription,
@@ -130,8 +199,206 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 		return nil, err
 	}
 
+	matlabSessionPerClient, err := flagSet.GetBool(flags.MATLABSessionPerClient)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIdleTimeout, err := flagSet.GetDuration(flags.SessionIdleTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionIdleTimeout < 0 {
+		return nil, fmt.Errorf("invalid session idle timeout: %s is negative", sessionIdleTimeout)
+	}
+
+	persistentSessions, err := flagSet.GetBool(flags.PersistentSessions)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSessionQueue, err := flagSet.GetInt(flags.MaxSessionQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxSessionQueue < 0 {
+		return nil, fmt.Errorf("invalid %s: %d is negative", flags.MaxSessionQueue, maxSessionQueue)
+	}
+
 	if !useSingleMATLABSession {
 		initializeMATLABOnStartup = false
+		matlabSessionPerClient = false
+	}
+
+	// Only sessions started with start_matlab_session are reaped; the single session is managed by the server.
+	if useSingleMATLABSession {
+		sessionIdleTimeout = 0
+		persistentSessions = false
+	}
+
+	// Persistent sessions are found again in the log folder, so it must outlive the server.
+	if persistentSessions && baseDir == "" {
+		return nil, fmt.Errorf("invalid %s: requires %s, so that the next server can find the sessions", flags.PersistentSessions, flags.BaseDir)
+	}
+
+	// With one MATLAB session per client, there is no session to initialize before a client connects.
+	if matlabSessionPerClient {
+		initializeMATLABOnStartup = false
+	}
+
+	transport, err := flagSet.GetString(flags.Transport)
+	if err != nil {
+		return nil, err
+	}
+
+	switch transport {
+	case string(entities.TransportModeStdio), string(entities.TransportModeHTTP):
+		break
+	default:
+		return nil, fmt.Errorf("invalid transport: %s", transport)
+	}
+
+	displayMode, err := flagSet.GetString(flags.DisplayMode)
+	if err != nil {
+		return nil, err
+	}
+
+	switch displayMode {
+	case string(entities.DisplayModeDesktop), string(entities.DisplayModeNoDesktop):
+		break
+	case string(entities.DisplayModeVirtual):
+		// Virtual displays are Xvfb servers, which only exist on Linux.
+		if runtime.GOOS != "linux" {
+			return nil, fmt.Errorf("invalid %s: %s is only supported on Linux", flags.DisplayMode, displayMode)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s: %s", flags.DisplayMode, displayMode)
+	}
+
+	listenAddress, err := flagSet.GetString(flags.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if transport == string(entities.TransportModeHTTP) {
+		if _, _, err := net.SplitHostPort(listenAddress); err != nil {
+			return nil, fmt.Errorf("invalid listen address: %s: %w", listenAddress, err)
+		}
+	}
+
+	authToken, err := flagSet.GetString(flags.AuthToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rawAllowedOrigins, err := flagSet.GetString(flags.AllowedOrigins)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedOrigins := []string{}
+	for _, origin := range strings.Split(rawAllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowedOrigins = append(allowedOrigins, origin)
+		}
+	}
+
+	rawAllowedFolders, err := flagSet.GetStringArray(flags.AllowedFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedFolders := []string{}
+	for _, folder := range rawAllowedFolders {
+		if folder = strings.TrimSpace(folder); folder == "" {
+			continue
+		}
+		if !filepath.IsAbs(folder) {
+			return nil, fmt.Errorf("invalid allowed folder: %s is not an absolute path", folder)
+		}
+		allowedFolders = append(allowedFolders, filepath.Clean(folder))
+	}
+
+	rawWarmPools, err := flagSet.GetStringArray(flags.WarmPool)
+	if err != nil {
+		return nil, err
+	}
+
+	warmPools := []entities.WarmPoolSize{}
+	for _, rawWarmPool := range rawWarmPools {
+		warmPool, err := parseWarmPool(rawWarmPool)
+		if err != nil {
+			return nil, err
+		}
+		for _, existing := range warmPools {
+			if existing.MATLABRoot == warmPool.MATLABRoot {
+				return nil, fmt.Errorf("invalid warm pool: %s is configured more than once", warmPool.MATLABRoot)
+			}
+		}
+		warmPools = append(warmPools, warmPool)
+	}
+
+	rawStartupScripts, err := flagSet.GetStringArray(flags.StartupScript)
+	if err != nil {
+		return nil, err
+	}
+
+	startupScripts := []string{}
+	for _, script := range rawStartupScripts {
+		if script = strings.TrimSpace(script); script == "" {
+			continue
+		}
+		if !filepath.IsAbs(script) {
+			return nil, fmt.Errorf("invalid startup script: %s is not an absolute path", script)
+		}
+		startupScripts = append(startupScripts, filepath.Clean(script))
+	}
+
+	rawMATLABFlags, err := flagSet.GetStringArray(flags.MATLABFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	matlabFlags := []string{}
+	for _, matlabFlag := range rawMATLABFlags {
+		if matlabFlag = strings.TrimSpace(matlabFlag); matlabFlag == "" {
+			continue
+		}
+		if strings.EqualFold(matlabFlag, "-r") || strings.EqualFold(matlabFlag, "-batch") {
+			return nil, fmt.Errorf("invalid MATLAB flag: %s would replace the startup code of the server, use --%s instead", matlabFlag, flags.StartupScript)
+		}
+		matlabFlags = append(matlabFlags, matlabFlag)
+	}
+
+	rawMATLABEnv, err := flagSet.GetStringArray(flags.MATLABEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	matlabEnv := []string{}
+	for _, envVar := range rawMATLABEnv {
+		name, value, found := strings.Cut(envVar, "=")
+		if name = strings.TrimSpace(name); !found || name == "" {
+			return nil, fmt.Errorf("invalid MATLAB environment variable: %q must be <name>=<value>", envVar)
+		}
+		matlabEnv = append(matlabEnv, name+"="+value)
+	}
+
+	sourceVMCSettings, err := flagSet.GetBool(flags.SourceVMCSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settings scripts are shell scripts, Model Composer uses batch files on Windows.
+	if sourceVMCSettings && runtime.GOOS == "windows" {
+		return nil, fmt.Errorf("invalid %s: not supported on Windows", flags.SourceVMCSettings)
+	}
+
+	skipVMCCompatibilityCheck, err := flagSet.GetBool(flags.SkipVMCCompatibilityCheck)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Config{
@@ -147,5 +414,56 @@ func createConfigWithFlagValues(osLayer OSLayer, flagSet *pflag.FlagSet, args []
 		watchdogMode:                     watchdogMode,
 		serverInstanceID:                 serverInstanceID,
 		initializeMATLABOnStartup:        initializeMATLABOnStartup,
+		matlabSessionPerClient:           matlabSessionPerClient,
+		transport:                        entities.TransportMode(transport),
+		listenAddress:                    listenAddress,
+		authToken:                        authToken,
+		allowedOrigins:                   allowedOrigins,
+		allowedFolders:                   allowedFolders,
+		warmPools:                        warmPools,
+		sessionIdleTimeout:               sessionIdleTimeout,
+		persistentSessions:               persistentSessions,
+		maxSessionQueue:                  maxSessionQueue,
+		displayMode:                      entities.DisplayMode(displayMode),
+		startupScripts:                   startupScripts,
+		matlabFlags:                      matlabFlags,
+		matlabEnvironment:                matlabEnv,
+		sourceVMCSettings:                sourceVMCSettings,
+		skipVMCCompatibilityCheck:        skipVMCCompatibilityCheck,
+	}, nil
+}
+
+// parseWarmPool parses a warm pool value of the form <matlab-root>=<min> or <matlab-root>=<min>:<max>.
+// The last '=' separates the MATLAB root from the sizes, so that the root itself may contain one.
+func parseWarmPool(value string) (entities.WarmPoolSize, error) {
+	separator := strings.LastIndex(value, "=")
+	if separator < 0 || strings.TrimSpace(value[:separator]) == "" {
+		return entities.WarmPoolSize{}, fmt.Errorf("invalid warm pool: %q must be <matlab-root>=<min>[:<max>]", value)
+	}
+
+	matlabRoot := strings.TrimSpace(value[:separator])
+	rawMin, rawMax, hasMax := strings.Cut(value[separator+1:], ":")
+
+	minSize, err := strconv.Atoi(strings.TrimSpace(rawMin))
+	if err != nil || minSize < 0 {
+		return entities.WarmPoolSize{}, fmt.Errorf("invalid warm pool: minimum size in %q must be a non-negative integer", value)
+	}
+
+	maxSize := minSize
+	if hasMax {
+		maxSize, err = strconv.Atoi(strings.TrimSpace(rawMax))
+		if err != nil || maxSize < minSize {
+			return entities.WarmPoolSize{}, fmt.Errorf("invalid warm pool: maximum size in %q must be an integer no smaller than the minimum size", value)
+		}
+	}
+
+	if maxSize == 0 {
+		return entities.WarmPoolSize{}, fmt.Errorf("invalid warm pool: maximum size in %q must be at least 1", value)
+	}
+
+	return entities.WarmPoolSize{
+		MATLABRoot: filepath.Clean(matlabRoot),
+		Min:        minSize,
+		Max:        maxSize,
 	}, nil
 }
@@ -2,6 +2,8 @@
 
 package flags
 
+import "time"
+
 const (
 	VersionMode             = "version"
 	VersionModeDefaultValue = false
@@ -35,6 +37,65 @@ const (
 	InitializeMATLABOnStartupDefaultValue = false
 	InitializeMATLABOnStartupDescription  = "To initialize MATLAB as soon as you start the server, set this argument to true. By default, MATLAB only starts when the first tool is called."
 
+	MATLABSessionPerClient             = "matlab-session-per-client"
+	MATLABSessionPerClientDefaultValue = false
+	MATLABSessionPerClientDescription  = "When use-single-matlab-session is true, give each connected MCP client its own MATLAB session, started when the client first calls a tool and stopped when it disconnects. By default, all clients share one MATLAB session."
+
+	Transport             = "transport"
+	TransportDefaultValue = "stdio"
+	TransportDescription  = "The MCP transport to serve. Valid values are 'stdio' and 'http'. With 'http', the server serves the MCP streamable HTTP transport on the address set by --listen, so that several clients can share one server and its MATLAB sessions."
+
+	ListenAddress             = "listen"
+	ListenAddressDefaultValue = "127.0.0.1:8765"
+	ListenAddressDescription  = "When transport is 'http', the host:port address the server listens on."
+
+	AuthToken             = "auth-token"
+	AuthTokenDefaultValue = ""
+	AuthTokenDescription  = "When transport is 'http', the bearer token clients must send. If not specified, the server generates a token, prints it once to stderr and writes it to the log folder."
+
+	AllowedOrigins             = "allowed-origins"
+	AllowedOriginsDefaultValue = ""
+	AllowedOriginsDescription  = "When transport is 'http', a comma-separated list of Origin header values to accept, such as 'http://localhost:6274'. Requests without an Origin header are always accepted."
+
+	AllowedFolder            = "allowed-folder"
+	AllowedFolderDescription = "A folder that MATLAB scripts and working folders passed to tools must be inside, in addition to the roots of the MCP client. Repeat the argument to allow several folders. If neither this argument nor client roots are set, any folder is allowed."
+
+	WarmPool            = "warm-pool"
+	WarmPoolDescription = "When use-single-matlab-session is false, keep spare MATLAB sessions started in the background for a MATLAB root, so that start_matlab_session returns one instantly. The value is '<matlab-root>=<min>' or '<matlab-root>=<min>:<max>'. The pool starts <min> sessions, and grows by one up to <max> each time a request finds it empty. Repeat the argument for several MATLAB roots."
+
+	SessionIdleTimeout             = "session-idle-timeout"
+	SessionIdleTimeoutDefaultValue = time.Duration(0)
+	SessionIdleTimeoutDescription  = "When use-single-matlab-session is false, stop MATLAB sessions that no tool has used for this long, such as '30m' or '2h'. Tool calls that are still running keep their session in use. By default, sessions are only stopped by stop_matlab_session or when the server shuts down."
+
+	PersistentSessions             = "persistent-sessions"
+	PersistentSessionsDefaultValue = false
+	PersistentSessionsDescription  = "When use-single-matlab-session is false, keep MATLAB sessions running when the server shuts down cleanly, such as when the MCP client restarts it, and reattach to them when the server starts again with the same log-folder. Requires log-folder. By default, the server stops its MATLAB sessions when it shuts down."
+
+	MaxSessionQueue             = "max-session-queue"
+	MaxSessionQueueDefaultValue = 10
+	MaxSessionQueueDescription  = "Maximum number of tool calls that can wait for a busy MATLAB session. MATLAB runs the calls to a session one at a time, in the order they arrive; further calls fail until the session catches up. Set to 0 for no limit."
+
+	DisplayMode             = "display-mode"
+	DisplayModeDefaultValue = "desktop"
+	DisplayModeDescription  = "How MATLAB sessions are displayed. Valid values are 'desktop', 'nodesktop' and 'virtual'. With 'desktop', the single MATLAB session shows the MATLAB desktop, which needs a display. With 'nodesktop', it runs without a desktop. With 'virtual', on Linux only, every session started without a desktop runs on its own Xvfb virtual display, so that Simulink and Vitis Model Composer graphics work on machines without a display."
+
+	StartupScript            = "startup-script"
+	StartupScriptDescription = "Absolute path to a MATLAB script that every MATLAB session runs once it has started, before any tool uses it, for example to add project folders to the path or set up Vitis Model Composer. Repeat the argument to run several scripts, in order. A failing script is reported in the session's matlab_stderr.log and does not stop the others."
+
+	MATLABFlag            = "matlab-flag"
+	MATLABFlagDescription = "Extra command-line argument passed to every MATLAB session, such as '-singleCompThread'. Repeat the argument for several flags. '-r' and '-batch' are not allowed, as the server sets the startup code itself."
+
+	MATLABEnv            = "matlab-env"
+	MATLABEnvDescription = "Environment variable set for every MATLAB session, as '<name>=<value>', such as 'LM_LICENSE_FILE=27000@licenses'. It replaces the value inherited from the server. Repeat the argument for several variables. Only the names are logged."
+
+	SourceVMCSettings             = "source-vmc-settings"
+	SourceVMCSettingsDefaultValue = false
+	SourceVMCSettingsDescription  = "When a VMC root is used, source the settings64.sh script of the Vitis installation in a subshell before starting Model Composer, and pass the environment it sets to MATLAB. The script is looked for in the VMC root, its parent folder, and the Vitis folder next to it. The environment is built once per VMC root. Not supported on Windows."
+
+	SkipVMCCompatibilityCheck             = "skip-vmc-compatibility-check"
+	SkipVMCCompatibilityCheckDefaultValue = false
+	SkipVMCCompatibilityCheckDescription  = "Start a session with a VMC root even when the Vitis Model Composer release does not list the MATLAB release as supported, and only log a warning. Use it when the list of supported releases built into the server is out of date."
+
 	// Hidden
 
 	WatchdogMode             = "watchdog"
@@ -17,6 +17,7 @@ type LifecycleSignaler interface {
 type Config interface {
 	UseSingleMATLABSession() bool
 	InitializeMATLABOnStartup() bool
+	PersistentSessions() bool
 	RecordToLogger(logger entities.Logger)
 }
 
@@ -38,7 +39,15 @@ type OSSignaler interface {
 }
 
 type GlobalMATLAB interface {
-	Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)
+	Client(ctx context.Context, logger entities.Logger, mcpSession entities.MCPSession) (entities.MATLABSessionClient, error)
+}
+
+type WarmPool interface {
+	Start(ctx context.Context, logger entities.Logger)
+}
+
+type SessionRestorer interface {
+	RestoreMATLABSessions(ctx context.Context, logger entities.Logger)
 }
 
 type Directory interface {
@@ -55,6 +64,8 @@ type Orchestrator struct {
 	osSignaler        OSSignaler
 	globalMATLAB      GlobalMATLAB
 	directory         Directory
+	warmPool          WarmPool
+	sessionRestorer   SessionRestorer
 }
 
 func New(
@@ -66,6 +77,8 @@ func New(
 	osSignaler OSSignaler,
 	globalMATLAB GlobalMATLAB,
 	directory Directory,
+	warmPool WarmPool,
+	sessionRestorer SessionRestorer,
 ) *Orchestrator {
 	orchestrator := &Orchestrator{
 		lifecycleSignaler: lifecycleSignaler,
@@ -76,6 +89,8 @@ func New(
 		osSignaler:        osSignaler,
 		globalMATLAB:      globalMATLAB,
 		directory:         directory,
+		warmPool:          warmPool,
+		sessionRestorer:   sessionRestorer,
 	}
 	return orchestrator
 }
@@ -115,11 +130,18 @@ func (o *Orchestrator) StartAndWaitForCompletion(ctx context.Context) error {
 		serverErrC <- o.server.Run()
 	}()
 
-	if o.config.UseSingleMATLABSession() && o.config.InitializeMATLABOnStartup() {
-		_, err := o.globalMATLAB.Client(ctx, o.loggerFactory.GetGlobalLogger())
-		if err != nil {
-			logger.WithError(err).Warn("MATLAB global initialization failed")
+	if o.config.UseSingleMATLABSession() {
+		if o.config.InitializeMATLABOnStartup() {
+			_, err := o.globalMATLAB.Client(ctx, o.loggerFactory.GetGlobalLogger(), nil)
+			if err != nil {
+				logger.WithError(err).Warn("MATLAB global initialization failed")
+			}
+		}
+	} else {
+		if o.config.PersistentSessions() {
+			o.sessionRestorer.RestoreMATLABSessions(ctx, logger)
 		}
+		o.warmPool.Start(ctx, logger)
 	}
 
 	logger.Info("MATLAB MCP Core Server application startup complete")
@@ -40,6 +40,12 @@ func TestNew_HappyPath(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	//Act
 	orchestratorInstance := orchestrator.New(
 		mockLifecycleSignaler,
@@ -50,6 +56,8 @@ func TestNew_HappyPath(t *testing.T) {
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Assert
@@ -82,6 +90,12 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -130,7 +144,7 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, nil).
 		Once()
 
@@ -163,6 +177,8 @@ func TestOrchestrator_StartAndWaitForCompletion_HappyPath(t *testing.T) {
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
@@ -207,6 +223,12 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABOnStartup_False(
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
 
@@ -282,6 +304,8 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABOnStartup_False(
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
@@ -323,6 +347,12 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -364,7 +394,7 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, nil).
 		Once()
 
@@ -397,6 +427,8 @@ func TestOrchestrator_StartAndWaitForCompletion_ServerError(t *testing.T) {
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
@@ -432,6 +464,12 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -477,7 +515,7 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, expectedError).
 		Once()
 
@@ -513,6 +551,8 @@ func TestOrchestrator_StartAndWaitForCompletion_InitializeMATLABErrorDoesNotTrig
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
@@ -559,6 +599,12 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -600,7 +646,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, nil).
 		Once()
 
@@ -633,6 +679,8 @@ func TestOrchestrator_StartAndWaitForCompletion_WaitForShutdownToCompleteError(t
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
@@ -686,6 +734,12 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
 	expectedError := assert.AnError
@@ -734,7 +788,7 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 		Once()
 
 	mockGlobalMATLABManager.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, nil).
 		Once()
 
@@ -763,7 +817,8 @@ func TestOrchestrator_StartAndWaitForCompletion_WatchdogStopError(t *testing.T)
 
 	orchestratorInstance := orchestrator.New(
 		mockLifecycleSignaler, mockConfig, mockServer, mockWatchdogClient,
-		mockLoggerFactory, mockSignalLayer, mockGlobalMATLABManager, mockDirectory,
+		mockLoggerFactory, mockSignalLayer, mockGlobalMATLABManager, mockDirectory, mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
@@ -812,6 +867,128 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 	mockDirectory := &orchestratormocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	interruptC := getInterruptChannel()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockDirectory.EXPECT().
+		RecordToLogger(mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockServer.EXPECT().
+		Run().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		UseSingleMATLABSession().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockWarmPool.EXPECT().
+		Start(ctx, mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockSignalLayer.EXPECT().
+		InterruptSignalChan().
+		Return(interruptC).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		RequestShutdown().
+		Return().
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		WaitForShutdownToComplete().
+		Return(nil).
+		Once()
+
+	mockWatchdogClient.EXPECT().
+		Stop().
+		Return(nil).
+		Once()
+
+	orchestratorInstance := orchestrator.New(
+		mockLifecycleSignaler,
+		mockConfig,
+		mockServer,
+		mockWatchdogClient,
+		mockLoggerFactory,
+		mockSignalLayer,
+		mockGlobalMATLABManager,
+		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
+	)
+
+	// Act
+	err := orchestratorInstance.StartAndWaitForCompletion(ctx)
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_PersistentSessions(t *testing.T) {
+	// Arrange
+	mockLifecycleSignaler := &orchestratormocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfig := &orchestratormocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockServer := &orchestratormocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockWatchdogClient := &orchestratormocks.MockWatchdogClient{}
+	defer mockWatchdogClient.AssertExpectations(t)
+
+	mockLoggerFactory := &orchestratormocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSignalLayer := &orchestratormocks.MockOSSignaler{}
+	defer mockSignalLayer.AssertExpectations(t)
+
+	mockGlobalMATLABManager := &orchestratormocks.MockGlobalMATLAB{}
+	defer mockGlobalMATLABManager.AssertExpectations(t) // Implicit assertion here, Initialize should not be called
+
+	mockDirectory := &orchestratormocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWarmPool := &orchestratormocks.MockWarmPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockSessionRestorer := &orchestratormocks.MockSessionRestorer{}
+	defer mockSessionRestorer.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	ctx := t.Context()
 	interruptC := getInterruptChannel()
@@ -846,6 +1023,21 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		Return(false).
 		Once()
 
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(true).
+		Once()
+
+	mockSessionRestorer.EXPECT().
+		RestoreMATLABSessions(ctx, mockLogger.AsMockArg()).
+		Return().
+		Once()
+
+	mockWarmPool.EXPECT().
+		Start(ctx, mockLogger.AsMockArg()).
+		Return().
+		Once()
+
 	mockSignalLayer.EXPECT().
 		InterruptSignalChan().
 		Return(interruptC).
@@ -875,6 +1067,8 @@ func TestOrchestrator_runMATLABMCPServerMain_MultipleSession_HappyPath(t *testin
 		mockSignalLayer,
 		mockGlobalMATLABManager,
 		mockDirectory,
+		mockWarmPool,
+		mockSessionRestorer,
 	)
 
 	// Act
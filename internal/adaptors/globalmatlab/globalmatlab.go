@@ -4,6 +4,7 @@ package globalmatlab
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -27,22 +28,41 @@ type MATLABStartingDirSelector interface {
 	SelectMatlabStartingDir() (string, error)
 }
 
+type Config interface {
+	MATLABSessionPerClient() bool
+	DisplayMode() entities.DisplayMode
+}
+
 type GlobalMATLAB struct {
 	matlabManager             MATLABManager
 	matlabRootSelector        MATLABRootSelector
 	vmcRootSelector           VMCRootSelector
 	matlabStartingDirSelector MATLABStartingDirSelector
+	matlabSessionPerClient    bool
+	showMATLABDesktop         bool
 
 	lock              *sync.Mutex
 	initializeOnce    *sync.Once
 	matlabRoot        string
 	vmcRoot           string
 	matlabStartingDir string
-	sessionID         entities.SessionID
 	cachedStartupErr  error
+
+	// MATLAB sessions, keyed by the MCP session using them.
+	// When MATLAB sessions are shared between clients, there is a single entry with a nil key.
+	matlabSessions map[entities.MCPSession]*matlabSession
+}
+
+// matlabSession tracks the lazily started MATLAB session of one MCP client, or of all clients when shared.
+type matlabSession struct {
+	lock             *sync.Mutex
+	sessionID        entities.SessionID
+	cachedStartupErr error
+	closed           bool
 }
 
 func New(
+	config Config,
 	matlabManager MATLABManager,
 	matlabRootSelector MATLABRootSelector,
 	vmcRootSelector VMCRootSelector,
@@ -53,13 +73,36 @@ func New(
 		matlabRootSelector:        matlabRootSelector,
 		vmcRootSelector:           vmcRootSelector,
 		matlabStartingDirSelector: matlabStartingDirSelector,
+		matlabSessionPerClient:    config.MATLABSessionPerClient(),
+		showMATLABDesktop:         config.DisplayMode() == entities.DisplayModeDesktop,
 
 		lock:           &sync.Mutex{},
 		initializeOnce: &sync.Once{},
+		matlabSessions: make(map[entities.MCPSession]*matlabSession),
+	}
+}
+
+func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger, mcpSession entities.MCPSession) (entities.MATLABSessionClient, error) {
+	session, err := g.matlabSessionFor(ctx, logger, mcpSession)
+	if err != nil {
+		return nil, err
+	}
+
+	session.lock.Lock()
+	defer session.lock.Unlock()
+
+	if session.closed {
+		return nil, errors.New("MCP session is closed")
 	}
+
+	if session.cachedStartupErr != nil {
+		return nil, session.cachedStartupErr
+	}
+
+	return g.getOrCreateClient(ctx, logger, session)
 }
 
-func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+func (g *GlobalMATLAB) matlabSessionFor(ctx context.Context, logger entities.Logger, mcpSession entities.MCPSession) (*matlabSession, error) {
 	g.lock.Lock()
 	defer g.lock.Unlock()
 
@@ -74,52 +117,102 @@ func (g *GlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (enti
 		return nil, g.cachedStartupErr
 	}
 
-	return g.getOrCreateClient(ctx, logger)
+	// Clients share the MATLAB session stored under the nil key, unless each gets its own.
+	if !g.matlabSessionPerClient {
+		mcpSession = nil
+	}
+
+	session, found := g.matlabSessions[mcpSession]
+	if !found {
+		session = &matlabSession{
+			lock: &sync.Mutex{},
+		}
+		g.matlabSessions[mcpSession] = session
+
+		if mcpSession != nil {
+			go g.stopOnDisconnect(mcpSession, session, logger)
+		}
+	}
+
+	return session, nil
 }
 
-func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
+// stopOnDisconnect waits for the MCP client to disconnect, and then stops the MATLAB session it was using.
+func (g *GlobalMATLAB) stopOnDisconnect(mcpSession entities.MCPSession, session *matlabSession, logger entities.Logger) {
+	logger = logger.With("mcp-session-id", mcpSession.ID())
+
+	if err := mcpSession.Wait(); err != nil {
+		logger.WithError(err).Debug("MCP session ended with an error")
+	}
+
+	g.lock.Lock()
+	delete(g.matlabSessions, mcpSession)
+	g.lock.Unlock()
+
+	session.lock.Lock()
+	defer session.lock.Unlock()
+
+	session.closed = true
+
+	var sessionIDZeroValue entities.SessionID
+	if session.sessionID == sessionIDZeroValue {
+		return
+	}
+
+	logger.Info("MCP client disconnected, stopping its MATLAB session")
+	if err := g.matlabManager.StopMATLABSession(context.Background(), logger, session.sessionID); err != nil {
+		logger.WithError(err).Warn("failed to stop MATLAB session")
+	}
+	session.sessionID = sessionIDZeroValue
+}
+
+func (g *GlobalMATLAB) getOrCreateClient(ctx context.Context, logger entities.Logger, session *matlabSession) (entities.MATLABSessionClient, error) {
 	var sessionIDZeroValue entities.SessionID
 
 	// Start MATLAB if we don't have a session
-	if g.sessionID == sessionIDZeroValue {
-		if err := g.startNewSession(ctx, logger); err != nil {
-			g.cachedStartupErr = err
+	if session.sessionID == sessionIDZeroValue {
+		if err := g.startNewSession(ctx, logger, session); err != nil {
+			session.cachedStartupErr = err
 			return nil, err
 		}
 	}
 
 	// Try to get the client
-	client, err := g.matlabManager.GetMATLABSessionClient(ctx, logger, g.sessionID)
+	client, err := g.matlabManager.GetMATLABSessionClient(ctx, logger, session.sessionID)
 	if err != nil {
 		// Retry: stop old session and start a new one
-		if stopErr := g.matlabManager.StopMATLABSession(ctx, logger, g.sessionID); stopErr != nil {
+		if stopErr := g.matlabManager.StopMATLABSession(ctx, logger, session.sessionID); stopErr != nil {
 			logger.WithError(stopErr).Warn("failed to stop MATLAB session")
 		}
 
-		if err := g.startNewSession(ctx, logger); err != nil {
-			g.cachedStartupErr = err
+		if err := g.startNewSession(ctx, logger, session); err != nil {
+			session.cachedStartupErr = err
 			return nil, err
 		}
 
-		return g.matlabManager.GetMATLABSessionClient(ctx, logger, g.sessionID)
+		return g.matlabManager.GetMATLABSessionClient(ctx, logger, session.sessionID)
 	}
 
 	return client, nil
 }
 
-func (g *GlobalMATLAB) startNewSession(ctx context.Context, logger entities.Logger) error {
+func (g *GlobalMATLAB) startNewSession(ctx context.Context, logger entities.Logger, session *matlabSession) error {
+	entities.ReportProgress(ctx, "Starting MATLAB, this can take a few minutes")
+
 	sessionID, err := g.matlabManager.StartMATLABSession(ctx, logger, entities.LocalSessionDetails{
 		MATLABRoot:             g.matlabRoot,
 		VMCRoot:                g.vmcRoot,
 		IsStartingDirectorySet: g.matlabStartingDir != "",
 		StartingDirectory:      g.matlabStartingDir,
-		ShowMATLABDesktop:      true,
+		ShowMATLABDesktop:      g.showMATLABDesktop,
 	})
 	if err != nil {
 		return err
 	}
 
-	g.sessionID = sessionID
+	entities.ReportProgress(ctx, "MATLAB session started")
+
+	session.sessionID = sessionID
 	return nil
 }
 
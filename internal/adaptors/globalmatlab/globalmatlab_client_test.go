@@ -22,12 +22,18 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -50,6 +56,11 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -65,32 +76,135 @@ func TestGlobalMATLAB_Client_HappyPath(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	require.NotNil(t, globalMATLABSession)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedSessionClient, client)
 }
 
+func TestGlobalMATLAB_Client_WithoutDesktop(t *testing.T) {
+	for _, displayMode := range []entities.DisplayMode{entities.DisplayModeNoDesktop, entities.DisplayModeVirtual} {
+		t.Run(string(displayMode), func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockMATLABManager := &mocks.MockMATLABManager{}
+			defer mockMATLABManager.AssertExpectations(t)
+
+			mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+			defer mockMATLABRootSelector.AssertExpectations(t)
+
+			mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+			defer mockVMCRootSelector.AssertExpectations(t)
+
+			mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+			defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+			expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+			ctx := t.Context()
+			expectedSessionID := entities.SessionID(123)
+			expectedMATLABRoot := filepath.Join("some", "matlab", "root")
+
+			expectedLocalSessionDetails := entities.LocalSessionDetails{
+				MATLABRoot:        expectedMATLABRoot,
+				ShowMATLABDesktop: false,
+			}
+
+			mockMATLABRootSelector.EXPECT().
+				SelectMATLABRoot(ctx, mockLogger.AsMockArg()).
+				Return(expectedMATLABRoot, nil).
+				Once()
+
+			mockVMCRootSelector.EXPECT().
+				SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+				Return("").
+				Once()
+
+			mockMATLABStartingDirSelector.EXPECT().
+				SelectMatlabStartingDir().
+				Return("", nil).
+				Once()
+
+			mockMATLABManager.EXPECT().
+				StartMATLABSession(mock.Anything, mockLogger.AsMockArg(), expectedLocalSessionDetails).
+				Return(expectedSessionID, nil).
+				Once()
+
+			mockMATLABManager.EXPECT().
+				GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+				Return(expectedSessionClient, nil).
+				Once()
+
+			mockConfig.EXPECT().
+				MATLABSessionPerClient().
+				Return(false).
+				Once()
+
+			mockConfig.EXPECT().
+				DisplayMode().
+				Return(displayMode).
+				Once()
+
+			globalMATLABSession := globalmatlab.New(
+				mockConfig,
+				mockMATLABManager,
+				mockMATLABRootSelector,
+				mockVMCRootSelector,
+				mockMATLABStartingDirSelector,
+			)
+
+			// Act
+			client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, expectedSessionClient, client)
+		})
+	}
+}
+
 func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -113,6 +227,11 @@ func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -128,16 +247,28 @@ func TestGlobalMATLAB_Client_StartingDirectorySet(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	require.NotNil(t, globalMATLABSession)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -148,12 +279,18 @@ func TestGlobalMATLAB_Client_SelectMATLABRootError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -165,14 +302,26 @@ func TestGlobalMATLAB_Client_SelectMATLABRootError(t *testing.T) {
 		Return("", expectedError).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -183,12 +332,18 @@ func TestGlobalMATLAB_Client_MATLABStartingDirSelectionError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -209,6 +364,11 @@ func TestGlobalMATLAB_Client_MATLABStartingDirSelectionError(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return("", assert.AnError).
@@ -224,16 +384,28 @@ func TestGlobalMATLAB_Client_MATLABStartingDirSelectionError(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	require.NotNil(t, globalMATLABSession)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -244,12 +416,18 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -270,6 +448,11 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -280,14 +463,26 @@ func TestGlobalMATLAB_Client_StartMATLABSessionError(t *testing.T) {
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -298,12 +493,18 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -326,6 +527,11 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -356,14 +562,26 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
@@ -374,12 +592,18 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -403,6 +627,11 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -433,14 +662,26 @@ func TestGlobalMATLAB_Client_GetMATLABSessionClientError_RetrySucceeds(t *testin
 		Return(expectedSessionClient, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	// Act
-	client, err := globalMATLABSession.Client(ctx, mockLogger)
+	client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -451,21 +692,39 @@ func TestGlobalMATLAB_Client_ReturnsInitializeCachedErrorOnSubsequentClientCalls
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
 	ctx := t.Context()
 	expectedError := assert.AnError
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
@@ -475,8 +734,8 @@ func TestGlobalMATLAB_Client_ReturnsInitializeCachedErrorOnSubsequentClientCalls
 		Once()
 
 	// Act
-	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
-	client2, err2 := globalMATLABSession.Client(ctx, mockLogger)
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger, nil)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	assert.Nil(t, client1)
@@ -490,12 +749,18 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -516,6 +781,11 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -526,15 +796,27 @@ func TestGlobalMATLAB_Client_ReturnsMATLABStartupCachedErrorOnSubsequentClientCa
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	// Act
-	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
-	client2, err2 := globalMATLABSession.Client(ctx, mockLogger)
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger, nil)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	assert.Nil(t, client1)
@@ -548,12 +830,18 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -587,6 +875,11 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -611,22 +904,34 @@ func TestGlobalMATLAB_Client_ConcurrentCallsWaitForCompletion(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
 	// Act
 	go func() {
-		client, err := globalMATLABSession.Client(ctx, mockLogger)
+		client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 		firstCallCompleted <- clientResult{client: client, err: err}
 	}()
 
 	<-startMATLABCalled
 
 	go func() {
-		client, err := globalMATLABSession.Client(ctx, mockLogger)
+		client, err := globalMATLABSession.Client(ctx, mockLogger, nil)
 		secondCallCompleted <- clientResult{client: client, err: err}
 	}()
 
@@ -653,12 +958,18 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -682,6 +993,11 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -717,14 +1033,24 @@ func TestGlobalMATLAB_Client_RestartOnGetClientFailure(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(mockConfig, mockMATLABManager, mockMATLABRootSelector, mockVMCRootSelector, mockMATLABStartingDirSelector)
 
 	// Act
-	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger, nil)
 	require.NoError(t, err1)
 	assert.Equal(t, expectedSessionClient, client1)
 
-	client2, err2 := globalMATLABSession.Client(ctx, mockLogger)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.NoError(t, err2)
@@ -735,12 +1061,18 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -764,6 +1096,11 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -799,14 +1136,24 @@ func TestGlobalMATLAB_Client_DoesNotErrorIfStopSessionError(t *testing.T) {
 		Return(expectedSessionClient, nil).
 		Once()
 
-	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(mockConfig, mockMATLABManager, mockMATLABRootSelector, mockVMCRootSelector, mockMATLABStartingDirSelector)
 
 	// Act
-	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger, nil)
 	require.NoError(t, err1)
 	assert.Equal(t, expectedSessionClient, client1)
 
-	client2, err2 := globalMATLABSession.Client(ctx, mockLogger)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.NoError(t, err2)
@@ -817,12 +1164,18 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
@@ -846,6 +1199,11 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 		Return(expectedMATLABRoot, nil).
 		Once()
 
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger.AsMockArg()).
+		Return("").
+		Once()
+
 	mockMATLABStartingDirSelector.EXPECT().
 		SelectMatlabStartingDir().
 		Return(expectedMATLABStartingDir, nil).
@@ -876,16 +1234,274 @@ func TestGlobalMATLAB_Client_RestartFailure_OnExistingSession(t *testing.T) {
 		Return(entities.SessionID(0), expectedError).
 		Once()
 
-	globalMATLABSession := globalmatlab.New(mockMATLABManager, mockMATLABRootSelector, mockMATLABStartingDirSelector)
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(mockConfig, mockMATLABManager, mockMATLABRootSelector, mockVMCRootSelector, mockMATLABStartingDirSelector)
 
 	// Act
-	client1, err1 := globalMATLABSession.Client(ctx, mockLogger)
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger, nil)
 	require.NoError(t, err1)
 	assert.Equal(t, expectedSessionClient, client1)
 
-	client2, err2 := globalMATLABSession.Client(ctx, mockLogger)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger, nil)
 
 	// Assert
 	require.ErrorIs(t, err2, expectedError)
 	assert.Nil(t, client2)
 }
+
+func TestGlobalMATLAB_Client_SessionPerClient_StartsAndStopsOneMATLABPerMCPSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockMCPSession1 := &entitiesmocks.MockMCPSession{}
+	defer mockMCPSession1.AssertExpectations(t)
+
+	mockMCPSession2 := &entitiesmocks.MockMCPSession{}
+	defer mockMCPSession2.AssertExpectations(t)
+
+	mockLogger1 := testutils.NewInspectableLogger()
+	mockLogger2 := testutils.NewInspectableLogger()
+
+	expectedSessionClient1 := &entitiesmocks.MockMATLABSessionClient{}
+	expectedSessionClient2 := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID1 := entities.SessionID(1)
+	expectedSessionID2 := entities.SessionID(2)
+	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
+
+	expectedLocalSessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:        expectedMATLABRoot,
+		ShowMATLABDesktop: true,
+	}
+
+	disconnect1 := make(chan struct{})
+	disconnect2 := make(chan struct{})
+	stopped := make(chan entities.SessionID, 2)
+
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger1.AsMockArg()).
+		Return(expectedMATLABRoot, nil).
+		Once()
+
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger1.AsMockArg()).
+		Return("").
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMCPSession1.EXPECT().
+		ID().
+		Return("session-1").
+		Once()
+
+	mockMCPSession1.EXPECT().
+		Wait().
+		RunAndReturn(func() error {
+			<-disconnect1
+			return nil
+		}).
+		Once()
+
+	mockMCPSession2.EXPECT().
+		ID().
+		Return("session-2").
+		Once()
+
+	mockMCPSession2.EXPECT().
+		Wait().
+		RunAndReturn(func() error {
+			<-disconnect2
+			return nil
+		}).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger1.AsMockArg(), expectedLocalSessionDetails).
+		Return(expectedSessionID1, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger2.AsMockArg(), expectedLocalSessionDetails).
+		Return(expectedSessionID2, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger1.AsMockArg(), expectedSessionID1).
+		Return(expectedSessionClient1, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger2.AsMockArg(), expectedSessionID2).
+		Return(expectedSessionClient2, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(mock.Anything, mockLogger1.AsMockArg(), expectedSessionID1).
+		Run(func(ctx context.Context, logger entities.Logger, sessionID entities.SessionID) {
+			stopped <- sessionID
+		}).
+		Return(nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(mock.Anything, mockLogger2.AsMockArg(), expectedSessionID2).
+		Run(func(ctx context.Context, logger entities.Logger, sessionID entities.SessionID) {
+			stopped <- sessionID
+		}).
+		Return(nil).
+		Once()
+
+	globalMATLABSession := globalmatlab.New(
+		mockConfig,
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockVMCRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	// Act
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger1, mockMCPSession1)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger2, mockMCPSession2)
+
+	// Assert
+	require.NoError(t, err1)
+	assert.Equal(t, expectedSessionClient1, client1)
+
+	require.NoError(t, err2)
+	assert.Equal(t, expectedSessionClient2, client2)
+
+	close(disconnect1)
+	assert.Equal(t, expectedSessionID1, <-stopped, "Disconnecting the first client should stop its MATLAB session only")
+
+	close(disconnect2)
+	assert.Equal(t, expectedSessionID2, <-stopped)
+}
+
+func TestGlobalMATLAB_Client_SharedSession_IgnoresMCPSession(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockMATLABManager := &mocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
+	defer mockMATLABRootSelector.AssertExpectations(t)
+
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
+	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
+	defer mockMATLABStartingDirSelector.AssertExpectations(t)
+
+	mockMCPSession1 := &entitiesmocks.MockMCPSession{}
+	defer mockMCPSession1.AssertExpectations(t)
+
+	mockMCPSession2 := &entitiesmocks.MockMCPSession{}
+	defer mockMCPSession2.AssertExpectations(t)
+
+	mockLogger1 := testutils.NewInspectableLogger()
+	mockLogger2 := testutils.NewInspectableLogger()
+
+	expectedSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+
+	ctx := t.Context()
+	expectedSessionID := entities.SessionID(123)
+	expectedMATLABRoot := filepath.Join("some", "matlab", "root")
+
+	expectedLocalSessionDetails := entities.LocalSessionDetails{
+		MATLABRoot:        expectedMATLABRoot,
+		ShowMATLABDesktop: true,
+	}
+
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockMATLABRootSelector.EXPECT().
+		SelectMATLABRoot(ctx, mockLogger1.AsMockArg()).
+		Return(expectedMATLABRoot, nil).
+		Once()
+
+	mockVMCRootSelector.EXPECT().
+		SelectVMCRoot(ctx, mockLogger1.AsMockArg()).
+		Return("").
+		Once()
+
+	mockMATLABStartingDirSelector.EXPECT().
+		SelectMatlabStartingDir().
+		Return("", nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger1.AsMockArg(), expectedLocalSessionDetails).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mock.Anything, expectedSessionID).
+		Return(expectedSessionClient, nil).
+		Twice()
+
+	globalMATLABSession := globalmatlab.New(
+		mockConfig,
+		mockMATLABManager,
+		mockMATLABRootSelector,
+		mockVMCRootSelector,
+		mockMATLABStartingDirSelector,
+	)
+
+	// Act
+	client1, err1 := globalMATLABSession.Client(ctx, mockLogger1, mockMCPSession1)
+	client2, err2 := globalMATLABSession.Client(ctx, mockLogger2, mockMCPSession2)
+
+	// Assert
+	require.NoError(t, err1)
+	assert.Equal(t, expectedSessionClient, client1)
+
+	require.NoError(t, err2)
+	assert.Equal(t, expectedSessionClient, client2)
+}
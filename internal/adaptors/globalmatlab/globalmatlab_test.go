@@ -6,25 +6,44 @@ import (
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/globalmatlab"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/globalmatlab"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockMATLABManager := &mocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
 	mockMATLABRootSelector := &mocks.MockMATLABRootSelector{}
 	defer mockMATLABRootSelector.AssertExpectations(t)
 
+	mockVMCRootSelector := &mocks.MockVMCRootSelector{}
+	defer mockVMCRootSelector.AssertExpectations(t)
+
 	mockMATLABStartingDirSelector := &mocks.MockMATLABStartingDirSelector{}
 	defer mockMATLABStartingDirSelector.AssertExpectations(t)
 
 	// Act
+	mockConfig.EXPECT().
+		MATLABSessionPerClient().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
 	globalMATLABSession := globalmatlab.New(
+		mockConfig,
 		mockMATLABManager,
 		mockMATLABRootSelector,
+		mockVMCRootSelector,
 		mockMATLABStartingDirSelector,
 	)
 
@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// attachToSession connects a client to a MATLAB session that was started outside of the server.
+// The server does not own that MATLAB, so stopping the session only detaches from it.
+func attachToSession(
+	sessionLogger entities.Logger,
+	matlabServices MATLABServices,
+	clientFactory MATLABSessionClientFactory,
+	_ entities.AttachedSessionDetails,
+) (matlabsessionstore.MATLABSessionClientWithCleanup, entities.MATLABSessionInfo, error) {
+	attachedSession, err := matlabServices.AttachToMATLABSession(sessionLogger, datatypes.AttachedSessionDetails{})
+	if err != nil {
+		return nil, entities.MATLABSessionInfo{}, err
+	}
+
+	embeddedConnectorClient, err := clientFactory.New(attachedSession.Endpoint)
+	if err != nil {
+		return nil, entities.MATLABSessionInfo{}, err
+	}
+
+	info := entities.MATLABSessionInfo{
+		MATLABRoot: attachedSession.MATLABRoot,
+		Release:    attachedSession.Release,
+		ProcessID:  attachedSession.ProcessID,
+		StartTime:  time.Now(),
+		Attached:   true,
+	}
+
+	return &attachedMATLABSessionClient{MATLABSessionClient: embeddedConnectorClient}, info, nil
+}
+
+type attachedMATLABSessionClient struct {
+	entities.MATLABSessionClient
+}
+
+func (c *attachedMATLABSessionClient) StopSession(_ context.Context, sessionLogger entities.Logger) error {
+	sessionLogger.Info("Detached from MATLAB session, MATLAB keeps running")
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABManager_StartMATLABSession_Attached_HappyPath(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	expectedSessionID := entities.SessionID(7)
+	attachedSession := datatypes.AttachedSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host: "localhost",
+			Port: "31515",
+		},
+		ProcessID:  2468,
+		MATLABRoot: filepath.Join("path", "to", "matlab", "R2024b"),
+		Release:    "R2024b",
+	}
+
+	mockMATLABServices.EXPECT().
+		AttachToMATLABSession(mock.Anything, datatypes.AttachedSessionDetails{}).
+		Return(attachedSession, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(attachedSession.Endpoint).
+		Return(mockSessionClient, nil).
+		Once()
+
+	var storedClient matlabsessionstore.MATLABSessionClientWithCleanup
+	var storedInfo entities.MATLABSessionInfo
+	mockSessionStore.EXPECT().
+		Add(mock.Anything, mock.Anything).
+		Run(func(client matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) {
+			storedClient = client
+			storedInfo = info
+		}).
+		Return(expectedSessionID).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, entities.AttachedSessionDetails{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+	assert.True(t, storedInfo.Attached)
+	assert.Equal(t, attachedSession.MATLABRoot, storedInfo.MATLABRoot)
+	assert.Equal(t, "R2024b", storedInfo.Release)
+	assert.Equal(t, 2468, storedInfo.ProcessID)
+	assert.WithinDuration(t, time.Now(), storedInfo.StartTime, time.Minute)
+
+	// Stopping an attached session must not stop MATLAB, so the client is not asked to do anything.
+	require.NoError(t, storedClient.StopSession(ctx, mockLogger))
+}
+
+func TestMATLABManager_StartMATLABSession_Attached_AttachError(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+
+	mockMATLABServices.EXPECT().
+		AttachToMATLABSession(mock.Anything, datatypes.AttachedSessionDetails{}).
+		Return(datatypes.AttachedSession{}, entities.ErrMATLABSessionNotShared).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, entities.AttachedSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABSessionNotShared)
+	assert.Zero(t, sessionID)
+}
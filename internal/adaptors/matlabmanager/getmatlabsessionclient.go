@@ -20,5 +20,7 @@ func (m *MATLABManager) GetMATLABSessionClient(ctx context.Context, sessionLogge
 		return nil, fmt.Errorf("MATLAB session %v is not alive", sessionID)
 	}
 
+	entities.ReportProgress(ctx, "MATLAB session is responding")
+
 	return client, nil
 }
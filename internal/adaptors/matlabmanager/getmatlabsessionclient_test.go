@@ -11,6 +11,7 @@ import (
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
 	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,6 +28,17 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	expectedSessionID := entities.SessionID(123)
@@ -42,7 +54,7 @@ func TestMATLABManager_GetMATLABSessionClient_HappyPath(t *testing.T) {
 		Return(entities.PingResponse{IsAlive: true}).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
@@ -65,6 +77,17 @@ func TestMATLABManager_GetMATLABSessionClient_PingFailure(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	mockSessionClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
 
 	sessionID := entities.SessionID(123)
@@ -80,7 +103,7 @@ func TestMATLABManager_GetMATLABSessionClient_PingFailure(t *testing.T) {
 		Return(entities.PingResponse{IsAlive: false}).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, sessionID)
@@ -104,6 +127,17 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	expectedSessionID := entities.SessionID(123)
 	ctx := t.Context()
 	expectedError := assert.AnError
@@ -113,7 +147,7 @@ func TestMATLABManager_GetMATLABSessionClient_SessionStoreError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 
 	// Act
 	client, err := manager.GetMATLABSessionClient(ctx, mockLogger, expectedSessionID)
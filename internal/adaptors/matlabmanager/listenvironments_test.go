@@ -11,6 +11,7 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,6 +28,17 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	expectedMatlabInfos := []datatypes.MatlabInfo{{
 		Location: filepath.Join("path", "to", "matlab", "R2023a"),
 		Version: datatypes.MatlabVersionInfo{
@@ -52,7 +64,7 @@ func TestMATLABManager_ListEnvironments_HappyPath(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 	ctx := t.Context()
 
 	// Act
@@ -81,6 +93,17 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	mockResponse := datatypes.ListMatlabInfo{
 		MatlabInfo: []datatypes.MatlabInfo{},
 	}
@@ -89,7 +112,7 @@ func TestMATLABManager_ListEnvironments_EmptyList(t *testing.T) {
 		Return(mockResponse).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory)
+	manager := matlabmanager.New(mockMATLABManager, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 	ctx := t.Context()
 
 	// Act
@@ -0,0 +1,17 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+func (m *MATLABManager) ListMATLABSessions(_ context.Context, sessionLogger entities.Logger) []entities.MATLABSessionInfo {
+	sessions := m.sessionStore.Sessions()
+
+	sessionLogger.With("count", len(sessions)).Debug("Listed MATLAB sessions")
+
+	return sessions
+}
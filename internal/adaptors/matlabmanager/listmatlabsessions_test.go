@@ -0,0 +1,75 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMATLABManager_ListMATLABSessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	startTime := time.Date(2025, time.October, 1, 9, 0, 0, 0, time.UTC)
+	expectedSessions := []entities.MATLABSessionInfo{
+		{
+			SessionID:  1,
+			MATLABRoot: filepath.Join("path", "to", "matlab", "R2024b"),
+			Release:    "R2024b",
+			ProcessID:  1234,
+			StartTime:  startTime,
+			LastUsed:   startTime,
+		},
+		{
+			SessionID:  2,
+			MATLABRoot: filepath.Join("path", "to", "matlab", "R2025a"),
+			Release:    "R2025a",
+			ProcessID:  5678,
+			StartTime:  startTime,
+			LastUsed:   startTime.Add(time.Minute),
+			Busy:       true,
+		},
+	}
+
+	mockSessionStore.EXPECT().
+		Sessions().
+		Return(expectedSessions).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	sessions := manager.ListMATLABSessions(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, expectedSessions, sessions)
+}
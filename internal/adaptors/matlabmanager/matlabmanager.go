@@ -3,6 +3,9 @@
 package matlabmanager
 
 import (
+	"context"
+	"sync"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
@@ -11,23 +14,35 @@ import (
 
 type MATLABServices interface {
 	ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo
-	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error)
+	FindPersistedLocalMATLABSessions(logger entities.Logger) ([]datatypes.PersistedLocalSession, error)
+	RestoreLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) func() error
+	DiscardLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) error
+	AttachToMATLABSession(logger entities.Logger, request datatypes.AttachedSessionDetails) (datatypes.AttachedSession, error)
 }
 
 type MATLABSessionStore interface {
-	Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID
+	Add(client matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) entities.SessionID
 	Get(sessionID entities.SessionID) (matlabsessionstore.MATLABSessionClientWithCleanup, error)
 	Remove(sessionID entities.SessionID)
+	Sessions() []entities.MATLABSessionInfo
 }
 
 type MATLABSessionClientFactory interface {
 	New(endpoint embeddedconnector.ConnectionDetails) (entities.MATLABSessionClient, error)
 }
 
+type WarmSessionPool interface {
+	Take(sessionLogger entities.Logger, request entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, entities.MATLABSessionInfo, bool)
+}
+
 type MATLABManager struct {
 	matlabServices MATLABServices
 	sessionStore   MATLABSessionStore
 	clientFactory  MATLABSessionClientFactory
+	warmPool       WarmSessionPool
+
+	restoring *sync.WaitGroup
 }
 
 var _ entities.MATLABManager = (*MATLABManager)(nil)
@@ -36,10 +51,23 @@ func New(
 	matlabServices MATLABServices,
 	sessionStore MATLABSessionStore,
 	clientFactory MATLABSessionClientFactory,
+	warmPool WarmSessionPool,
+	lifecycleSignaler LifecycleSignaler,
 ) *MATLABManager {
-	return &MATLABManager{
+	manager := &MATLABManager{
 		matlabServices: matlabServices,
 		sessionStore:   sessionStore,
 		clientFactory:  clientFactory,
+		warmPool:       warmPool,
+
+		restoring: new(sync.WaitGroup),
 	}
+
+	// The server must not exit while sessions left by an earlier server are still being checked and added to the store.
+	lifecycleSignaler.AddShutdownFunction(func() error {
+		manager.restoring.Wait()
+		return nil
+	})
+
+	return manager
 }
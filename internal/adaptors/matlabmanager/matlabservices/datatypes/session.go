@@ -2,6 +2,13 @@
 
 package datatypes
 
+import (
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
 type SessionID int
 
 type LocalSessionDetails struct {
@@ -11,3 +18,33 @@ type LocalSessionDetails struct {
 	StartingDirectory      string
 	ShowMATLABDesktop      bool
 }
+
+// LocalSession describes a local MATLAB session once it has started.
+// ProcessMonitor reports when the MATLAB process exits; it is nil for sessions whose process the server did not launch.
+type LocalSession struct {
+	Endpoint          embeddedconnector.ConnectionDetails
+	ProcessID         int
+	Release           string
+	StartingDirectory string
+	ProcessMonitor    *entities.MATLABProcessMonitor
+}
+
+// PersistedLocalSession describes a local MATLAB session recorded by an earlier server running with persistent sessions.
+type PersistedLocalSession struct {
+	LocalSession
+	MATLABRoot       string
+	VMCRoot          string
+	StartTime        time.Time
+	SessionDirectory string
+	DisplayProcessID int
+}
+
+type AttachedSessionDetails struct{}
+
+// AttachedSession describes a MATLAB session that was started outside of the server and shared with it.
+type AttachedSession struct {
+	Endpoint   embeddedconnector.ConnectionDetails
+	ProcessID  int
+	MATLABRoot string
+	Release    string
+}
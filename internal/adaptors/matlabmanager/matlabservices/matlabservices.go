@@ -3,8 +3,9 @@
 package matlabservices
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
@@ -13,20 +14,30 @@ type MATLABLocator interface {
 }
 
 type LocalMATLABSessionLauncher interface {
-	StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)
+	StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error)
+	FindPersistedLocalMATLABSessions(logger entities.Logger) ([]datatypes.PersistedLocalSession, error)
+	RestoreLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) func() error
+	DiscardLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) error
+}
+
+type MATLABSessionAttacher interface {
+	AttachToMATLABSession(logger entities.Logger, request datatypes.AttachedSessionDetails) (datatypes.AttachedSession, error)
 }
 
 type MATLABServices struct {
 	MATLABLocator
 	LocalMATLABSessionLauncher
+	MATLABSessionAttacher
 }
 
 func New(
 	matlabLocator MATLABLocator,
 	localMATLABSessionLauncher LocalMATLABSessionLauncher,
+	matlabSessionAttacher MATLABSessionAttacher,
 ) *MATLABServices {
 	return &MATLABServices{
 		MATLABLocator:              matlabLocator,
 		LocalMATLABSessionLauncher: localMATLABSessionLauncher,
+		MATLABSessionAttacher:      matlabSessionAttacher,
 	}
 }
@@ -0,0 +1,163 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachedmatlabsession
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Files written by matlab_mcp.shareSession
+const (
+	securePortFile  = "connector.securePort"
+	certificateFile = "cert.pem"
+	apiKeyFile      = "connector.apiKey"
+	matlabRootFile  = "matlab.root"
+	processIDFile   = "matlab.pid"
+)
+
+const matlabMCPPackage = "+matlab_mcp"
+
+// sharedSessionDir is the folder of the base directory that MATLAB sessions are shared in.
+// It is created only accessible to the user, so that no one else can read the connection details.
+const sharedSessionDir = "shared-session"
+
+type ApplicationDirectory interface {
+	BaseDir() string
+}
+
+type OSLayer interface {
+	MkdirAll(name string, perm os.FileMode) error
+	ReadFile(filePath string) ([]byte, error)
+	WriteFile(name string, data []byte, perm os.FileMode) error
+}
+
+type MATLABFiles interface {
+	GetAll() map[string][]byte
+}
+
+type MATLABVersionGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
+}
+
+type Attacher struct {
+	applicationDirectory ApplicationDirectory
+	osLayer              OSLayer
+	matlabFiles          MATLABFiles
+	matlabVersionGetter  MATLABVersionGetter
+}
+
+func NewAttacher(
+	applicationDirectory ApplicationDirectory,
+	osLayer OSLayer,
+	matlabFiles MATLABFiles,
+	matlabVersionGetter MATLABVersionGetter,
+) *Attacher {
+	return &Attacher{
+		applicationDirectory: applicationDirectory,
+		osLayer:              osLayer,
+		matlabFiles:          matlabFiles,
+		matlabVersionGetter:  matlabVersionGetter,
+	}
+}
+
+// AttachToMATLABSession reads the connection details that matlab_mcp.shareSession wrote to the shared session folder of the base directory.
+// If the folder has none, the matlab_mcp package is written to it, and the error explains how to share a MATLAB session there.
+func (a *Attacher) AttachToMATLABSession(logger entities.Logger, _ datatypes.AttachedSessionDetails) (datatypes.AttachedSession, error) {
+	sessionFolder := filepath.Join(a.applicationDirectory.BaseDir(), sharedSessionDir)
+
+	logger = logger.With("session_folder", sessionFolder)
+	logger.Debug("Attaching to a MATLAB session")
+
+	securePort, err := a.readDetail(sessionFolder, securePortFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err := a.writeMATLABMCPPackage(sessionFolder); err != nil {
+			return datatypes.AttachedSession{}, err
+		}
+		logger.Info("MATLAB session is not shared yet, wrote the matlab_mcp package to the session folder")
+		return datatypes.AttachedSession{}, fmt.Errorf(`%w: in the MATLAB session to attach to, run addpath("%s"); matlab_mcp.shareSession(), then attach again`, entities.ErrMATLABSessionNotShared, sessionFolder)
+	}
+	if err != nil {
+		return datatypes.AttachedSession{}, err
+	}
+
+	certificatePEM, err := a.readDetail(sessionFolder, certificateFile)
+	if err != nil {
+		return datatypes.AttachedSession{}, err
+	}
+
+	apiKey, err := a.readDetail(sessionFolder, apiKeyFile)
+	if err != nil {
+		return datatypes.AttachedSession{}, err
+	}
+
+	attachedSession := datatypes.AttachedSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           strings.TrimSpace(string(securePort)),
+			APIKey:         strings.TrimSpace(string(apiKey)),
+			CertificatePEM: certificatePEM,
+		},
+	}
+
+	// The remaining details only describe the session, so attaching does not depend on them.
+	if matlabRoot, err := a.readDetail(sessionFolder, matlabRootFile); err != nil {
+		logger.WithError(err).Debug("Failed to read the MATLAB root of the shared session")
+	} else {
+		attachedSession.MATLABRoot = strings.TrimSpace(string(matlabRoot))
+	}
+
+	if processID, err := a.readDetail(sessionFolder, processIDFile); err != nil {
+		logger.WithError(err).Debug("Failed to read the process ID of the shared session")
+	} else if attachedSession.ProcessID, err = strconv.Atoi(strings.TrimSpace(string(processID))); err != nil {
+		logger.WithError(err).Debug("Failed to parse the process ID of the shared session")
+	}
+
+	if attachedSession.MATLABRoot != "" {
+		if versionInfo, err := a.matlabVersionGetter.Get(attachedSession.MATLABRoot); err != nil {
+			logger.WithError(err).Debug("Failed to read the MATLAB release")
+		} else {
+			attachedSession.Release = versionInfo.ReleaseFamily
+		}
+	}
+
+	return attachedSession, nil
+}
+
+func (a *Attacher) readDetail(sessionFolder string, fileName string) ([]byte, error) {
+	content, err := a.osLayer.ReadFile(filepath.Join(sessionFolder, fileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from the session folder: %w", fileName, err)
+	}
+
+	if len(content) == 0 {
+		return nil, fmt.Errorf("%s in the session folder is empty, call matlab_mcp.shareSession again", fileName)
+	}
+
+	return content, nil
+}
+
+func (a *Attacher) writeMATLABMCPPackage(sessionFolder string) error {
+	packagePath := filepath.Join(sessionFolder, matlabMCPPackage)
+
+	if err := a.osLayer.MkdirAll(packagePath, 0o700); err != nil {
+		return fmt.Errorf("failed to create package directory: %w", err)
+	}
+
+	for fileName, fileContent := range a.matlabFiles.GetAll() {
+		if err := a.osLayer.WriteFile(filepath.Join(packagePath, fileName), fileContent, 0o600); err != nil {
+			return fmt.Errorf("failed to create %s file: %w", fileName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,337 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachedmatlabsession_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/attachedmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/attachedmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttacher_HappyPath(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	// Act
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Assert
+	assert.NotNil(t, attacher)
+}
+
+func TestAttacher_AttachToMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("home", "user", ".matlab-mcp")
+	sessionFolder := filepath.Join(baseDir, "shared-session")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+	matlabRoot := filepath.Join("opt", "matlab", "R2024b")
+	certificatePEM := []byte("-----BEGIN CERTIFICATE-----\n")
+
+	expectReadFile(mockOSLayer, sessionFolder, "connector.securePort", []byte("31515\n"))
+	expectReadFile(mockOSLayer, sessionFolder, "cert.pem", certificatePEM)
+	expectReadFile(mockOSLayer, sessionFolder, "connector.apiKey", []byte("api-key\n"))
+	expectReadFile(mockOSLayer, sessionFolder, "matlab.root", []byte(matlabRoot+"\n"))
+	expectReadFile(mockOSLayer, sessionFolder, "matlab.pid", []byte("2468\n"))
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(matlabRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Act
+	attachedSession, err := attacher.AttachToMATLABSession(mockLogger, datatypes.AttachedSessionDetails{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, datatypes.AttachedSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           "31515",
+			APIKey:         "api-key",
+			CertificatePEM: certificatePEM,
+		},
+		ProcessID:  2468,
+		MATLABRoot: matlabRoot,
+		Release:    "R2024b",
+	}, attachedSession)
+}
+
+func TestAttacher_AttachToMATLABSession_OptionalDetailsMissing(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("home", "user", ".matlab-mcp")
+	sessionFolder := filepath.Join(baseDir, "shared-session")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	expectReadFile(mockOSLayer, sessionFolder, "connector.securePort", []byte("31515"))
+	expectReadFile(mockOSLayer, sessionFolder, "cert.pem", []byte("cert"))
+	expectReadFile(mockOSLayer, sessionFolder, "connector.apiKey", []byte("api-key"))
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionFolder, "matlab.root")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionFolder, "matlab.pid")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Act
+	attachedSession, err := attacher.AttachToMATLABSession(mockLogger, datatypes.AttachedSessionDetails{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "31515", attachedSession.Endpoint.Port)
+	assert.Empty(t, attachedSession.MATLABRoot)
+	assert.Empty(t, attachedSession.Release)
+	assert.Zero(t, attachedSession.ProcessID)
+}
+
+func TestAttacher_AttachToMATLABSession_NotShared(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("home", "user", ".matlab-mcp")
+	sessionFolder := filepath.Join(baseDir, "shared-session")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+	packageDir := filepath.Join(sessionFolder, "+matlab_mcp")
+	expectedMATLABFiles := map[string][]byte{
+		"shareSession.m": []byte("function shareSession()"),
+	}
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionFolder, "connector.securePort")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll(packageDir, os.FileMode(0o700)).
+		Return(nil).
+		Once()
+
+	mockMATLABFiles.EXPECT().
+		GetAll().
+		Return(expectedMATLABFiles).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(packageDir, "shareSession.m"), expectedMATLABFiles["shareSession.m"], os.FileMode(0o600)).
+		Return(nil).
+		Once()
+
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Act
+	attachedSession, err := attacher.AttachToMATLABSession(mockLogger, datatypes.AttachedSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABSessionNotShared)
+	assert.Contains(t, err.Error(), "matlab_mcp.shareSession()")
+	assert.Contains(t, err.Error(), sessionFolder)
+	assert.Empty(t, attachedSession)
+}
+
+func TestAttacher_AttachToMATLABSession_NotShared_MkdirAllError(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("home", "user", ".matlab-mcp")
+	sessionFolder := filepath.Join(baseDir, "shared-session")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionFolder, "connector.securePort")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		MkdirAll(filepath.Join(sessionFolder, "+matlab_mcp"), os.FileMode(0o700)).
+		Return(assert.AnError).
+		Once()
+
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Act
+	_, err := attacher.AttachToMATLABSession(mockLogger, datatypes.AttachedSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.NotErrorIs(t, err, entities.ErrMATLABSessionNotShared)
+}
+
+func TestAttacher_AttachToMATLABSession_MissingCertificate(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("home", "user", ".matlab-mcp")
+	sessionFolder := filepath.Join(baseDir, "shared-session")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	expectReadFile(mockOSLayer, sessionFolder, "connector.securePort", []byte("31515"))
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionFolder, "cert.pem")).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Act
+	_, err := attacher.AttachToMATLABSession(mockLogger, datatypes.AttachedSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, os.ErrNotExist)
+	assert.Contains(t, err.Error(), "cert.pem")
+}
+
+func TestAttacher_AttachToMATLABSession_EmptyAPIKey(t *testing.T) {
+	// Arrange
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("home", "user", ".matlab-mcp")
+	sessionFolder := filepath.Join(baseDir, "shared-session")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	expectReadFile(mockOSLayer, sessionFolder, "connector.securePort", []byte("31515"))
+	expectReadFile(mockOSLayer, sessionFolder, "cert.pem", []byte("cert"))
+	expectReadFile(mockOSLayer, sessionFolder, "connector.apiKey", []byte{})
+
+	attacher := attachedmatlabsession.NewAttacher(mockApplicationDirectory, mockOSLayer, mockMATLABFiles, mockMATLABVersionGetter)
+
+	// Act
+	_, err := attacher.AttachToMATLABSession(mockLogger, datatypes.AttachedSessionDetails{})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connector.apiKey")
+}
+
+func expectReadFile(mockOSLayer *mocks.MockOSLayer, sessionFolder string, fileName string, content []byte) {
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionFolder, fileName)).
+		Return(content, nil).
+		Once()
+}
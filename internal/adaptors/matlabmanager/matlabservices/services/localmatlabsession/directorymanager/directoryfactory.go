@@ -12,6 +12,7 @@ import (
 )
 
 type ApplicationDirectory interface {
+	BaseDir() string
 	CreateSubDir(pattern string) (string, error)
 }
 
@@ -20,6 +21,7 @@ type OSLayer interface {
 	RemoveAll(path string) error
 
 	Stat(name string) (osfacade.FileInfo, error)
+	Glob(pattern string) ([]string, error)
 	ReadFile(filePath string) ([]byte, error)
 	WriteFile(name string, data []byte, perm os.FileMode) error
 }
@@ -32,10 +34,14 @@ type Directory interface {
 	Path() string
 	CertificateFile() string
 	CertificateKeyFile() string
-	GetEmbeddedConnectorDetails() (string, []byte, error)
+	GetEmbeddedConnectorDetails(processMonitor *entities.MATLABProcessMonitor) (string, []byte, error)
+	WriteSessionRecord(record SessionRecord) error
+	ReadSessionRecord() (SessionRecord, []byte, error)
 	Cleanup() error
 }
 
+const sessionDirPrefix = "matlab-session-"
+
 type DirectoryFactory struct {
 	osLayer              OSLayer
 	applicationDirectory ApplicationDirectory
@@ -55,7 +61,7 @@ func NewFactory(
 }
 
 func (f *DirectoryFactory) Create(logger entities.Logger) (Directory, error) {
-	sessionDir, err := f.applicationDirectory.CreateSubDir("matlab-session-")
+	sessionDir, err := f.applicationDirectory.CreateSubDir(sessionDirPrefix)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temporary session directory: %w", err)
 	}
@@ -76,3 +82,25 @@ func (f *DirectoryFactory) Create(logger entities.Logger) (Directory, error) {
 
 	return newDirectoryManager(sessionDir, f.osLayer), nil
 }
+
+// FindRecorded returns the session directories, left by any server using the same base directory, that hold a session record.
+func (f *DirectoryFactory) FindRecorded(logger entities.Logger) ([]Directory, error) {
+	recordFiles, err := f.osLayer.Glob(filepath.Join(f.applicationDirectory.BaseDir(), sessionDirPrefix+"*", sessionRecordFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session records: %w", err)
+	}
+
+	directories := make([]Directory, 0, len(recordFiles))
+	for _, recordFile := range recordFiles {
+		sessionDir := filepath.Dir(recordFile)
+		logger.With("session_dir", sessionDir).Debug("Found session record")
+		directories = append(directories, newDirectoryManager(sessionDir, f.osLayer))
+	}
+
+	return directories, nil
+}
+
+// Open returns an existing session directory, such as one found by FindRecorded.
+func (f *DirectoryFactory) Open(sessionDir string) Directory {
+	return newDirectoryManager(sessionDir, f.osLayer)
+}
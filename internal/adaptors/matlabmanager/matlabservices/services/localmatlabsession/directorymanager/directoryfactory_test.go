@@ -210,3 +210,100 @@ func TestDirectoryFactory_Create_WriteFileError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, directory)
 }
+
+func TestDirectoryFactory_FindRecorded_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	baseDir := filepath.Join("tmp", "logs")
+	firstSessionDir := filepath.Join(baseDir, "matlab-session-1-abc")
+	secondSessionDir := filepath.Join(baseDir, "matlab-session-2-def")
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(filepath.Join(baseDir, "matlab-session-*", "session.json")).
+		Return([]string{
+			filepath.Join(firstSessionDir, "session.json"),
+			filepath.Join(secondSessionDir, "session.json"),
+		}, nil).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directories, err := factory.FindRecorded(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, directories, 2)
+	assert.Equal(t, firstSessionDir, directories[0].Path())
+	assert.Equal(t, secondSessionDir, directories[1].Path())
+}
+
+func TestDirectoryFactory_FindRecorded_GlobError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockApplicationDirectory.EXPECT().
+		BaseDir().
+		Return(filepath.Join("tmp", "logs")).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Glob(mock.AnythingOfType("string")).
+		Return(nil, assert.AnError).
+		Once()
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directories, err := factory.FindRecorded(mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, directories)
+}
+
+func TestDirectoryFactory_Open_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockApplicationDirectory := &mocks.MockApplicationDirectory{}
+	defer mockApplicationDirectory.AssertExpectations(t)
+
+	mockMATLABFiles := &mocks.MockMATLABFiles{}
+	defer mockMATLABFiles.AssertExpectations(t)
+
+	expectedSessionDir := filepath.Join("tmp", "logs", "matlab-session-1-abc")
+
+	factory := directorymanager.NewFactory(mockOSLayer, mockApplicationDirectory, mockMATLABFiles)
+
+	// Act
+	directory := factory.Open(expectedSessionDir)
+
+	// Assert
+	assert.Equal(t, expectedSessionDir, directory.Path())
+}
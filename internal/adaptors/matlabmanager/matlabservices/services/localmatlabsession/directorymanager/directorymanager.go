@@ -3,9 +3,12 @@
 package directorymanager
 
 import (
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
 const defaultEmbeddedConnectorDetailsTimeout = 5 * time.Minute
@@ -17,6 +20,20 @@ const defaultCleanupRetry = 500 * time.Millisecond
 const securePortFile = "connector.securePort"
 const certificateFile = "cert.pem"
 const certificateKeyFile = "cert.key"
+const sessionRecordFile = "session.json"
+
+// SessionRecord holds what a later server needs to reconnect to a persistent MATLAB session.
+type SessionRecord struct {
+	Port              string    `json:"port"`
+	APIKey            string    `json:"api_key"`
+	ProcessID         int       `json:"pid"`
+	MATLABRoot        string    `json:"matlab_root"`
+	VMCRoot           string    `json:"vmc_root,omitempty"`
+	Release           string    `json:"release,omitempty"`
+	StartingDirectory string    `json:"starting_directory"`
+	StartTime         time.Time `json:"start_time"`
+	DisplayProcessID  int       `json:"display_pid,omitempty"`
+}
 
 type directoryManager struct {
 	sessionDir string
@@ -52,18 +69,34 @@ func (m *directoryManager) CertificateKeyFile() string {
 	return filepath.Join(m.sessionDir, certificateKeyFile)
 }
 
-func (m *directoryManager) GetEmbeddedConnectorDetails() (string, []byte, error) {
+// GetEmbeddedConnectorDetails waits for MATLAB to write the port and certificate of its embedded connector.
+// When processMonitor is set, it also watches the MATLAB process and its logs while it waits, and returns
+// an *entities.MATLABStartupError as soon as the process exits or the logs show a fatal startup failure.
+func (m *directoryManager) GetEmbeddedConnectorDetails(processMonitor *entities.MATLABProcessMonitor) (string, []byte, error) {
 	securePortFileFullPath := m.securePortFile()
 	certificateFileFullPath := m.CertificateFile()
 
 	timeout := time.After(m.embeddedConnectorDetailsTimeout)
 	tick := time.Tick(m.embeddedConnectorDetailsRetry)
 
+	var processExited <-chan struct{}
+	if processMonitor != nil {
+		processExited = processMonitor.Done()
+	}
+
 	for {
 		select {
 		case <-timeout:
 			return "", nil, fmt.Errorf("timeout waiting for worker to start")
+		case <-processExited:
+			exit, _ := processMonitor.Exit()
+			return "", nil, m.startupFailure(exit)
 		case <-tick:
+			if processMonitor != nil {
+				if startupErr, found := m.findStartupFailure(); found {
+					return "", nil, startupErr
+				}
+			}
 			if _, err := m.osLayer.Stat(securePortFileFullPath); err != nil {
 				continue
 			}
@@ -91,6 +124,41 @@ func (m *directoryManager) GetEmbeddedConnectorDetails() (string, []byte, error)
 	}
 }
 
+// WriteSessionRecord records the connection details of the session in its directory.
+// The record holds the API key of the session, so only the user can read it.
+func (m *directoryManager) WriteSessionRecord(record SessionRecord) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode session record: %w", err)
+	}
+
+	if err := m.osLayer.WriteFile(m.sessionRecordFile(), content, 0o600); err != nil {
+		return fmt.Errorf("failed to write session record: %w", err)
+	}
+
+	return nil
+}
+
+// ReadSessionRecord returns the session record and the embedded connector certificate recorded in the directory.
+func (m *directoryManager) ReadSessionRecord() (SessionRecord, []byte, error) {
+	content, err := m.osLayer.ReadFile(m.sessionRecordFile())
+	if err != nil {
+		return SessionRecord{}, nil, fmt.Errorf("failed to read session record: %w", err)
+	}
+
+	var record SessionRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		return SessionRecord{}, nil, fmt.Errorf("failed to decode session record: %w", err)
+	}
+
+	certificatePEM, err := m.osLayer.ReadFile(m.CertificateFile())
+	if err != nil {
+		return SessionRecord{}, nil, fmt.Errorf("failed to read certificate path file: %w", err)
+	}
+
+	return record, certificatePEM, nil
+}
+
 func (m *directoryManager) Cleanup() error {
 	if m.sessionDir == "" {
 		return nil
@@ -115,3 +183,7 @@ func (m *directoryManager) Cleanup() error {
 func (m *directoryManager) securePortFile() string {
 	return filepath.Join(m.sessionDir, securePortFile)
 }
+
+func (m *directoryManager) sessionRecordFile() string {
+	return filepath.Join(m.sessionDir, sessionRecordFile)
+}
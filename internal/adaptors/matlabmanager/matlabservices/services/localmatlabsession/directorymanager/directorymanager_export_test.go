@@ -3,6 +3,7 @@
 package directorymanager
 
 import (
+	"path/filepath"
 	"time"
 )
 
@@ -29,3 +30,11 @@ func (m *directoryManager) SetCleanupTimeout(timeout time.Duration) {
 func (m *directoryManager) SetCleanupRetry(retry time.Duration) {
 	m.cleanupRetry = retry
 }
+
+func (m *directoryManager) StdoutLogFile() string {
+	return filepath.Join(m.sessionDir, stdoutLogFile)
+}
+
+func (m *directoryManager) StderrLogFile() string {
+	return filepath.Join(m.sessionDir, stderrLogFile)
+}
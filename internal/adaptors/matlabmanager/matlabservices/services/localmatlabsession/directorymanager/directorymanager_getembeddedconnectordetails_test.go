@@ -55,7 +55,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -108,7 +108,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_WaitsForSecurePortFile(t *
 		Once()
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -161,7 +161,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_WaitsForCertificateFile(t
 		Once()
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -213,7 +213,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_WaitsForNotEmptyPortFile(t
 		Once()
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -264,7 +264,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_WaitsForNotEmptyCertificat
 		Once()
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.NoError(t, err)
@@ -290,7 +290,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_TimesoutWaitingForFilesToE
 		Return(nil, os.ErrNotExist)
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.Error(t, err)
@@ -328,7 +328,7 @@ func TestDirectoryManager_GetEmbeddedConnectorDetails_TimesoutWaitingForFileCont
 		Return([]byte(""), nil) // Will be called multiple times in wait loop
 
 	// Act
-	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails()
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(nil)
 
 	// Assert
 	require.Error(t, err)
@@ -0,0 +1,158 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package directorymanager_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryManager_SessionRecord_RoundTrip(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "matlab-session-12345")
+	recordFile := filepath.Join(sessionDir, "session.json")
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----")
+	expectedRecord := directorymanager.SessionRecord{
+		Port:              "31515",
+		APIKey:            "api-key",
+		ProcessID:         2468,
+		MATLABRoot:        filepath.Join("opt", "matlab", "R2024b"),
+		VMCRoot:           filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+		Release:           "R2024b",
+		StartingDirectory: sessionDir,
+		StartTime:         time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	var writtenRecord []byte
+	mockOSLayer.EXPECT().
+		WriteFile(recordFile, mock.Anything, os.FileMode(0o600)).
+		Run(func(_ string, data []byte, _ os.FileMode) {
+			writtenRecord = data
+		}).
+		Return(nil).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	require.NoError(t, directoryManager.WriteSessionRecord(expectedRecord))
+
+	mockOSLayer.EXPECT().
+		ReadFile(recordFile).
+		Return(writtenRecord, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "cert.pem")).
+		Return(expectedCertificatePEM, nil).
+		Once()
+
+	// Act
+	record, certificatePEM, err := directoryManager.ReadSessionRecord()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedRecord, record)
+	assert.Equal(t, expectedCertificatePEM, certificatePEM)
+}
+
+func TestDirectoryManager_WriteSessionRecord_WriteFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "matlab-session-12345")
+
+	mockOSLayer.EXPECT().
+		WriteFile(filepath.Join(sessionDir, "session.json"), mock.Anything, os.FileMode(0o600)).
+		Return(assert.AnError).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	err := directoryManager.WriteSessionRecord(directorymanager.SessionRecord{})
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestDirectoryManager_ReadSessionRecord_ReadFileError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "matlab-session-12345")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "session.json")).
+		Return(nil, assert.AnError).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	record, certificatePEM, err := directoryManager.ReadSessionRecord()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, record)
+	assert.Nil(t, certificatePEM)
+}
+
+func TestDirectoryManager_ReadSessionRecord_InvalidRecord(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "matlab-session-12345")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "session.json")).
+		Return([]byte("not json"), nil).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	_, _, err := directoryManager.ReadSessionRecord()
+
+	// Assert
+	require.ErrorContains(t, err, "failed to decode session record")
+}
+
+func TestDirectoryManager_ReadSessionRecord_CertificateError(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := filepath.Join("tmp", "matlab-session-12345")
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "session.json")).
+		Return([]byte(`{"port":"31515"}`), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(filepath.Join(sessionDir, "cert.pem")).
+		Return(nil, assert.AnError).
+		Once()
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+
+	// Act
+	_, _, err := directoryManager.ReadSessionRecord()
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package directorymanager_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectoryManager_GetEmbeddedConnectorDetails_ProcessExitedWithKnownFailure(t *testing.T) {
+	testCases := []struct {
+		name            string
+		stderr          string
+		expectedReason  error
+		expectedExcerpt string
+	}{
+		{
+			name:            "license",
+			stderr:          "MATLAB is selecting SOFTWARE OPENGL rendering.\nLicense checkout failed.\nLicense Manager Error -9\n\nDiagnostic Information:\nFeature: MATLAB\nLicense path: /usr/local/MATLAB/licenses\nLicensing error: -9,57.\n",
+			expectedReason:  entities.ErrMATLABLicense,
+			expectedExcerpt: "Feature: MATLAB\nLicense path: /usr/local/MATLAB/licenses\nLicensing error: -9,57.",
+		},
+		{
+			name:            "display",
+			stderr:          "Error: Can't open display: :99\n",
+			expectedReason:  entities.ErrNoDisplay,
+			expectedExcerpt: "Error: Can't open display: :99",
+		},
+		{
+			name:            "VMC and MATLAB pairing",
+			stderr:          "Vitis Model Composer 2025.2 is not supported with this version of MATLAB.\n",
+			expectedReason:  entities.ErrIncompatibleMATLABRelease,
+			expectedExcerpt: "Vitis Model Composer 2025.2 is not supported with this version of MATLAB.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockOSLayer := &mocks.MockOSLayer{}
+			defer mockOSLayer.AssertExpectations(t)
+
+			sessionDir := "/tmp/matlab-session-12345"
+
+			directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+			directoryManager.SetEmbeddedConnectorDetailsTimeout(time.Minute)
+			directoryManager.SetEmbeddedConnectorDetailsRetry(time.Minute)
+
+			expectedExit := entities.MATLABProcessExit{ProcessID: 1234, ExitCode: 1}
+			processMonitor := entities.NewMATLABProcessMonitor()
+			processMonitor.Exited(expectedExit)
+
+			mockOSLayer.EXPECT().
+				ReadFile(directoryManager.StderrLogFile()).
+				Return([]byte(testCase.stderr), nil).
+				Once()
+
+			// Act
+			port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(processMonitor)
+
+			// Assert
+			require.ErrorIs(t, err, entities.ErrMATLABStartupFailed)
+			require.ErrorIs(t, err, testCase.expectedReason)
+			assert.Empty(t, port)
+			assert.Empty(t, certificate)
+
+			var startupErr *entities.MATLABStartupError
+			require.ErrorAs(t, err, &startupErr)
+			assert.Equal(t, &entities.MATLABStartupError{
+				Reason:  testCase.expectedReason,
+				Exit:    &expectedExit,
+				LogFile: "matlab_stderr.log",
+				Excerpt: testCase.expectedExcerpt,
+			}, startupErr)
+			assert.Contains(t, err.Error(), testCase.expectedExcerpt)
+		})
+	}
+}
+
+func TestDirectoryManager_GetEmbeddedConnectorDetails_ProcessExitedWithUnknownFailure(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := "/tmp/matlab-session-12345"
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+	directoryManager.SetEmbeddedConnectorDetailsTimeout(time.Minute)
+	directoryManager.SetEmbeddedConnectorDetailsRetry(time.Minute)
+
+	expectedExit := entities.MATLABProcessExit{
+		ProcessID:  1234,
+		ExitCode:   127,
+		StderrTail: "MATLAB: error while loading shared libraries: libXt.so.6: cannot open shared object file",
+	}
+	processMonitor := entities.NewMATLABProcessMonitor()
+	processMonitor.Exited(expectedExit)
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StderrLogFile()).
+		Return([]byte(expectedExit.StderrTail), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StdoutLogFile()).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	// Act
+	_, _, err := directoryManager.GetEmbeddedConnectorDetails(processMonitor)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABStartupFailed)
+	assert.NotErrorIs(t, err, entities.ErrMATLABLicense)
+	assert.NotErrorIs(t, err, entities.ErrNoDisplay)
+
+	var startupErr *entities.MATLABStartupError
+	require.ErrorAs(t, err, &startupErr)
+	assert.Equal(t, &entities.MATLABStartupError{
+		Exit:    &expectedExit,
+		LogFile: "matlab_stderr.log",
+		Excerpt: expectedExit.StderrTail,
+	}, startupErr)
+	assert.Contains(t, err.Error(), "exited with code 127")
+}
+
+func TestDirectoryManager_GetEmbeddedConnectorDetails_FatalFailureWhileProcessRuns(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := "/tmp/matlab-session-12345"
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+	directoryManager.SetEmbeddedConnectorDetailsTimeout(time.Minute)
+	directoryManager.SetEmbeddedConnectorDetailsRetry(10 * time.Millisecond)
+
+	processMonitor := entities.NewMATLABProcessMonitor()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StderrLogFile()).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StdoutLogFile()).
+		Return([]byte("Starting MATLAB\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(directoryManager.SecurePortFile()).
+		Return(nil, os.ErrNotExist).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StderrLogFile()).
+		Return([]byte("License checkout failed.\nLicense Manager Error -15\n\nDiagnostic Information:\nFeature: MATLAB\n"), nil).
+		Once()
+
+	// Act
+	_, _, err := directoryManager.GetEmbeddedConnectorDetails(processMonitor)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABLicense)
+
+	var startupErr *entities.MATLABStartupError
+	require.ErrorAs(t, err, &startupErr)
+	assert.Nil(t, startupErr.Exit, "MATLAB is still running")
+	assert.Equal(t, "Feature: MATLAB", startupErr.Excerpt)
+}
+
+func TestDirectoryManager_GetEmbeddedConnectorDetails_IgnoresNonFatalLogsWhileProcessRuns(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDir := "/tmp/matlab-session-12345"
+
+	directoryManager := directorymanager.NewDirectoryManager(sessionDir, mockOSLayer)
+	directoryManager.SetEmbeddedConnectorDetailsTimeout(time.Minute)
+	directoryManager.SetEmbeddedConnectorDetailsRetry(10 * time.Millisecond)
+
+	processMonitor := entities.NewMATLABProcessMonitor()
+	expectedPort := "9999"
+	expectedCertificate := []byte("-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----")
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StderrLogFile()).
+		Return([]byte("License checkout failed.\nLicense Manager Error -4\n\nDiagnostic Information:\nFeature: Simulink_Design_Verifier\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.StdoutLogFile()).
+		Return([]byte("Checking that the display can be opened\nVitis Model Composer setup is not compatible with older releases, skipping\n"), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stat(mock.Anything).
+		Return(nil, nil).
+		Twice()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.SecurePortFile()).
+		Return([]byte(expectedPort), nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		ReadFile(directoryManager.CertificateFile()).
+		Return(expectedCertificate, nil).
+		Once()
+
+	// Act
+	port, certificate, err := directoryManager.GetEmbeddedConnectorDetails(processMonitor)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedPort, port)
+	assert.Equal(t, expectedCertificate, certificate)
+}
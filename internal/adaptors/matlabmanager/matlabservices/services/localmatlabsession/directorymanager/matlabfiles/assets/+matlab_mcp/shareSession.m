@@ -0,0 +1,64 @@
+function shareSession()
+    % shareSession shares this MATLAB session with the MATLAB MCP Core Server, so that its
+    % attach_matlab_session tool can use it. Call it before anything else starts the MATLAB connector.
+    % The connection details are written to the folder that contains the matlab_mcp package,
+    % which the server creates in its own folder, so that only the user can reach them.
+
+    % Copyright 2025 The MathWorks, Inc.
+
+    sessionFolder = string(fileparts(fileparts(mfilename("fullpath"))));
+
+    certificateFile = fullfile(sessionFolder, "cert.pem");
+    certificateKeyFile = fullfile(sessionFolder, "cert.key");
+    apiKey = string(java.util.UUID.randomUUID());
+
+    % The connector reads its API key and certificate locations when it starts
+    setenv("MWAPIKEY", apiKey);
+    setenv("MW_CERTFILE", certificateFile);
+    setenv("MW_PKEYFILE", certificateKeyFile);
+    connector.ensureServiceOn();
+
+    if ~isfile(certificateFile)
+        error("matlab_mcp:shareSession:connectorAlreadyRunning", ...
+            "The MATLAB connector was already running, so this session cannot be shared. " + ...
+            "Restart MATLAB and call matlab_mcp.shareSession first.");
+    end
+
+    % The private key of the certificate lets others impersonate this MATLAB, so only the user may read it
+    restrictToUser(certificateKeyFile);
+
+    % The API key gives control of this MATLAB, so only the user may read it. Its file is
+    % restricted while still empty, before the key is written to it.
+    apiKeyFile = fullfile(sessionFolder, "connector.apiKey");
+    writeDetail(apiKeyFile, "");
+    restrictToUser(apiKeyFile);
+    writeDetail(apiKeyFile, apiKey);
+    writeDetail(fullfile(sessionFolder, "matlab.root"), matlabroot);
+    writeDetail(fullfile(sessionFolder, "matlab.pid"), string(feature("getpid")));
+
+    % Record the port last, as the MCP server reads the other details once it exists
+    writeDetail(fullfile(sessionFolder, "connector.securePort"), string(connector.securePort()));
+
+    fprintf("MATLAB session shared in %s\n", sessionFolder);
+end
+
+function writeDetail(filePath, detail)
+    fileID = fopen(filePath, "w");
+    closeFile = onCleanup(@() fclose(fileID));
+    fprintf(fileID, "%s", detail);
+end
+
+function restrictToUser(filePath)
+    % fileattrib cannot restrict readers on Windows, where the access list of the folder applies
+    if ~isunix
+        return
+    end
+
+    for users = ["g", "o"]
+        [status, message] = fileattrib(filePath, "-r -w -x", users);
+        if ~status
+            error("matlab_mcp:shareSession:restrictFailed", ...
+                "Could not restrict access to %s: %s", filePath, message);
+        end
+    end
+end
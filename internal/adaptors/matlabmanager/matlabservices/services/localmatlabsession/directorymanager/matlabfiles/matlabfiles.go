@@ -13,6 +13,9 @@ var mcpEval []byte
 //go:embed assets/+matlab_mcp/getOrStashExceptions.m
 var getOrStashExceptions []byte
 
+//go:embed assets/+matlab_mcp/shareSession.m
+var shareSession []byte
+
 type MATLABFiles struct{}
 
 func New() MATLABFiles {
@@ -24,5 +27,6 @@ func (g MATLABFiles) GetAll() map[string][]byte {
 		"initializeMCP.m":        initializeMCP,
 		"mcpEval.m":              mcpEval,
 		"getOrStashExceptions.m": getOrStashExceptions,
+		"shareSession.m":         shareSession,
 	}
 }
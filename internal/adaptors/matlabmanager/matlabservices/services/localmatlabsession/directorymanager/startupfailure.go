@@ -0,0 +1,100 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package directorymanager
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// MATLAB writes its standard output and standard error to these files in the session directory.
+const stdoutLogFile = "matlab_stdout.log"
+const stderrLogFile = "matlab_stderr.log"
+
+// maxExcerptLines bounds the lines of a MATLAB log shown for a startup failure, from the line that matched onwards.
+const maxExcerptLines = 5
+
+// startupFailurePatterns recognise, in the MATLAB logs, the messages MATLAB writes when it cannot start.
+// They match whole lines, and only fatal messages, as they are also matched while MATLAB is still starting,
+// when its logs hold startup script output and non-fatal messages, such as a failed checkout of a toolbox license.
+var startupFailurePatterns = []struct {
+	reason  error
+	pattern *regexp.Regexp
+}{
+	{
+		// A failed license checkout names the feature, which is MATLAB itself only when MATLAB cannot start.
+		reason:  entities.ErrMATLABLicense,
+		pattern: regexp.MustCompile(`(?i)^\s*feature:\s*matlab\s*$`),
+	},
+	{
+		reason:  entities.ErrNoDisplay,
+		pattern: regexp.MustCompile(`(?i)^\s*(error:\s*)?(can'?t|cannot|unable to) open (x )?display\b`),
+	},
+	{
+		reason:  entities.ErrIncompatibleMATLABRelease,
+		pattern: regexp.MustCompile(`(?i)^\s*(error:\s*)?(vitis )?model composer \S+ is not (supported|compatible) with this (version|release) of matlab\.?\s*$`),
+	},
+}
+
+// findStartupFailure looks for a known startup failure in the MATLAB logs, standard error first.
+func (m *directoryManager) findStartupFailure() (*entities.MATLABStartupError, bool) {
+	for _, logFile := range []string{stderrLogFile, stdoutLogFile} {
+		content, err := m.osLayer.ReadFile(filepath.Join(m.sessionDir, logFile))
+		if err != nil {
+			// MATLAB may not have written anything yet.
+			continue
+		}
+
+		if startupErr, found := classifyStartupLog(string(content)); found {
+			startupErr.LogFile = logFile
+			return startupErr, true
+		}
+	}
+
+	return nil, false
+}
+
+// startupFailure describes why MATLAB exited before it was ready.
+// When the logs do not show a known failure, the last lines MATLAB wrote are shown instead.
+func (m *directoryManager) startupFailure(exit entities.MATLABProcessExit) error {
+	if startupErr, found := m.findStartupFailure(); found {
+		startupErr.Exit = &exit
+		return startupErr
+	}
+
+	startupErr := &entities.MATLABStartupError{
+		Exit: &exit,
+	}
+	switch {
+	case exit.StderrTail != "":
+		startupErr.LogFile, startupErr.Excerpt = stderrLogFile, exit.StderrTail
+	case exit.StdoutTail != "":
+		startupErr.LogFile, startupErr.Excerpt = stdoutLogFile, exit.StdoutTail
+	}
+
+	return startupErr
+}
+
+// classifyStartupLog returns the first known failure in a MATLAB log, with the lines that show it.
+func classifyStartupLog(content string) (*entities.MATLABStartupError, bool) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for i, line := range lines {
+		for _, failure := range startupFailurePatterns {
+			if !failure.pattern.MatchString(line) {
+				continue
+			}
+
+			excerpt := lines[i:min(i+maxExcerptLines, len(lines))]
+			return &entities.MATLABStartupError{
+				Reason:  failure.reason,
+				Excerpt: strings.TrimSpace(strings.Join(excerpt, "\n")),
+			}, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,26 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// checkDisplay returns an error if MATLAB is asked to show its desktop on Linux without an X server to show it on.
+// MATLAB would otherwise start, fail to open its desktop, and leave the server waiting for the embedded connector until it times out.
+func checkDisplay(goos string, showMATLABDesktop bool, env []string) error {
+	if goos != "linux" || !showMATLABDesktop {
+		return nil
+	}
+
+	for _, envVar := range env {
+		if display, found := strings.CutPrefix(envVar, "DISPLAY="); found && display != "" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: the MATLAB desktop needs an X server, but DISPLAY is not set; run the server from a graphical session, or use --display-mode=virtual or --display-mode=nodesktop", entities.ErrNoDisplay)
+}
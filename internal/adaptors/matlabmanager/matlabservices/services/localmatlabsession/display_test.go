@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDisplay(t *testing.T) {
+	testCases := []struct {
+		name              string
+		goos              string
+		showMATLABDesktop bool
+		env               []string
+		expectedError     error
+	}{
+		{
+			name:              "desktop with a display",
+			goos:              "linux",
+			showMATLABDesktop: true,
+			env:               []string{"HOME=/home/user", "DISPLAY=:0"},
+		},
+		{
+			name:              "desktop without a display",
+			goos:              "linux",
+			showMATLABDesktop: true,
+			env:               []string{"HOME=/home/user"},
+			expectedError:     entities.ErrNoDisplay,
+		},
+		{
+			name:              "desktop with an empty display",
+			goos:              "linux",
+			showMATLABDesktop: true,
+			env:               []string{"DISPLAY="},
+			expectedError:     entities.ErrNoDisplay,
+		},
+		{
+			name:              "no desktop without a display",
+			goos:              "linux",
+			showMATLABDesktop: false,
+			env:               []string{"HOME=/home/user"},
+		},
+		{
+			name:              "desktop on Windows",
+			goos:              "windows",
+			showMATLABDesktop: true,
+			env:               []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			err := localmatlabsession.CheckDisplay(testCase.goos, testCase.showMATLABDesktop, testCase.env)
+
+			// Assert
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, testCase.expectedError)
+			assert.Contains(t, err.Error(), "--display-mode=virtual")
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession
+
+import "github.com/matlab/matlab-mcp-core-server/internal/entities"
+
+func CheckVMCCompatibility(logger entities.Logger, vmcRoot string, matlabRelease string) error {
+	return checkVMCCompatibility(logger, vmcRoot, matlabRelease)
+}
+
+func CheckDisplay(goos string, showMATLABDesktop bool, env []string) error {
+	return checkDisplay(goos, showMATLABDesktop, env)
+}
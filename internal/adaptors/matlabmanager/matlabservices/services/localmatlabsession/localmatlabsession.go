@@ -3,59 +3,120 @@
 package localmatlabsession
 
 import (
+	"context"
 	"runtime"
+	"slices"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/virtualdisplay"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
+type Config interface {
+	PersistentSessions() bool
+	DisplayMode() entities.DisplayMode
+	StartupScripts() []string
+	MATLABFlags() []string
+	MATLABEnvironmentNames() []string
+	SkipVMCCompatibilityCheck() bool
+}
+
 type SessionDirectoryFactory interface {
 	Create(logger entities.Logger) (directorymanager.Directory, error)
+	FindRecorded(logger entities.Logger) ([]directorymanager.Directory, error)
+	Open(sessionDir string) directorymanager.Directory
 }
 
 type ProcessDetails interface {
 	NewAPIKey() string
-	EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string
+	EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, display string) []string
 	StartupFlag(os string, showMATLAB bool, startupCode string) []string
 }
 
 type MATLABProcessLauncher interface {
-	Launch(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string) (int, func(), error)
+	Launch(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string, keepStdinOpen bool) (int, *entities.MATLABProcessMonitor, func(), func(), error)
 }
 
 type Watchdog interface {
 	RegisterProcessPIDWithWatchdog(processPID int) error
+	RegisterPersistentProcessPIDWithWatchdog(processPID int) error
+}
+
+type MATLABVersionGetter interface {
+	Get(matlabRootLocation string) (datatypes.MatlabVersionInfo, error)
+}
+
+type VirtualDisplay interface {
+	Start(logger entities.Logger, sessionDir string) (virtualdisplay.Display, func(), error)
+	Stop(logger entities.Logger, processID int)
 }
 
 type Starter struct {
-	directoryFactory      SessionDirectoryFactory
-	processDetails        ProcessDetails
-	matlabProcessLauncher MATLABProcessLauncher
-	watchdog              Watchdog
+	directoryFactory       SessionDirectoryFactory
+	processDetails         ProcessDetails
+	matlabProcessLauncher  MATLABProcessLauncher
+	watchdog               Watchdog
+	matlabVersionGetter    MATLABVersionGetter
+	virtualDisplay         VirtualDisplay
+	persistentSessions     bool
+	displayMode            entities.DisplayMode
+	startupScripts         []string
+	matlabFlags            []string
+	matlabEnvironmentNames []string
+	skipVMCCompatibility   bool
 }
 
 func NewStarter(
+	config Config,
 	directoryFactory SessionDirectoryFactory,
 	procesDetails ProcessDetails,
 	matlabProcessLauncher MATLABProcessLauncher,
 	watchdog Watchdog,
+	matlabVersionGetter MATLABVersionGetter,
+	virtualDisplay VirtualDisplay,
 ) *Starter {
 	return &Starter{
-		directoryFactory:      directoryFactory,
-		processDetails:        procesDetails,
-		matlabProcessLauncher: matlabProcessLauncher,
-		watchdog:              watchdog,
+		directoryFactory:       directoryFactory,
+		processDetails:         procesDetails,
+		matlabProcessLauncher:  matlabProcessLauncher,
+		watchdog:               watchdog,
+		matlabVersionGetter:    matlabVersionGetter,
+		virtualDisplay:         virtualDisplay,
+		persistentSessions:     config.PersistentSessions(),
+		displayMode:            config.DisplayMode(),
+		startupScripts:         config.StartupScripts(),
+		matlabFlags:            config.MATLABFlags(),
+		matlabEnvironmentNames: config.MATLABEnvironmentNames(),
+		skipVMCCompatibility:   config.SkipVMCCompatibilityCheck(),
 	}
 }
 
-func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
+func (m *Starter) StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error) {
 	logger.Debug("Starting a local MATLAB session")
 
+	// The release is only informative, so a MATLAB root without version information does not fail the start.
+	var release string
+	if versionInfo, err := m.matlabVersionGetter.Get(request.MATLABRoot); err != nil {
+		logger.WithError(err).Debug("Failed to read the MATLAB release")
+	} else {
+		release = versionInfo.ReleaseFamily
+	}
+
+	if request.VMCRoot != "" {
+		if err := checkVMCCompatibility(logger, request.VMCRoot, release); err != nil {
+			if !m.skipVMCCompatibility {
+				return datatypes.LocalSession{}, nil, err
+			}
+			logger.WithError(err).Warn("Starting Vitis Model Composer with a MATLAB release it does not support, as the compatibility check is skipped")
+		}
+	}
+
 	sessionDir, err := m.directoryFactory.Create(logger)
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		return datatypes.LocalSession{}, nil, err
 	}
 
 	sessionDirPath := sessionDir.Path()
@@ -67,6 +128,23 @@ func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request dataty
 		request.StartingDirectory = sessionDirPath
 	}
 
+	// Sessions without a desktop run on their own virtual display, so that Simulink and Vitis Model Composer graphics still work.
+	var display virtualdisplay.Display
+	stopDisplay := func() {}
+	if m.displayMode == entities.DisplayModeVirtual && !request.ShowMATLABDesktop {
+		display, stopDisplay, err = m.virtualDisplay.Start(logger, sessionDirPath)
+		if err != nil {
+			return datatypes.LocalSession{}, nil, err
+		}
+
+		logger = logger.With("display", display.Name)
+		logger.With("xvfb-pid", display.ProcessID).Info("Started virtual display for MATLAB session")
+
+		if err := m.registerWithWatchdog(display.ProcessID); err != nil {
+			logger.WithError(err).Warn("Failed to register virtual display with watchdog")
+		}
+	}
+
 	uniqueAPIKey := m.processDetails.NewAPIKey()
 
 	env := m.processDetails.EnvironmentVariables(
@@ -74,33 +152,95 @@ func (m *Starter) StartLocalMATLABSession(logger entities.Logger, request dataty
 		uniqueAPIKey,
 		sessionDir.CertificateFile(),
 		sessionDir.CertificateKeyFile(),
+		display.Name,
 	)
 
-	startupCode := "sessionPath = '" + sessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;"
+	if err := checkDisplay(runtime.GOOS, request.ShowMATLABDesktop, env); err != nil {
+		return datatypes.LocalSession{}, nil, err
+	}
+
+	if len(m.startupScripts) > 0 || len(m.matlabFlags) > 0 || len(m.matlabEnvironmentNames) > 0 {
+		logger.
+			With("startup-scripts", m.startupScripts).
+			With("matlab-flags", m.matlabFlags).
+			With("matlab-env", m.matlabEnvironmentNames).
+			Info("Applying user startup configuration to MATLAB session")
+	}
 
-	startupFlags := m.processDetails.StartupFlag(runtime.GOOS, request.ShowMATLABDesktop, startupCode)
+	startupFlags := append(
+		slices.Clone(m.matlabFlags),
+		m.processDetails.StartupFlag(runtime.GOOS, request.ShowMATLABDesktop, startupCode(sessionDirPath, m.startupScripts))...,
+	)
 
-	processID, processCleanup, err := m.matlabProcessLauncher.Launch(logger, sessionDirPath, request.MATLABRoot, request.VMCRoot, request.StartingDirectory, startupFlags, env)
+	// MATLAB exits when its standard input closes, so persistent sessions keep it open past the server.
+	processID, processMonitor, processCleanup, processKill, err := m.matlabProcessLauncher.Launch(logger, sessionDirPath, request.MATLABRoot, request.VMCRoot, request.StartingDirectory, startupFlags, env, m.persistentSessions)
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		stopDisplay()
+		return datatypes.LocalSession{}, nil, err
 	}
 
-	if err = m.watchdog.RegisterProcessPIDWithWatchdog(processID); err != nil {
+	logger.With("pid", processID).Debug("Launched MATLAB process")
+	entities.ReportProgress(ctx, "MATLAB process launched, waiting for MATLAB to start")
+
+	if err = m.registerWithWatchdog(processID); err != nil {
 		logger.WithError(err).Warn("Failed to register process with watchdog")
 	}
 
-	securePort, certificatePEM, err := sessionDir.GetEmbeddedConnectorDetails()
+	// Startup fails as soon as MATLAB exits or its logs show a fatal startup failure, rather than when the wait times out.
+	securePort, certificatePEM, err := sessionDir.GetEmbeddedConnectorDetails(processMonitor)
 	if err != nil {
-		return embeddedconnector.ConnectionDetails{}, nil, err
+		logger.WithError(err).Warn("MATLAB session failed to start")
+		// MATLAB may still be running, for example while it shows a license dialog, and nothing asked it to exit, so it is killed.
+		processKill()
+		processCleanup()
+		stopDisplay()
+		if cleanupErr := sessionDir.Cleanup(); cleanupErr != nil {
+			logger.WithError(cleanupErr).Warn("Failed to remove session directory")
+		}
+		return datatypes.LocalSession{}, nil, err
 	}
 
-	return embeddedconnector.ConnectionDetails{
+	entities.ReportProgress(ctx, "MATLAB embedded connector is listening")
+
+	if m.persistentSessions {
+		err = sessionDir.WriteSessionRecord(directorymanager.SessionRecord{
+			Port:              securePort,
+			APIKey:            uniqueAPIKey,
+			ProcessID:         processID,
+			MATLABRoot:        request.MATLABRoot,
+			VMCRoot:           request.VMCRoot,
+			Release:           release,
+			StartingDirectory: request.StartingDirectory,
+			StartTime:         time.Now(),
+			DisplayProcessID:  display.ProcessID,
+		})
+		if err != nil {
+			// The session still works, the next server just cannot reattach to it.
+			logger.WithError(err).Warn("Failed to record MATLAB session, it will not be restored by the next server")
+		}
+	}
+
+	return datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
 			Host:           "localhost",
 			Port:           securePort,
 			APIKey:         uniqueAPIKey,
 			CertificatePEM: certificatePEM,
-		}, func() error {
-			processCleanup()
-			return sessionDir.Cleanup()
-		}, nil
+		},
+		ProcessID:         processID,
+		Release:           release,
+		StartingDirectory: request.StartingDirectory,
+		ProcessMonitor:    processMonitor,
+	}, func() error {
+		processCleanup()
+		stopDisplay()
+		return sessionDir.Cleanup()
+	}, nil
+}
+
+func (m *Starter) registerWithWatchdog(processID int) error {
+	if m.persistentSessions {
+		return m.watchdog.RegisterPersistentProcessPIDWithWatchdog(processID)
+	}
+	return m.watchdog.RegisterProcessPIDWithWatchdog(processID)
 }
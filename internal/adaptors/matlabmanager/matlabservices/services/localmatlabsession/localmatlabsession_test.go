@@ -3,48 +3,888 @@
 package localmatlabsession_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/virtualdisplay"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewStarter_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	// Act
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	// Assert
+	assert.NotNil(t, starter)
+}
+
+func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	expectedEnv := []string{"MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	expectedStartupCode := "sessionPath = '" + expectedSessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;"
+	showDestop := false
+	expectedStartupFlags := []string{"-r", expectedStartupCode}
+	expectedProcessID := 12345
+	processCleanupCalled := false
+	processMonitor := entities.NewMATLABProcessMonitor()
+	processCleanup := func() {
+		processCleanupCalled = true
+	}
+	processKill := func() {}
+
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
+		Return(expectedEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag(runtime.GOOS, showDestop, expectedStartupCode).
+		Return(expectedStartupFlags).
+		Once()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedStartupFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails(processMonitor).
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		IsStartingDirectorySet: false,
+		MATLABRoot:             expectedMATLABRoot,
+	}
+
+	// Act
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, cleanup)
+	assert.Equal(t, datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           expectedSecurePort,
+			APIKey:         expectedAPIKey,
+			CertificatePEM: expectedCertificatePEM,
+		},
+		ProcessID:         expectedProcessID,
+		Release:           "R2024b",
+		StartingDirectory: expectedSessionDirPath,
+		ProcessMonitor:    processMonitor,
+	}, localSession)
+
+	assert.False(t, processCleanupCalled)
+	err = cleanup()
+	require.NoError(t, err)
+	assert.True(t, processCleanupCalled)
+}
+
+func TestStarter_StartLocalMATLABSession_UserStartupConfiguration(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	expectedEnv := []string{"MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	startupScript := filepath.Join("home", "o'brien", "setup.m")
+	quotedStartupScript := "'" + filepath.Join("home", "o''brien", "setup.m") + "'"
+	expectedStartupCode := "sessionPath = '" + expectedSessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;" +
+		"try,run(" + quotedStartupScript + ");" +
+		"catch startupScriptError,fprintf(2,'Startup script %s failed: %s\\n'," + quotedStartupScript + ",startupScriptError.message);" +
+		"end;clear startupScriptError;"
+	showDestop := false
+	expectedStartupFlags := []string{"-r", expectedStartupCode}
+	expectedLaunchFlags := []string{"-singleCompThread", "-r", expectedStartupCode}
+	expectedProcessID := 12345
+	processCleanupCalled := false
+	processMonitor := entities.NewMATLABProcessMonitor()
+	processCleanup := func() {
+		processCleanupCalled = true
+	}
+	processKill := func() {}
+
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
+		Return(expectedEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag(runtime.GOOS, showDestop, expectedStartupCode).
+		Return(expectedStartupFlags).
+		Once()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedLaunchFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails(processMonitor).
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{startupScript}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{"-singleCompThread"}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{"LM_LICENSE_FILE"}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		IsStartingDirectorySet: false,
+		MATLABRoot:             expectedMATLABRoot,
+	}
+
+	// Act
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, cleanup)
+	assert.Equal(t, datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           expectedSecurePort,
+			APIKey:         expectedAPIKey,
+			CertificatePEM: expectedCertificatePEM,
+		},
+		ProcessID:         expectedProcessID,
+		Release:           "R2024b",
+		StartingDirectory: expectedSessionDirPath,
+		ProcessMonitor:    processMonitor,
+	}, localSession)
+
+	logFields, found := mockLogger.InfoLogs()["Applying user startup configuration to MATLAB session"]
+	require.True(t, found, "Expected the user startup configuration to be logged")
+	assert.Equal(t, []string{startupScript}, logFields["startup-scripts"])
+	assert.Equal(t, []string{"-singleCompThread"}, logFields["matlab-flags"])
+	assert.Equal(t, []string{"LM_LICENSE_FILE"}, logFields["matlab-env"])
+
+	err = cleanup()
+	require.NoError(t, err)
+	assert.True(t, processCleanupCalled)
+}
+
+func TestStarter_StartLocalMATLABSession_VirtualDisplay(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
-	mockProcessDetails := &mocks.MockProcessDetails{}
-	defer mockProcessDetails.AssertExpectations(t)
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedSecurePort := "9999"
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
+	expectedEnv := []string{"MATLAB_MCP_API_KEY=" + expectedAPIKey}
+	expectedStartupCode := "sessionPath = '" + expectedSessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;"
+	showDestop := false
+	expectedStartupFlags := []string{"-r", expectedStartupCode}
+	expectedProcessID := 12345
+	expectedDisplay := virtualdisplay.Display{Name: ":42", ProcessID: 6789}
+	processCleanupCalled := false
+	processMonitor := entities.NewMATLABProcessMonitor()
+	processCleanup := func() {
+		processCleanupCalled = true
+	}
+	processKill := func() {}
+	displayStopped := false
+	stopDisplay := func() {
+		assert.True(t, processCleanupCalled, "Expected MATLAB to exit before its display")
+		displayStopped = true
+	}
+
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockVirtualDisplay.EXPECT().
+		Start(mockLogger.AsMockArg(), expectedSessionDirPath).
+		Return(expectedDisplay, stopDisplay, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedDisplay.ProcessID).
+		Return(nil).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, expectedDisplay.Name).
+		Return(expectedEnv).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		StartupFlag(runtime.GOOS, showDestop, expectedStartupCode).
+		Return(expectedStartupFlags).
+		Once()
+
+	mockMATLABProcessLauncher.EXPECT().
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedStartupFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
+		Once()
+
+	mockWatchdog.EXPECT().
+		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		Return(nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		GetEmbeddedConnectorDetails(processMonitor).
+		Return(expectedSecurePort, expectedCertificatePEM, nil).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeVirtual).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		IsStartingDirectorySet: false,
+		MATLABRoot:             expectedMATLABRoot,
+	}
+
+	// Act
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, cleanup)
+	assert.Equal(t, datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           expectedSecurePort,
+			APIKey:         expectedAPIKey,
+			CertificatePEM: expectedCertificatePEM,
+		},
+		ProcessID:         expectedProcessID,
+		Release:           "R2024b",
+		StartingDirectory: expectedSessionDirPath,
+		ProcessMonitor:    processMonitor,
+	}, localSession)
+
+	assert.False(t, displayStopped)
+	err = cleanup()
+	require.NoError(t, err)
+	assert.True(t, displayStopped)
+}
+
+func TestStarter_StartLocalMATLABSession_VirtualDisplayStartError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedError := fmt.Errorf("%w: Xvfb was not found", entities.ErrNoDisplay)
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockVirtualDisplay.EXPECT().
+		Start(mockLogger.AsMockArg(), expectedSessionDirPath).
+		Return(virtualdisplay.Display{}, nil, expectedError).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeVirtual).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		MATLABRoot: expectedMATLABRoot,
+	}
+
+	// Act
+	_, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrNoDisplay)
+	assert.Nil(t, cleanup)
+}
+
+func TestStarter_StartLocalMATLABSession_DesktopWithoutDisplay(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("The display is only checked on Linux")
+	}
+
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
+	expectedCertificateFile := filepath.Join("tmp", "matlab-session-12345", "cert.pem")
+	expectedCertificateKeyFile := filepath.Join("tmp", "matlab-session-12345", "cert.key")
+	expectedAPIKey := "test-api-key-12345"
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
+	expectedEnv := []string{"HOME=/home/user"}
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(mockDirectory, nil).
+		Once()
+
+	mockDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDirPath).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		NewAPIKey().
+		Return(expectedAPIKey).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateFile().
+		Return(expectedCertificateFile).
+		Once()
+
+	mockDirectory.EXPECT().
+		CertificateKeyFile().
+		Return(expectedCertificateKeyFile).
+		Once()
+
+	mockProcessDetails.EXPECT().
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
+		Return(expectedEnv).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
 
-	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
-	defer mockMATLABProcessLauncher.AssertExpectations(t)
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
 
-	mockWatchdog := &mocks.MockWatchdog{}
-	defer mockWatchdog.AssertExpectations(t)
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
 
-	// Act
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
+	startRequest := datatypes.LocalSessionDetails{
+		MATLABRoot:        expectedMATLABRoot,
+		ShowMATLABDesktop: true,
+	}
+
+	// Act
+	_, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
 	// Assert
-	assert.NotNil(t, starter)
+	require.ErrorIs(t, err, entities.ErrNoDisplay)
+	assert.ErrorContains(t, err, "--display-mode=virtual")
+	assert.Nil(t, cleanup)
 }
 
-func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
+func TestStarter_StartLocalMATLABSession_PersistentSessions(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -60,6 +900,12 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
@@ -75,9 +921,11 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
 	processCleanupCalled := false
+	processMonitor := entities.NewMATLABProcessMonitor()
 	processCleanup := func() {
 		processCleanupCalled = true
 	}
+	processKill := func() {}
 
 	mockDirectoryFactory.EXPECT().
 		Create(mockLogger.AsMockArg()).
@@ -105,7 +953,7 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
 		Return(expectedEnv).
 		Once()
 
@@ -115,30 +963,81 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 		Once()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
-		Return(expectedProcessID, processCleanup, nil).
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedStartupFlags, expectedEnv, true).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
 		Once()
 
 	mockWatchdog.EXPECT().
-		RegisterProcessPIDWithWatchdog(expectedProcessID).
+		RegisterPersistentProcessPIDWithWatchdog(expectedProcessID).
 		Return(nil).
 		Once()
 
 	mockDirectory.EXPECT().
-		GetEmbeddedConnectorDetails().
+		GetEmbeddedConnectorDetails(processMonitor).
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
 		Once()
 
+	mockDirectory.EXPECT().
+		WriteSessionRecord(mock.MatchedBy(func(record directorymanager.SessionRecord) bool {
+			return record.Port == expectedSecurePort &&
+				record.APIKey == expectedAPIKey &&
+				record.ProcessID == expectedProcessID &&
+				record.MATLABRoot == expectedMATLABRoot &&
+				record.Release == "R2024b" &&
+				record.StartingDirectory == expectedSessionDirPath &&
+				!record.StartTime.IsZero()
+		})).
+		Return(nil).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
 	mockDirectory.EXPECT().
 		Cleanup().
 		Return(nil).
 		Once()
 
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -147,15 +1046,23 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, err)
 	assert.NotNil(t, cleanup)
-	assert.Equal(t, "localhost", connectionDetails.Host)
-	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
-	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
-	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+	assert.Equal(t, datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           expectedSecurePort,
+			APIKey:         expectedAPIKey,
+			CertificatePEM: expectedCertificatePEM,
+		},
+		ProcessID:         expectedProcessID,
+		Release:           "R2024b",
+		StartingDirectory: expectedSessionDirPath,
+		ProcessMonitor:    processMonitor,
+	}, localSession)
 
 	assert.False(t, processCleanupCalled)
 	err = cleanup()
@@ -165,6 +1072,9 @@ func TestStarter_StartLocalMATLABSession_HappyPath(t *testing.T) {
 
 func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -180,6 +1090,12 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
@@ -195,7 +1111,9 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	showDesktop := false
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
+	processMonitor := entities.NewMATLABProcessMonitor()
 	processCleanup := func() {}
+	processKill := func() {}
 
 	mockDirectoryFactory.EXPECT().
 		Create(mockLogger.AsMockArg()).
@@ -223,7 +1141,7 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
 		Return(expectedEnv).
 		Once()
 
@@ -234,8 +1152,8 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 
 	// Note: When starting directory is empty, it should use sessionDirPath
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv).
-		Return(expectedProcessID, processCleanup, nil).
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedStartingDir, expectedStartupFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
 		Once()
 
 	mockWatchdog.EXPECT().
@@ -244,15 +1162,53 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 		Once()
 
 	mockDirectory.EXPECT().
-		GetEmbeddedConnectorDetails().
+		GetEmbeddedConnectorDetails(processMonitor).
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
 		Once()
 
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -263,19 +1219,209 @@ func TestStarter_StartLocalMATLABSession_WithStartingDirectory(t *testing.T) {
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, err)
 	assert.NotNil(t, cleanup)
-	assert.Equal(t, "localhost", connectionDetails.Host)
-	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
-	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
-	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+	assert.Equal(t, datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           expectedSecurePort,
+			APIKey:         expectedAPIKey,
+			CertificatePEM: expectedCertificatePEM,
+		},
+		ProcessID:         expectedProcessID,
+		Release:           "R2024b",
+		StartingDirectory: expectedStartingDir,
+		ProcessMonitor:    processMonitor,
+	}, localSession)
+}
+
+func TestStarter_StartLocalMATLABSession_IncompatibleVMCRelease(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2022a")
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2022a"}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		MATLABRoot: expectedMATLABRoot,
+		VMCRoot:    filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+	}
+
+	// Act
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrIncompatibleMATLABRelease)
+	assert.Nil(t, cleanup)
+	assert.Equal(t, datatypes.LocalSession{}, localSession)
+}
+
+func TestStarter_StartLocalMATLABSession_IncompatibleVMCReleaseWithSkippedCheck(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
+	defer mockDirectoryFactory.AssertExpectations(t)
+
+	mockProcessDetails := &mocks.MockProcessDetails{}
+	defer mockProcessDetails.AssertExpectations(t)
+
+	mockMATLABProcessLauncher := &mocks.MockMATLABProcessLauncher{}
+	defer mockMATLABProcessLauncher.AssertExpectations(t)
+
+	mockWatchdog := &mocks.MockWatchdog{}
+	defer mockWatchdog.AssertExpectations(t)
+
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2022a")
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2022a"}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(true).
+		Once()
+
+	expectedError := assert.AnError
+	mockDirectoryFactory.EXPECT().
+		Create(mockLogger.AsMockArg()).
+		Return(nil, expectedError).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		mockConfig,
+		mockDirectoryFactory,
+		mockProcessDetails,
+		mockMATLABProcessLauncher,
+		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
+	)
+
+	startRequest := datatypes.LocalSessionDetails{
+		MATLABRoot: expectedMATLABRoot,
+		VMCRoot:    filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+	}
+
+	// Act
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Start should go on past the compatibility check")
+	assert.Nil(t, cleanup)
+	assert.Equal(t, datatypes.LocalSession{}, localSession)
+	assert.Contains(t, mockLogger.WarnLogs(), "Starting Vitis Model Composer with a MATLAB release it does not support, as the compatibility check is skipped")
 }
 
 func TestStarter_StartLocalMATLABSession_DirectoryFactoryCreateError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -288,8 +1434,15 @@ func TestStarter_StartLocalMATLABSession_DirectoryFactoryCreateError(t *testing.
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
+	expectedMATLABRoot := filepath.Join("usr", "local", "MATLAB", "R2024b")
 	expectedError := assert.AnError
 
 	mockDirectoryFactory.EXPECT().
@@ -297,31 +1450,72 @@ func TestStarter_StartLocalMATLABSession_DirectoryFactoryCreateError(t *testing.
 		Return(nil, expectedError).
 		Once()
 
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
-		MATLABRoot:             filepath.Join("usr", "local", "MATLAB", "R2024b"),
+		MATLABRoot:             expectedMATLABRoot,
 		StartingDirectory:      filepath.Join("home", "user", "workspace"),
 		IsStartingDirectorySet: true,
 		ShowMATLABDesktop:      false,
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, cleanup)
-	assert.Equal(t, embeddedconnector.ConnectionDetails{}, connectionDetails)
+	assert.Equal(t, datatypes.LocalSession{}, localSession)
 }
 
 func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -337,6 +1531,12 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedSessionDirPath := filepath.Join("tmp", "matlab-session-12345")
@@ -375,7 +1575,7 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
 		Return(expectedEnv).
 		Once()
 
@@ -385,15 +1585,53 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 		Once()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
-		Return(0, nil, expectedError).
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedStartupFlags, expectedEnv, false).
+		Return(0, nil, nil, nil, expectedError).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
 		Once()
 
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -402,16 +1640,19 @@ func TestStarter_StartLocalMATLABSession_MATLABProcessLauncherError(t *testing.T
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, cleanup)
-	assert.Equal(t, embeddedconnector.ConnectionDetails{}, connectionDetails)
+	assert.Equal(t, datatypes.LocalSession{}, localSession)
 }
 
 func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -427,6 +1668,12 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedStartingDir := filepath.Join("somewhere")
@@ -440,7 +1687,9 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedError := assert.AnError
 	expectedProcessID := 12345
+	processMonitor := entities.NewMATLABProcessMonitor()
 	processCleanup := func() {}
+	processKill := func() {}
 	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----\ntest-cert\n-----END CERTIFICATE-----")
 	expectedSecurePort := "9999"
 	showDesktop := false
@@ -471,7 +1720,7 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
 		Return(expectedEnv).
 		Once()
 
@@ -481,8 +1730,8 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 		Once()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedStartingDir, expectedStartupFlags, expectedEnv).
-		Return(expectedProcessID, processCleanup, nil).
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedStartingDir, expectedStartupFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
 		Once()
 
 	mockWatchdog.EXPECT().
@@ -491,15 +1740,53 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 		Once()
 
 	mockDirectory.EXPECT().
-		GetEmbeddedConnectorDetails().
+		GetEmbeddedConnectorDetails(processMonitor).
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
 		Once()
 
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{}, expectedError).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -510,15 +1797,23 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.NoError(t, err)
 	assert.NotNil(t, cleanup)
-	assert.Equal(t, "localhost", connectionDetails.Host)
-	assert.Equal(t, expectedSecurePort, connectionDetails.Port)
-	assert.Equal(t, expectedAPIKey, connectionDetails.APIKey)
-	assert.Equal(t, expectedCertificatePEM, connectionDetails.CertificatePEM)
+	assert.Equal(t, datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host:           "localhost",
+			Port:           expectedSecurePort,
+			APIKey:         expectedAPIKey,
+			CertificatePEM: expectedCertificatePEM,
+		},
+		ProcessID:         expectedProcessID,
+		Release:           "",
+		StartingDirectory: expectedStartingDir,
+		ProcessMonitor:    processMonitor,
+	}, localSession)
 
 	logs := mockLogger.WarnLogs()
 
@@ -536,6 +1831,9 @@ func TestStarter_StartLocalMATLABSession_RegisterProcessPIDWithWatchdogError(t *
 
 func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -548,6 +1846,12 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -562,7 +1866,10 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	expectedStartupCode := "sessionPath = '" + expectedSessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;"
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
-	processCleanup := func() {}
+	processMonitor := entities.NewMATLABProcessMonitor()
+	var stopSteps []string
+	processCleanup := func() { stopSteps = append(stopSteps, "cleanup") }
+	processKill := func() { stopSteps = append(stopSteps, "kill") }
 	expectedError := assert.AnError
 
 	mockDirectoryFactory.EXPECT().
@@ -591,7 +1898,7 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
 		Return(expectedEnv).
 		Once()
 
@@ -601,8 +1908,8 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 		Once()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
-		Return(expectedProcessID, processCleanup, nil).
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedStartupFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
 		Once()
 
 	mockWatchdog.EXPECT().
@@ -611,15 +1918,59 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 		Once()
 
 	mockDirectory.EXPECT().
-		GetEmbeddedConnectorDetails().
+		GetEmbeddedConnectorDetails(processMonitor).
 		Return("", nil, expectedError).
 		Once()
 
+	mockDirectory.EXPECT().
+		Cleanup().
+		Run(func() { stopSteps = append(stopSteps, "remove directory") }).
+		Return(nil).
+		Once()
+
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -628,16 +1979,20 @@ func TestStarter_StartLocalMATLABSession_GetEmbeddedConnectorDetailsError(t *tes
 	}
 
 	// Act
-	connectionDetails, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	localSession, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 
 	// Assert
 	require.ErrorIs(t, err, expectedError)
 	assert.Nil(t, cleanup)
-	assert.Equal(t, embeddedconnector.ConnectionDetails{}, connectionDetails)
+	assert.Equal(t, datatypes.LocalSession{}, localSession)
+	assert.Equal(t, []string{"kill", "cleanup", "remove directory"}, stopSteps, "MATLAB should be killed, and its session directory removed once it exited")
 }
 
 func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockDirectoryFactory := &mocks.MockSessionDirectoryFactory{}
 	defer mockDirectoryFactory.AssertExpectations(t)
 
@@ -650,6 +2005,12 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 	mockWatchdog := &mocks.MockWatchdog{}
 	defer mockWatchdog.AssertExpectations(t)
 
+	mockMATLABVersionGetter := &mocks.MockMATLABVersionGetter{}
+	defer mockMATLABVersionGetter.AssertExpectations(t)
+
+	mockVirtualDisplay := &mocks.MockVirtualDisplay{}
+	defer mockVirtualDisplay.AssertExpectations(t)
+
 	mockDirectory := &directorymocks.MockDirectory{}
 	defer mockDirectory.AssertExpectations(t)
 
@@ -667,7 +2028,9 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 	showDestop := false
 	expectedStartupFlags := []string{"-r", expectedStartupCode}
 	expectedProcessID := 12345
+	processMonitor := entities.NewMATLABProcessMonitor()
 	processCleanup := func() {}
+	processKill := func() {}
 	expectedError := assert.AnError
 
 	mockDirectoryFactory.EXPECT().
@@ -696,7 +2059,7 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		Once()
 
 	mockProcessDetails.EXPECT().
-		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile).
+		EnvironmentVariables(expectedSessionDirPath, expectedAPIKey, expectedCertificateFile, expectedCertificateKeyFile, "").
 		Return(expectedEnv).
 		Once()
 
@@ -706,8 +2069,8 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		Once()
 
 	mockMATLABProcessLauncher.EXPECT().
-		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, expectedSessionDirPath, expectedStartupFlags, expectedEnv).
-		Return(expectedProcessID, processCleanup, nil).
+		Launch(mockLogger.AsMockArg(), expectedSessionDirPath, expectedMATLABRoot, "", expectedSessionDirPath, expectedStartupFlags, expectedEnv, false).
+		Return(expectedProcessID, processMonitor, processCleanup, processKill, nil).
 		Once()
 
 	mockWatchdog.EXPECT().
@@ -716,20 +2079,58 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		Once()
 
 	mockDirectory.EXPECT().
-		GetEmbeddedConnectorDetails().
+		GetEmbeddedConnectorDetails(processMonitor).
 		Return(expectedSecurePort, expectedCertificatePEM, nil).
 		Once()
 
+	mockMATLABVersionGetter.EXPECT().
+		Get(expectedMATLABRoot).
+		Return(datatypes.MatlabVersionInfo{ReleaseFamily: "R2024b"}, nil).
+		Once()
+
 	mockDirectory.EXPECT().
 		Cleanup().
 		Return(expectedError).
 		Once()
 
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeDesktop).
+		Once()
+
+	mockConfig.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	mockConfig.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
 	starter := localmatlabsession.NewStarter(
+		mockConfig,
 		mockDirectoryFactory,
 		mockProcessDetails,
 		mockMATLABProcessLauncher,
 		mockWatchdog,
+		mockMATLABVersionGetter,
+		mockVirtualDisplay,
 	)
 
 	startRequest := datatypes.LocalSessionDetails{
@@ -737,7 +2138,7 @@ func TestStarter_StartLocalMATLABSession_CleanupReturnsSessionCleanupError(t *te
 		IsStartingDirectorySet: false,
 	}
 
-	_, cleanup, err := starter.StartLocalMATLABSession(mockLogger, startRequest)
+	_, cleanup, err := starter.StartLocalMATLABSession(t.Context(), mockLogger, startRequest)
 	require.NoError(t, err)
 	require.NotNil(t, cleanup)
 
@@ -0,0 +1,82 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession
+
+import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// FindPersistedLocalMATLABSessions returns the sessions recorded by earlier servers sharing the same log folder.
+// The sessions may have stopped since, so callers check that they are alive before restoring them.
+func (m *Starter) FindPersistedLocalMATLABSessions(logger entities.Logger) ([]datatypes.PersistedLocalSession, error) {
+	sessionDirs, err := m.directoryFactory.FindRecorded(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]datatypes.PersistedLocalSession, 0, len(sessionDirs))
+	for _, sessionDir := range sessionDirs {
+		record, certificatePEM, err := sessionDir.ReadSessionRecord()
+		if err != nil {
+			logger.With("session_dir", sessionDir.Path()).WithError(err).Warn("Failed to read MATLAB session record, skipping it")
+			continue
+		}
+
+		sessions = append(sessions, datatypes.PersistedLocalSession{
+			LocalSession: datatypes.LocalSession{
+				Endpoint: embeddedconnector.ConnectionDetails{
+					Host:           "localhost",
+					Port:           record.Port,
+					APIKey:         record.APIKey,
+					CertificatePEM: certificatePEM,
+				},
+				ProcessID:         record.ProcessID,
+				Release:           record.Release,
+				StartingDirectory: record.StartingDirectory,
+			},
+			MATLABRoot:       record.MATLABRoot,
+			VMCRoot:          record.VMCRoot,
+			StartTime:        record.StartTime,
+			SessionDirectory: sessionDir.Path(),
+			DisplayProcessID: record.DisplayProcessID,
+		})
+	}
+
+	return sessions, nil
+}
+
+// RestoreLocalMATLABSession takes ownership of a persisted session that is still alive.
+// The returned cleanup stops the virtual display of the session, if any, and removes the session directory once MATLAB was asked to exit;
+// MATLAB is no longer a child of this server, so it cannot be waited for.
+func (m *Starter) RestoreLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) func() error {
+	if err := m.watchdog.RegisterPersistentProcessPIDWithWatchdog(session.ProcessID); err != nil {
+		logger.WithError(err).Warn("Failed to register process with watchdog")
+	}
+
+	if session.DisplayProcessID != 0 {
+		if err := m.watchdog.RegisterPersistentProcessPIDWithWatchdog(session.DisplayProcessID); err != nil {
+			logger.WithError(err).Warn("Failed to register virtual display with watchdog")
+		}
+	}
+
+	sessionDir := m.directoryFactory.Open(session.SessionDirectory)
+
+	return func() error {
+		m.stopPersistedDisplay(logger, session)
+		return sessionDir.Cleanup()
+	}
+}
+
+// DiscardLocalMATLABSession stops the virtual display of a persisted session that stopped, if any, and removes its directory.
+func (m *Starter) DiscardLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) error {
+	m.stopPersistedDisplay(logger, session)
+	return m.directoryFactory.Open(session.SessionDirectory).Cleanup()
+}
+
+func (m *Starter) stopPersistedDisplay(logger entities.Logger, session datatypes.PersistedLocalSession) {
+	if session.DisplayProcessID != 0 {
+		m.virtualDisplay.Stop(logger, session.DisplayProcessID)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
+	directorymocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type starterMocks struct {
+	config                *mocks.MockConfig
+	directoryFactory      *mocks.MockSessionDirectoryFactory
+	processDetails        *mocks.MockProcessDetails
+	matlabProcessLauncher *mocks.MockMATLABProcessLauncher
+	watchdog              *mocks.MockWatchdog
+	matlabVersionGetter   *mocks.MockMATLABVersionGetter
+	virtualDisplay        *mocks.MockVirtualDisplay
+}
+
+func newPersistentStarter(t *testing.T) (*localmatlabsession.Starter, starterMocks) {
+	t.Helper()
+
+	starterMocks := starterMocks{
+		config:                &mocks.MockConfig{},
+		directoryFactory:      &mocks.MockSessionDirectoryFactory{},
+		processDetails:        &mocks.MockProcessDetails{},
+		matlabProcessLauncher: &mocks.MockMATLABProcessLauncher{},
+		watchdog:              &mocks.MockWatchdog{},
+		matlabVersionGetter:   &mocks.MockMATLABVersionGetter{},
+		virtualDisplay:        &mocks.MockVirtualDisplay{},
+	}
+	t.Cleanup(func() {
+		starterMocks.config.AssertExpectations(t)
+		starterMocks.directoryFactory.AssertExpectations(t)
+		starterMocks.processDetails.AssertExpectations(t)
+		starterMocks.matlabProcessLauncher.AssertExpectations(t)
+		starterMocks.watchdog.AssertExpectations(t)
+		starterMocks.matlabVersionGetter.AssertExpectations(t)
+		starterMocks.virtualDisplay.AssertExpectations(t)
+	})
+
+	starterMocks.config.EXPECT().
+		PersistentSessions().
+		Return(true).
+		Once()
+
+	starterMocks.config.EXPECT().
+		DisplayMode().
+		Return(entities.DisplayModeVirtual).
+		Once()
+
+	starterMocks.config.EXPECT().
+		StartupScripts().
+		Return([]string{}).
+		Once()
+
+	starterMocks.config.EXPECT().
+		MATLABFlags().
+		Return([]string{}).
+		Once()
+
+	starterMocks.config.EXPECT().
+		MATLABEnvironmentNames().
+		Return([]string{}).
+		Once()
+
+	starterMocks.config.EXPECT().
+		SkipVMCCompatibilityCheck().
+		Return(false).
+		Once()
+
+	starter := localmatlabsession.NewStarter(
+		starterMocks.config,
+		starterMocks.directoryFactory,
+		starterMocks.processDetails,
+		starterMocks.matlabProcessLauncher,
+		starterMocks.watchdog,
+		starterMocks.matlabVersionGetter,
+		starterMocks.virtualDisplay,
+	)
+
+	return starter, starterMocks
+}
+
+func TestStarter_FindPersistedLocalMATLABSessions_HappyPath(t *testing.T) {
+	// Arrange
+	starter, starterMocks := newPersistentStarter(t)
+
+	mockReadableDirectory := &directorymocks.MockDirectory{}
+	defer mockReadableDirectory.AssertExpectations(t)
+
+	mockUnreadableDirectory := &directorymocks.MockDirectory{}
+	defer mockUnreadableDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedSessionDir := filepath.Join("tmp", "logs", "matlab-session-1-abc")
+	expectedCertificatePEM := []byte("-----BEGIN CERTIFICATE-----")
+	expectedRecord := directorymanager.SessionRecord{
+		Port:              "31515",
+		APIKey:            "api-key",
+		ProcessID:         2468,
+		MATLABRoot:        filepath.Join("opt", "matlab", "R2024b"),
+		VMCRoot:           filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+		Release:           "R2024b",
+		StartingDirectory: filepath.Join("home", "user", "project"),
+		StartTime:         time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	starterMocks.directoryFactory.EXPECT().
+		FindRecorded(mockLogger.AsMockArg()).
+		Return([]directorymanager.Directory{mockUnreadableDirectory, mockReadableDirectory}, nil).
+		Once()
+
+	mockUnreadableDirectory.EXPECT().
+		ReadSessionRecord().
+		Return(directorymanager.SessionRecord{}, nil, assert.AnError).
+		Once()
+
+	mockUnreadableDirectory.EXPECT().
+		Path().
+		Return(filepath.Join("tmp", "logs", "matlab-session-2-def")).
+		Once()
+
+	mockReadableDirectory.EXPECT().
+		ReadSessionRecord().
+		Return(expectedRecord, expectedCertificatePEM, nil).
+		Once()
+
+	mockReadableDirectory.EXPECT().
+		Path().
+		Return(expectedSessionDir).
+		Once()
+
+	// Act
+	sessions, err := starter.FindPersistedLocalMATLABSessions(mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []datatypes.PersistedLocalSession{
+		{
+			LocalSession: datatypes.LocalSession{
+				Endpoint: embeddedconnector.ConnectionDetails{
+					Host:           "localhost",
+					Port:           expectedRecord.Port,
+					APIKey:         expectedRecord.APIKey,
+					CertificatePEM: expectedCertificatePEM,
+				},
+				ProcessID:         expectedRecord.ProcessID,
+				Release:           expectedRecord.Release,
+				StartingDirectory: expectedRecord.StartingDirectory,
+			},
+			MATLABRoot:       expectedRecord.MATLABRoot,
+			VMCRoot:          expectedRecord.VMCRoot,
+			StartTime:        expectedRecord.StartTime,
+			SessionDirectory: expectedSessionDir,
+		},
+	}, sessions)
+
+	_, found := mockLogger.WarnLogs()["Failed to read MATLAB session record, skipping it"]
+	assert.True(t, found, "Expected a warning for the unreadable session record")
+}
+
+func TestStarter_FindPersistedLocalMATLABSessions_FindRecordedError(t *testing.T) {
+	// Arrange
+	starter, starterMocks := newPersistentStarter(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	starterMocks.directoryFactory.EXPECT().
+		FindRecorded(mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	// Act
+	sessions, err := starter.FindPersistedLocalMATLABSessions(mockLogger)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, sessions)
+}
+
+func TestStarter_RestoreLocalMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	starter, starterMocks := newPersistentStarter(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	session := datatypes.PersistedLocalSession{
+		LocalSession:     datatypes.LocalSession{ProcessID: 2468},
+		SessionDirectory: filepath.Join("tmp", "logs", "matlab-session-1-abc"),
+	}
+
+	starterMocks.watchdog.EXPECT().
+		RegisterPersistentProcessPIDWithWatchdog(session.ProcessID).
+		Return(nil).
+		Once()
+
+	starterMocks.directoryFactory.EXPECT().
+		Open(session.SessionDirectory).
+		Return(mockDirectory).
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	// Act
+	cleanup := starter.RestoreLocalMATLABSession(mockLogger, session)
+
+	// Assert
+	require.NotNil(t, cleanup)
+	require.NoError(t, cleanup())
+}
+
+func TestStarter_RestoreLocalMATLABSession_VirtualDisplay(t *testing.T) {
+	// Arrange
+	starter, starterMocks := newPersistentStarter(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	session := datatypes.PersistedLocalSession{
+		LocalSession:     datatypes.LocalSession{ProcessID: 2468},
+		SessionDirectory: filepath.Join("tmp", "logs", "matlab-session-1-abc"),
+		DisplayProcessID: 1357,
+	}
+
+	starterMocks.watchdog.EXPECT().
+		RegisterPersistentProcessPIDWithWatchdog(session.ProcessID).
+		Return(nil).
+		Once()
+
+	starterMocks.watchdog.EXPECT().
+		RegisterPersistentProcessPIDWithWatchdog(session.DisplayProcessID).
+		Return(nil).
+		Once()
+
+	starterMocks.directoryFactory.EXPECT().
+		Open(session.SessionDirectory).
+		Return(mockDirectory).
+		Once()
+
+	starterMocks.virtualDisplay.EXPECT().
+		Stop(mockLogger.AsMockArg(), session.DisplayProcessID).
+		Return().
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(nil).
+		Once()
+
+	// Act
+	cleanup := starter.RestoreLocalMATLABSession(mockLogger, session)
+
+	// Assert
+	require.NotNil(t, cleanup)
+	require.NoError(t, cleanup())
+}
+
+func TestStarter_RestoreLocalMATLABSession_RegisterWithWatchdogError(t *testing.T) {
+	// Arrange
+	starter, starterMocks := newPersistentStarter(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	session := datatypes.PersistedLocalSession{
+		LocalSession:     datatypes.LocalSession{ProcessID: 2468},
+		SessionDirectory: filepath.Join("tmp", "logs", "matlab-session-1-abc"),
+	}
+
+	starterMocks.watchdog.EXPECT().
+		RegisterPersistentProcessPIDWithWatchdog(session.ProcessID).
+		Return(assert.AnError).
+		Once()
+
+	starterMocks.directoryFactory.EXPECT().
+		Open(session.SessionDirectory).
+		Return(mockDirectory).
+		Once()
+
+	// Act
+	cleanup := starter.RestoreLocalMATLABSession(mockLogger, session)
+
+	// Assert
+	assert.NotNil(t, cleanup)
+
+	_, found := mockLogger.WarnLogs()["Failed to register process with watchdog"]
+	assert.True(t, found, "Expected a warning for the watchdog registration")
+}
+
+func TestStarter_DiscardLocalMATLABSession_HappyPath(t *testing.T) {
+	// Arrange
+	starter, starterMocks := newPersistentStarter(t)
+
+	mockDirectory := &directorymocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	session := datatypes.PersistedLocalSession{
+		SessionDirectory: filepath.Join("tmp", "logs", "matlab-session-1-abc"),
+	}
+
+	starterMocks.directoryFactory.EXPECT().
+		Open(session.SessionDirectory).
+		Return(mockDirectory).
+		Once()
+
+	mockDirectory.EXPECT().
+		Cleanup().
+		Return(assert.AnError).
+		Once()
+
+	// Act
+	err := starter.DiscardLocalMATLABSession(mockLogger, session)
+
+	// Assert
+	require.ErrorIs(t, err, assert.AnError)
+}
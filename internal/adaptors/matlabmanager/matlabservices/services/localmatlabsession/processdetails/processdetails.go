@@ -4,6 +4,7 @@ package processdetails
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -16,21 +17,30 @@ const (
 	// For more information see https://mathworks.com/support/faq/user_experience_information_faq.html
 	MWContextTagsEnvVar = "MW_CONTEXT_TAGS"
 	MWContextTagsValue  = "MATLAB:MATLAB_MCP_CORE_SERVER:V1"
+
+	DisplayEnvVar = "DISPLAY"
 )
 
+type Config interface {
+	MATLABEnvironment() []string
+}
+
 type OSLayer interface {
 	Environ() []string
 }
 
 type ProcessDetails struct {
-	osLayer OSLayer
+	osLayer              OSLayer
+	environmentOverrides []string
 }
 
 func New(
+	config Config,
 	osLayer OSLayer,
 ) *ProcessDetails {
 	return &ProcessDetails{
-		osLayer: osLayer,
+		osLayer:              osLayer,
+		environmentOverrides: config.MATLABEnvironment(),
 	}
 }
 
@@ -38,16 +48,27 @@ func (*ProcessDetails) NewAPIKey() string {
 	return uuid.NewString()
 }
 
-func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string {
-	processEnvVars := append(
-		g.osLayer.Environ(),
-		"MATLAB_LOG_DIR="+sessionDirPath,
-		"MW_MCP_SESSION_DIR="+sessionDirPath,
-		`MW_DIAGNOSTIC_DEST="filedir=`+sessionDirPath+`"`,
-		"MWAPIKEY="+apiKey,
-		"MW_CERTFILE="+certificateFile,
-		"MW_PKEYFILE="+certificateKey,
-	)
+// EnvironmentVariables returns the environment of a MATLAB session.
+// The configured environment overrides replace the variables inherited from the server, but not the ones the server sets for the session.
+// When display is set, it replaces the display inherited from the server, so that MATLAB renders to a virtual display.
+func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, display string) []string {
+	processEnvVars := g.osLayer.Environ()
+	for _, override := range g.environmentOverrides {
+		name, _, _ := strings.Cut(override, "=")
+		processEnvVars = setEnvironmentVariable(processEnvVars, name, override)
+	}
+
+	for _, envVar := range []string{
+		"MATLAB_LOG_DIR=" + sessionDirPath,
+		"MW_MCP_SESSION_DIR=" + sessionDirPath,
+		`MW_DIAGNOSTIC_DEST="filedir=` + sessionDirPath + `"`,
+		"MWAPIKEY=" + apiKey,
+		"MW_CERTFILE=" + certificateFile,
+		"MW_PKEYFILE=" + certificateKey,
+	} {
+		name, _, _ := strings.Cut(envVar, "=")
+		processEnvVars = setEnvironmentVariable(processEnvVars, name, envVar)
+	}
 
 	// Add or update the MW_CONTEXT_TAGS environment variable
 	// If MW_CONTEXT_TAGS already exists, append a comma and the new value.
@@ -74,9 +95,21 @@ func (g *ProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey stri
 		return append(processEnvVars, envVarPrefix+MWContextTagsValue)
 	}(processEnvVars)
 
+	if display != "" {
+		processEnvVars = setEnvironmentVariable(processEnvVars, DisplayEnvVar, DisplayEnvVar+"="+display)
+	}
+
 	return processEnvVars
 }
 
+// setEnvironmentVariable replaces any existing value of the variable with the given <name>=<value> entry.
+func setEnvironmentVariable(processEnvVars []string, name string, envVar string) []string {
+	processEnvVars = slices.DeleteFunc(processEnvVars, func(existing string) bool {
+		return strings.HasPrefix(existing, name+"=")
+	})
+	return append(processEnvVars, envVar)
+}
+
 func (*ProcessDetails) StartupFlag(os string, showMATLAB bool, startupCode string) []string {
 	startupFlags := []string{}
 	if showMATLAB {
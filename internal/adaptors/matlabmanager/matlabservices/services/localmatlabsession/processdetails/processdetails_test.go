@@ -15,11 +15,19 @@ import (
 
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
 	// Act
-	details := processdetails.New(mockOSLayer)
+	details := processdetails.New(mockConfig, mockOSLayer)
 
 	// Assert
 	assert.NotNil(t, details)
@@ -27,10 +35,18 @@ func TestNew_HappyPath(t *testing.T) {
 
 func TestProcessDetails_NewAPIKey_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
-	details := processdetails.New(mockOSLayer)
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
 
 	// Act
 	apiKey := details.NewAPIKey()
@@ -43,10 +59,18 @@ func TestProcessDetails_NewAPIKey_HappyPath(t *testing.T) {
 
 func TestProcessDetails_NewAPIKey_ReturnsUniqueValues(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
-	details := processdetails.New(mockOSLayer)
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
 
 	// Act
 	apiKey1 := details.NewAPIKey()
@@ -58,6 +82,9 @@ func TestProcessDetails_NewAPIKey_ReturnsUniqueValues(t *testing.T) {
 
 func TestProcessDetails_EnvironmentVariables_HappyPath(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -72,10 +99,15 @@ func TestProcessDetails_EnvironmentVariables_HappyPath(t *testing.T) {
 		Return(existingEnv).
 		Once()
 
-	details := processdetails.New(mockOSLayer)
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
 
 	// Act
-	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey)
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, "")
 
 	// Assert
 	expectedEnv := append(
@@ -95,6 +127,9 @@ func TestProcessDetails_EnvironmentVariables_HappyPath(t *testing.T) {
 
 func TestProcessDetails_EnvironmentVariables_EmptyExistingEnvironment(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -109,10 +144,15 @@ func TestProcessDetails_EnvironmentVariables_EmptyExistingEnvironment(t *testing
 		Return(existingEnv).
 		Once()
 
-	details := processdetails.New(mockOSLayer)
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
 
 	// Act
-	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey)
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, "")
 
 	// Assert
 	expectedEnv := []string{
@@ -129,6 +169,9 @@ func TestProcessDetails_EnvironmentVariables_EmptyExistingEnvironment(t *testing
 
 func TestProcessDetails_EnvironmentVariables_MWContextTagPropagation(t *testing.T) {
 	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockOSLayer := &mocks.MockOSLayer{}
 	defer mockOSLayer.AssertExpectations(t)
 
@@ -149,10 +192,15 @@ func TestProcessDetails_EnvironmentVariables_MWContextTagPropagation(t *testing.
 		Return(append(expectedUnchangedExistingEnv, existingMWContextTagEnv...)).
 		Once()
 
-	details := processdetails.New(mockOSLayer)
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
 
 	// Act
-	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey)
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, "")
 
 	// Assert
 	expectedEnv := append(
@@ -170,6 +218,100 @@ func TestProcessDetails_EnvironmentVariables_MWContextTagPropagation(t *testing.
 	assert.ElementsMatch(t, expectedEnv, env)
 }
 
+func TestProcessDetails_EnvironmentVariables_Display(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirPath := "/tmp/matlab-session-12345"
+	apiKey := "test-api-key-12345"
+	certificateFile := "/tmp/matlab-session-12345/cert.pem"
+	certificateKey := "/tmp/matlab-session-12345/cert.key"
+	display := ":42"
+	expectedUnchangedExistingEnv := []string{
+		"PATH=/usr/bin",
+		"HOME=/home/user",
+	}
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return(append(expectedUnchangedExistingEnv, "DISPLAY=:0")).
+		Once()
+
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
+
+	// Act
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, display)
+
+	// Assert
+	expectedEnv := append(
+		expectedUnchangedExistingEnv,
+		[]string{
+			"MATLAB_LOG_DIR=" + sessionDirPath,
+			"MW_MCP_SESSION_DIR=" + sessionDirPath,
+			`MW_DIAGNOSTIC_DEST="filedir=` + sessionDirPath + `"`,
+			"MW_CONTEXT_TAGS=MATLAB:MATLAB_MCP_CORE_SERVER:V1",
+			"MWAPIKEY=" + apiKey,
+			"MW_CERTFILE=" + certificateFile,
+			"MW_PKEYFILE=" + certificateKey,
+			"DISPLAY=" + display,
+		}...,
+	)
+	assert.ElementsMatch(t, expectedEnv, env)
+}
+
+func TestProcessDetails_EnvironmentVariables_Overrides(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	sessionDirPath := "/tmp/matlab-session-12345"
+	apiKey := "test-api-key-12345"
+	certificateFile := "/tmp/matlab-session-12345/cert.pem"
+	certificateKey := "/tmp/matlab-session-12345/cert.key"
+
+	mockConfig.EXPECT().
+		MATLABEnvironment().
+		Return([]string{"LM_LICENSE_FILE=27000@licenses", "MWAPIKEY=not-the-session-key", "XILINX_LOCAL_USER_DATA="}).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Environ().
+		Return([]string{"PATH=/usr/bin", "LM_LICENSE_FILE=/opt/license.dat"}).
+		Once()
+
+	details := processdetails.New(mockConfig, mockOSLayer)
+
+	// Act
+	env := details.EnvironmentVariables(sessionDirPath, apiKey, certificateFile, certificateKey, "")
+
+	// Assert
+	expectedEnv := []string{
+		"PATH=/usr/bin",
+		"LM_LICENSE_FILE=27000@licenses",
+		"XILINX_LOCAL_USER_DATA=",
+		"MATLAB_LOG_DIR=" + sessionDirPath,
+		"MW_MCP_SESSION_DIR=" + sessionDirPath,
+		`MW_DIAGNOSTIC_DEST="filedir=` + sessionDirPath + `"`,
+		"MW_CONTEXT_TAGS=MATLAB:MATLAB_MCP_CORE_SERVER:V1",
+		"MWAPIKEY=" + apiKey,
+		"MW_CERTFILE=" + certificateFile,
+		"MW_PKEYFILE=" + certificateKey,
+	}
+	assert.ElementsMatch(t, expectedEnv, env)
+}
+
 func TestProcessDetails_StartupFlag_HappyPath(t *testing.T) {
 	for _, testConfig := range []struct {
 		os            string
@@ -230,10 +372,18 @@ func TestProcessDetails_StartupFlag_HappyPath(t *testing.T) {
 	} {
 		t.Run(fmt.Sprintf("%s_desktop_%v", testConfig.os, testConfig.showDesktop), func(t *testing.T) {
 			// Arrange
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
 			mockOSLayer := &mocks.MockOSLayer{}
 			defer mockOSLayer.AssertExpectations(t)
 
-			details := processdetails.New(mockOSLayer)
+			mockConfig.EXPECT().
+				MATLABEnvironment().
+				Return([]string{}).
+				Once()
+
+			details := processdetails.New(mockConfig, mockOSLayer)
 			startupCode := "disp('Hello World');"
 
 			// Act
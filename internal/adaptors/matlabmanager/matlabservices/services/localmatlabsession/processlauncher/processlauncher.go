@@ -4,8 +4,10 @@ package processlauncher
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -13,41 +15,75 @@ import (
 
 const gracefulShutdownTimeout = 2 * time.Minute
 
-type MATLABProcessLauncher struct{}
+const (
+	stdoutLogFile = "matlab_stdout.log"
+	stderrLogFile = "matlab_stderr.log"
 
-func New() *MATLABProcessLauncher {
-	return &MATLABProcessLauncher{}
+	// MATLAB names its crash dumps matlab_crash_dump.<pid>-<n>.
+	crashDumpPattern = "matlab_crash_dump.*"
+
+	maxTailLines = 20
+	maxTailBytes = 16 * 1024
+)
+
+type Config interface {
+	SourceVMCSettings() bool
+}
+
+type VMCSettings interface {
+	Environment(logger entities.Logger, vmcRoot string, env []string) ([]string, error)
+}
+
+type MATLABProcessLauncher struct {
+	sourceVMCSettings bool
+	vmcSettings       VMCSettings
 }
 
-func (l *MATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string) (int, func(), error) {
+func New(
+	config Config,
+	vmcSettings VMCSettings,
+) *MATLABProcessLauncher {
+	return &MATLABProcessLauncher{
+		sourceVMCSettings: config.SourceVMCSettings(),
+		vmcSettings:       vmcSettings,
+	}
+}
+
+// Launch starts MATLAB, and supervises it until it exits.
+// The returned monitor reports how MATLAB exited, with the last lines it wrote and the crash dumps it left in the session directory.
+// The cleanup function waits for MATLAB to exit after it was asked to, and the kill function stops MATLAB straight away.
+func (l *MATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string, keepStdinOpen bool) (int, *entities.MATLABProcessMonitor, func(), func(), error) {
+	if vmcRoot != "" && l.sourceVMCSettings {
+		var err error
+		env, err = l.vmcSettings.Environment(logger, vmcRoot, env)
+		if err != nil {
+			return 0, nil, nil, nil, err
+		}
+	}
+
 	stdIO, stdIOCleanup, err := createLocalStdioForNewProcess(logger, sessionRoot)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, nil, err
 	}
 
+	stdIO.keepStdinOpen = keepStdinOpen
+
 	process, err := startMatlab(logger, matlabRoot, vmcRoot, workingDir, args, env, stdIO)
 	if err != nil {
-		return 0, nil, fmt.Errorf("failed to start MATLAB process: %w", err)
+		stdIOCleanup()
+		return 0, nil, nil, nil, fmt.Errorf("failed to start MATLAB process: %w", err)
 	}
 
-	return process.Pid, func() {
+	monitor := entities.NewMATLABProcessMonitor()
+	go supervise(logger, sessionRoot, process, monitor)
+
+	cleanup := func() {
 		// By the time this is called, we expect MATLAB to be shutting down gracefully
 		logger.Debug("Waiting for MATLAB process to exit gracefully")
 
-		errC := make(chan error)
-		go func() {
-			_, err := process.Wait()
-			errC <- err
-		}()
-
 		select {
-		case err := <-errC:
+		case <-monitor.Done():
 			logger.Debug("Done waiting for MATLAB process to exit")
-			if err != nil && err != os.ErrProcessDone {
-				logger.Warn("MATLAB process did not exit gracefully, forcefully kill it")
-				// Probably overkill, but ensure the process is killed if it's still running
-				killMATLABProcess(logger, process)
-			}
 		case <-time.After(gracefulShutdownTimeout):
 			logger.Warn("Timed out waiting for MATLAB process to exit gracefully, forcefully kill it")
 			// Probably overkill, but ensure the process is killed if it's still running
@@ -56,7 +92,86 @@ func (l *MATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot strin
 
 		// Cleanup the stdIO files
 		stdIOCleanup()
-	}, nil
+	}
+
+	kill := func() {
+		killMATLABProcess(logger, process)
+	}
+
+	return process.Pid, monitor, cleanup, kill, nil
+}
+
+// supervise waits for MATLAB to exit, and reports how it exited to the monitor.
+func supervise(logger entities.Logger, sessionRoot string, process *os.Process, monitor *entities.MATLABProcessMonitor) {
+	exit := entities.MATLABProcessExit{
+		ProcessID: process.Pid,
+		ExitCode:  -1,
+	}
+
+	state, err := process.Wait()
+	exit.ExitTime = time.Now()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to wait for MATLAB process")
+	} else {
+		exit.ExitCode = state.ExitCode()
+		exit.Signal = exitSignal(state)
+	}
+
+	exit.StdoutTail = tailFile(logger, filepath.Join(sessionRoot, stdoutLogFile))
+	exit.StderrTail = tailFile(logger, filepath.Join(sessionRoot, stderrLogFile))
+
+	// MW_DIAGNOSTIC_DEST points MATLAB to the session directory for its crash dumps.
+	crashDumps, err := filepath.Glob(filepath.Join(sessionRoot, crashDumpPattern))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to look for MATLAB crash dumps")
+	}
+	exit.CrashDumps = crashDumps
+
+	logger.
+		With("exit-code", exit.ExitCode).
+		With("signal", exit.Signal).
+		With("crash-dumps", exit.CrashDumps).
+		Debug("MATLAB process exited")
+
+	monitor.Exited(exit)
+}
+
+// tailFile returns the last lines of a log file, or an empty string if it cannot be read.
+func tailFile(logger entities.Logger, path string) string {
+	file, err := os.Open(path) //nolint:gosec // We construct this path, and file
+	if err != nil {
+		logger.WithError(err).Debug("Failed to open MATLAB log file")
+		return ""
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.WithError(err).Debug("Failed to close MATLAB log file")
+		}
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		logger.WithError(err).Debug("Failed to read MATLAB log file")
+		return ""
+	}
+
+	offset := max(info.Size()-maxTailBytes, 0)
+	content := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(content, offset); err != nil && err != io.EOF {
+		logger.WithError(err).Debug("Failed to read MATLAB log file")
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimRight(string(content), "\r\n"), "\n")
+	if offset > 0 && len(lines) > 1 {
+		// The first line was cut by the offset.
+		lines = lines[1:]
+	}
+	if len(lines) > maxTailLines {
+		lines = lines[len(lines)-maxTailLines:]
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 func killMATLABProcess(logger entities.Logger, process *os.Process) {
@@ -71,6 +186,10 @@ type stdIO struct {
 	stdOut       *os.File
 	stdErr       *os.File
 	writeToStdIn *os.File
+
+	// keepStdinOpen hands the write end of the stdin pipe to MATLAB too,
+	// so that MATLAB does not see its stdin close when the server exits.
+	keepStdinOpen bool
 }
 
 func (s *stdIO) cleanup(logger entities.Logger) {
@@ -87,14 +206,14 @@ func (s *stdIO) cleanup(logger entities.Logger) {
 func createLocalStdioForNewProcess(logger entities.Logger, sessionRoot string) (*stdIO, func(), error) {
 	stdIO := &stdIO{}
 
-	stdOut, err := os.Create(filepath.Join(sessionRoot, "matlab_stdout.log")) //nolint:gosec // We construct this path, and file
+	stdOut, err := os.Create(filepath.Join(sessionRoot, stdoutLogFile)) //nolint:gosec // We construct this path, and file
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create stdOut log file: %w", err)
 	}
 
 	stdIO.stdOut = stdOut
 
-	stdErr, err := os.Create(filepath.Join(sessionRoot, "matlab_stderr.log")) //nolint:gosec // We construct this path, and file
+	stdErr, err := os.Create(filepath.Join(sessionRoot, stderrLogFile)) //nolint:gosec // We construct this path, and file
 	if err != nil {
 		stdIO.cleanup(logger)
 		return nil, nil, fmt.Errorf("failed to create stdErr log file: %w", err)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"golang.org/x/sys/unix"
@@ -41,10 +42,15 @@ func startMatlab(_ entities.Logger, matlabRoot string, vmcRoot string, workingDi
 		processArgs = append([]string{executablePath}, args...)
 	}
 
+	files := []*os.File{stdIO.stdIn, stdIO.stdOut, stdIO.stdErr}
+	if stdIO.keepStdinOpen {
+		files = append(files, stdIO.writeToStdIn)
+	}
+
 	attr := &os.ProcAttr{
 		Dir:   workingDir,
 		Env:   env,
-		Files: []*os.File{stdIO.stdIn, stdIO.stdOut, stdIO.stdErr},
+		Files: files,
 		Sys: &unix.SysProcAttr{
 			Setsid: true, // Create a new session
 		},
@@ -60,3 +66,12 @@ func startMatlab(_ entities.Logger, matlabRoot string, vmcRoot string, workingDi
 
 	return process, nil
 }
+
+// exitSignal returns the name of the signal that killed the process, if any.
+func exitSignal(state *os.ProcessState) string {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return ""
+	}
+	return unix.SignalName(status.Signal())
+}
@@ -172,3 +172,8 @@ func snapshotProcessIterator(snapshot windows.Handle) iter.Seq[windows.ProcessEn
 		}
 	}
 }
+
+// exitSignal returns an empty string, as processes are not killed by signals on Windows.
+func exitSignal(_ *os.ProcessState) string {
+	return ""
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcsettings
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+)
+
+const (
+	settingsScriptName = "settings64.sh"
+
+	sourceTimeout = 2 * time.Minute
+)
+
+// ignoredVariables are set by the shell itself, rather than by the settings script.
+var ignoredVariables = []string{"_", "SHLVL", "PWD", "OLDPWD"}
+
+type OSLayer interface {
+	Stat(name string) (osfacade.FileInfo, error)
+	CommandContext(ctx context.Context, name string, arg ...string) osfacade.Cmd
+}
+
+// Sourcer builds the environment that Vitis Model Composer expects, by sourcing the settings script of its installation.
+// Sourcing is slow, so the variables each settings script sets are cached per VMC root.
+type Sourcer struct {
+	osLayer OSLayer
+
+	l     *sync.Mutex
+	cache map[string]*cachedSettings
+}
+
+// cachedSettings holds the variables the settings script of one VMC root sets.
+// Its lock is held while the script is sourced, so that sessions of other VMC roots do not wait for it.
+type cachedSettings struct {
+	l        sync.Mutex
+	sourced  bool
+	settings []string
+}
+
+func New(
+	osLayer OSLayer,
+) *Sourcer {
+	return &Sourcer{
+		osLayer: osLayer,
+		l:       new(sync.Mutex),
+		cache:   map[string]*cachedSettings{},
+	}
+}
+
+// Environment returns env with the variables set by the settings script of the VMC root.
+func (s *Sourcer) Environment(logger entities.Logger, vmcRoot string, env []string) ([]string, error) {
+	cached := s.cachedSettingsOf(vmcRoot)
+
+	cached.l.Lock()
+	defer cached.l.Unlock()
+
+	if !cached.sourced {
+		script, err := s.findSettingsScript(vmcRoot)
+		if err != nil {
+			return nil, err
+		}
+
+		settings, err := s.source(script, env)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.
+			With("script", script).
+			With("variables", len(settings)).
+			Info("Sourced Vitis settings script")
+
+		cached.sourced, cached.settings = true, settings
+	}
+
+	return merge(env, cached.settings), nil
+}
+
+func (s *Sourcer) cachedSettingsOf(vmcRoot string) *cachedSettings {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	cached, found := s.cache[vmcRoot]
+	if !found {
+		cached = &cachedSettings{}
+		s.cache[vmcRoot] = cached
+	}
+	return cached
+}
+
+// findSettingsScript looks for the settings script in the VMC root, then in the Vitis installation around it,
+// such as /tools/Xilinx/2025.1 for /tools/Xilinx/2025.1/Model_Composer.
+func (s *Sourcer) findSettingsScript(vmcRoot string) (string, error) {
+	candidates := []string{
+		filepath.Join(vmcRoot, settingsScriptName),
+		filepath.Join(filepath.Dir(vmcRoot), settingsScriptName),
+		filepath.Join(filepath.Dir(vmcRoot), "Vitis", settingsScriptName),
+	}
+
+	for _, candidate := range candidates {
+		if info, err := s.osLayer.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("no Vitis settings script found for %s, looked for %s", vmcRoot, strings.Join(candidates, ", "))
+}
+
+// source runs the settings script in a bash subshell, and returns the variables it set or changed.
+func (s *Sourcer) source(script string, env []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sourceTimeout)
+	defer cancel()
+
+	// The script output goes to stderr, so that stdout only holds the resulting environment.
+	cmd := s.osLayer.CommandContext(ctx, "bash", "-c", `. "$0" >&2; exec env -0`, script)
+	cmd.SetEnv(env)
+
+	var stdout, stderr bytes.Buffer
+	cmd.SetStdout(&stdout)
+	cmd.SetStderr(&stderr)
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("timed out after %v", sourceTimeout)
+		}
+		return nil, fmt.Errorf("failed to source Vitis settings script %s: %w%s", script, err, formatStderr(stderr.String()))
+	}
+
+	sourced := strings.Split(strings.TrimSuffix(stdout.String(), "\x00"), "\x00")
+	return changedVariables(env, sourced), nil
+}
+
+func changedVariables(before []string, after []string) []string {
+	changed := []string{}
+	for _, envVar := range after {
+		name, _, found := strings.Cut(envVar, "=")
+		if !found || slices.Contains(ignoredVariables, name) || slices.Contains(before, envVar) {
+			continue
+		}
+		changed = append(changed, envVar)
+	}
+	return changed
+}
+
+func merge(env []string, settings []string) []string {
+	merged := slices.Clone(env)
+	for _, setting := range settings {
+		name, _, _ := strings.Cut(setting, "=")
+		merged = slices.DeleteFunc(merged, func(existing string) bool {
+			return strings.HasPrefix(existing, name+"=")
+		})
+		merged = append(merged, setting)
+	}
+	return merged
+}
+
+func formatStderr(stderr string) string {
+	if stderr = strings.TrimSpace(stderr); stderr == "" {
+		return ""
+	}
+	return ", stderr:\n" + stderr
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build !windows
+
+package vmcsettings_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher/utils/vmcsettings"
+	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher/utils/vmcsettings"
+	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSettingsScript writes a settings script that runs the given shell code, and returns its path.
+func writeSettingsScript(t *testing.T, dir string, script string) string {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+
+	scriptPath := filepath.Join(dir, "settings64.sh")
+	err := os.WriteFile(scriptPath, []byte(script+"\n"), 0o600)
+	require.NoError(t, err)
+
+	return scriptPath
+}
+
+func TestSourcer_Environment_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	vmcRoot := filepath.Join(t.TempDir(), "2025.1", "Model_Composer")
+	writeSettingsScript(t, vmcRoot, `
+echo "Setting up Vitis"
+export XILINX_VITIS=/tools/Xilinx/2025.1/Vitis
+export PATH=/tools/Xilinx/2025.1/Vitis/bin:$PATH
+`)
+
+	env := []string{"PATH=/usr/bin", "HOME=/home/user"}
+
+	sourcer := vmcsettings.New(osfacade.New())
+
+	// Act
+	result, err := sourcer.Environment(mockLogger, vmcRoot, env)
+
+	// Assert
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"HOME=/home/user",
+		"XILINX_VITIS=/tools/Xilinx/2025.1/Vitis",
+		"PATH=/tools/Xilinx/2025.1/Vitis/bin:/usr/bin",
+	}, result)
+	assert.Equal(t, []string{"PATH=/usr/bin", "HOME=/home/user"}, env, "Expected the input environment to be left unchanged")
+
+	_, found := mockLogger.InfoLogs()["Sourced Vitis settings script"]
+	assert.True(t, found, "Expected the settings script to be logged")
+}
+
+func TestSourcer_Environment_ScriptInVitisInstallation(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	installation := filepath.Join(t.TempDir(), "2025.1")
+	vmcRoot := filepath.Join(installation, "Model_Composer")
+	require.NoError(t, os.MkdirAll(vmcRoot, 0o700))
+	writeSettingsScript(t, filepath.Join(installation, "Vitis"), `export XILINX_VITIS="$(dirname "${BASH_SOURCE[0]}")"`)
+
+	sourcer := vmcsettings.New(osfacade.New())
+
+	// Act
+	result, err := sourcer.Environment(mockLogger, vmcRoot, []string{"PATH=/usr/bin"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, result, "XILINX_VITIS="+filepath.Join(installation, "Vitis"))
+}
+
+func TestSourcer_Environment_CachedPerVMCRoot(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	vmcRoot := filepath.Join(t.TempDir(), "Model_Composer")
+	countFile := filepath.Join(t.TempDir(), "count")
+	writeSettingsScript(t, vmcRoot, `echo sourced >> "`+countFile+`"; export XILINX_VITIS=/tools/Xilinx/Vitis`)
+
+	sourcer := vmcsettings.New(osfacade.New())
+
+	// Act
+	first, err := sourcer.Environment(mockLogger, vmcRoot, []string{"PATH=/usr/bin"})
+	require.NoError(t, err)
+	second, err := sourcer.Environment(mockLogger, vmcRoot, []string{"PATH=/usr/bin", "MWAPIKEY=other-session"})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Contains(t, first, "XILINX_VITIS=/tools/Xilinx/Vitis")
+	assert.ElementsMatch(t, []string{"PATH=/usr/bin", "MWAPIKEY=other-session", "XILINX_VITIS=/tools/Xilinx/Vitis"}, second)
+
+	count, err := os.ReadFile(countFile) //nolint:gosec // Test file
+	require.NoError(t, err)
+	assert.Equal(t, "sourced\n", string(count), "Expected the settings script to be sourced once")
+}
+
+func TestSourcer_Environment_OtherVMCRootsDoNotWait(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockFileInfo := &osfacademocks.MockFileInfo{}
+	mockFileInfo.EXPECT().IsDir().Return(false)
+
+	slowVMCRoot := filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer")
+	fastVMCRoot := filepath.Join("tools", "Xilinx", "2025.2", "Model_Composer")
+	releaseSlowSource := make(chan struct{})
+
+	expectSource := func(vmcRoot string, run func() error, output string) {
+		script := filepath.Join(vmcRoot, "settings64.sh")
+
+		mockOSLayer.EXPECT().
+			Stat(script).
+			Return(mockFileInfo, nil).
+			Once()
+
+		mockCmd := &osfacademocks.MockCmd{}
+		mockCmd.EXPECT().SetEnv(mock.Anything).Return()
+		mockCmd.EXPECT().SetStderr(mock.Anything).Return()
+		mockCmd.EXPECT().
+			SetStdout(mock.Anything).
+			Run(func(stdout io.Writer) {
+				_, _ = io.WriteString(stdout, output)
+			}).
+			Return()
+		mockCmd.EXPECT().Run().RunAndReturn(run)
+
+		mockOSLayer.EXPECT().
+			CommandContext(mock.Anything, "bash", []string{"-c", `. "$0" >&2; exec env -0`, script}).
+			RunAndReturn(func(context.Context, string, ...string) osfacade.Cmd {
+				return mockCmd
+			}).
+			Once()
+	}
+
+	expectSource(slowVMCRoot, func() error {
+		<-releaseSlowSource
+		return nil
+	}, "XILINX_VITIS=/tools/Xilinx/2025.1/Vitis\x00")
+	expectSource(fastVMCRoot, func() error {
+		return nil
+	}, "XILINX_VITIS=/tools/Xilinx/2025.2/Vitis\x00")
+
+	sourcer := vmcsettings.New(mockOSLayer)
+
+	slowDone := make(chan error, 1)
+	go func() {
+		_, err := sourcer.Environment(mockLogger, slowVMCRoot, nil)
+		slowDone <- err
+	}()
+
+	// Act
+	fast, err := sourcer.Environment(mockLogger, fastVMCRoot, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"XILINX_VITIS=/tools/Xilinx/2025.2/Vitis"}, fast)
+
+	close(releaseSlowSource)
+	require.NoError(t, <-slowDone)
+}
+
+func TestSourcer_Environment_ScriptFails(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	vmcRoot := filepath.Join(t.TempDir(), "Model_Composer")
+	script := writeSettingsScript(t, vmcRoot, `echo "XILINX_VITIS is not a Vitis installation" >&2; exit 3`)
+
+	sourcer := vmcsettings.New(osfacade.New())
+
+	// Act
+	result, err := sourcer.Environment(mockLogger, vmcRoot, []string{"PATH=/usr/bin"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), script)
+	assert.Contains(t, err.Error(), "XILINX_VITIS is not a Vitis installation")
+}
+
+func TestSourcer_Environment_NoScript(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	vmcRoot := filepath.Join(t.TempDir(), "Model_Composer")
+
+	sourcer := vmcsettings.New(osfacade.New())
+
+	// Act
+	result, err := sourcer.Environment(mockLogger, vmcRoot, []string{"PATH=/usr/bin"})
+
+	// Assert
+	require.ErrorContains(t, err, filepath.Join(vmcRoot, "settings64.sh"))
+	assert.Nil(t, result)
+}
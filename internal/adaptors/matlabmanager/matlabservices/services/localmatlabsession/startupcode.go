@@ -0,0 +1,24 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession
+
+import (
+	"strings"
+)
+
+// startupCode returns the code MATLAB runs when it starts: it sets up the MCP connection, then runs the user startup scripts in order.
+// The scripts run in the base workspace, so that the paths and variables they set stay available to tools.
+// MATLAB serves the embedded connector only once the startup code is done, so tools never see a session before its scripts ran.
+// A failing script is reported on standard error, which is logged in the session directory, and does not stop the scripts after it.
+func startupCode(sessionDirPath string, startupScripts []string) string {
+	code := "sessionPath = '" + sessionDirPath + "';addpath(sessionPath);matlab_mcp.initializeMCP();clear sessionPath;"
+
+	for _, script := range startupScripts {
+		quotedScript := "'" + strings.ReplaceAll(script, "'", "''") + "'"
+		code += "try,run(" + quotedScript + ");" +
+			"catch startupScriptError,fprintf(2,'Startup script %s failed: %s\\n'," + quotedScript + ",startupScriptError.message);" +
+			"end;clear startupScriptError;"
+	}
+
+	return code
+}
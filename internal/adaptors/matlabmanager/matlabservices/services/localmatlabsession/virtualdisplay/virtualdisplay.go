@@ -0,0 +1,41 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package virtualdisplay
+
+import (
+	"time"
+)
+
+const (
+	xvfbExecutable = "Xvfb"
+
+	// Xvfb writes the display number it picked to this file descriptor once it is ready for clients.
+	displayFD = 3
+
+	startTimeout = 30 * time.Second
+	stopTimeout  = 10 * time.Second
+)
+
+type OSLayer interface {
+	LookPath(file string) (string, error)
+}
+
+// Display describes a virtual display started for a MATLAB session.
+type Display struct {
+	// Name is the value of the DISPLAY environment variable for the display, for example ":99".
+	Name      string
+	ProcessID int
+}
+
+// VirtualDisplay starts Xvfb servers, so that MATLAB sessions without a desktop can still render graphics on machines without a display.
+type VirtualDisplay struct {
+	osLayer OSLayer
+}
+
+func New(
+	osLayer OSLayer,
+) *VirtualDisplay {
+	return &VirtualDisplay{
+		osLayer: osLayer,
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build !windows
+
+package virtualdisplay
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"golang.org/x/sys/unix"
+)
+
+// Start starts a virtual display for the session, logging to the session directory.
+// The returned function stops the display; the display is watched until then, and a warning is logged if it exits early.
+func (v *VirtualDisplay) Start(logger entities.Logger, sessionDir string) (Display, func(), error) {
+	xvfbPath, err := v.osLayer.LookPath(xvfbExecutable)
+	if err != nil {
+		return Display{}, nil, fmt.Errorf("%w: %s was not found on the PATH, install it (the xvfb package on most Linux distributions) or use --display-mode=nodesktop", entities.ErrNoDisplay, xvfbExecutable)
+	}
+
+	logFile, err := os.Create(filepath.Join(sessionDir, "xvfb.log")) //nolint:gosec // We construct this path, and file
+	if err != nil {
+		return Display{}, nil, fmt.Errorf("failed to create Xvfb log file: %w", err)
+	}
+	defer closeFile(logger, logFile)
+
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		return Display{}, nil, fmt.Errorf("failed to open %s: %w", os.DevNull, err)
+	}
+	defer closeFile(logger, devNull)
+
+	readDisplay, writeDisplay, err := os.Pipe()
+	if err != nil {
+		return Display{}, nil, fmt.Errorf("failed to create display pipe: %w", err)
+	}
+	defer closeFile(logger, readDisplay)
+
+	args := []string{
+		xvfbPath,
+		"-displayfd", strconv.Itoa(displayFD),
+		"-screen", "0", "1920x1080x24",
+		"-nolisten", "tcp",
+	}
+
+	process, err := os.StartProcess(xvfbPath, args, &os.ProcAttr{
+		Files: []*os.File{devNull, logFile, logFile, writeDisplay},
+		Sys: &unix.SysProcAttr{
+			Setsid: true, // Create a new session
+		},
+	})
+	// Only Xvfb writes to the pipe, so that reading it ends if Xvfb exits before picking a display.
+	closeFile(logger, writeDisplay)
+	if err != nil {
+		return Display{}, nil, fmt.Errorf("error starting %s: %w", xvfbExecutable, err)
+	}
+
+	logger = logger.With("xvfb-pid", process.Pid)
+
+	exited := make(chan struct{})
+	stopping := new(atomic.Bool)
+	go func() {
+		defer close(exited)
+		state, err := process.Wait()
+		if stopping.Load() {
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Warn("Failed to wait for the virtual display")
+			return
+		}
+		logger.With("status", state.String()).Warn("Virtual display exited unexpectedly, MATLAB graphics will fail")
+	}()
+
+	stop := func() {
+		stopping.Store(true)
+		stopProcess(logger, process, exited)
+	}
+
+	displayNumber, err := readDisplayNumber(readDisplay)
+	if err != nil {
+		stop()
+		return Display{}, nil, fmt.Errorf("%w: %s did not start, see %s: %w", entities.ErrNoDisplay, xvfbExecutable, logFile.Name(), err)
+	}
+
+	display := Display{
+		Name:      ":" + displayNumber,
+		ProcessID: process.Pid,
+	}
+
+	logger.With("display", display.Name).Debug("Started virtual display")
+
+	return display, stop, nil
+}
+
+// Stop stops a virtual display started by an earlier server.
+// It is not a child of this server, so it is only asked to exit.
+func (v *VirtualDisplay) Stop(logger entities.Logger, processID int) {
+	process, err := os.FindProcess(processID)
+	if err != nil {
+		return
+	}
+
+	if err := process.Signal(unix.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		logger.With("xvfb-pid", processID).WithError(err).Warn("Failed to stop virtual display")
+	}
+}
+
+func readDisplayNumber(readDisplay *os.File) (string, error) {
+	type result struct {
+		line string
+		err  error
+	}
+
+	resultC := make(chan result, 1)
+	go func() {
+		line, err := bufio.NewReader(readDisplay).ReadString('\n')
+		resultC <- result{line: line, err: err}
+	}()
+
+	select {
+	case res := <-resultC:
+		displayNumber := strings.TrimSpace(res.line)
+		if displayNumber == "" {
+			return "", fmt.Errorf("exited before reporting a display: %w", res.err)
+		}
+		if _, err := strconv.Atoi(displayNumber); err != nil {
+			return "", fmt.Errorf("reported an invalid display %q", displayNumber)
+		}
+		return displayNumber, nil
+	case <-time.After(startTimeout):
+		return "", fmt.Errorf("timed out after %v waiting for a display", startTimeout)
+	}
+}
+
+func stopProcess(logger entities.Logger, process *os.Process, exited <-chan struct{}) {
+	logger.Debug("Stopping virtual display")
+
+	if err := process.Signal(unix.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		logger.WithError(err).Warn("Failed to signal virtual display")
+	}
+
+	select {
+	case <-exited:
+	case <-time.After(stopTimeout):
+		logger.Warn("Timed out waiting for virtual display to exit, forcefully kill it")
+		if err := process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			logger.WithError(err).Warn("Failed to kill virtual display")
+		}
+	}
+}
+
+func closeFile(logger entities.Logger, file *os.File) {
+	if err := file.Close(); err != nil {
+		logger.WithError(err).Warn(fmt.Sprintf("Failed to close %v", file.Name()))
+	}
+}
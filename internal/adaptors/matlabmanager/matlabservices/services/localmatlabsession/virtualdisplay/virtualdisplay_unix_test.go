@@ -0,0 +1,112 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build !windows
+
+package virtualdisplay_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/virtualdisplay"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabservices/services/localmatlabsession/virtualdisplay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFakeXvfb writes a script standing in for Xvfb, which runs the given shell code.
+func writeFakeXvfb(t *testing.T, script string) string {
+	t.Helper()
+
+	xvfbPath := filepath.Join(t.TempDir(), "Xvfb")
+	err := os.WriteFile(xvfbPath, []byte("#!/bin/sh\n"+script+"\n"), 0o700) //nolint:gosec // Test script
+	require.NoError(t, err)
+
+	return xvfbPath
+}
+
+func TestVirtualDisplay_Start_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := t.TempDir()
+	xvfbPath := writeFakeXvfb(t, `echo 42 >&3; exec sleep 60`)
+
+	mockOSLayer.EXPECT().
+		LookPath("Xvfb").
+		Return(xvfbPath, nil).
+		Once()
+
+	virtualDisplay := virtualdisplay.New(mockOSLayer)
+
+	// Act
+	display, stop, err := virtualDisplay.Start(mockLogger, sessionDir)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, stop)
+	assert.Equal(t, ":42", display.Name)
+	assert.NotZero(t, display.ProcessID)
+	assert.FileExists(t, filepath.Join(sessionDir, "xvfb.log"))
+
+	stop()
+	assert.Empty(t, mockLogger.WarnLogs())
+}
+
+func TestVirtualDisplay_Start_XvfbNotFound(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockOSLayer.EXPECT().
+		LookPath("Xvfb").
+		Return("", assert.AnError).
+		Once()
+
+	virtualDisplay := virtualdisplay.New(mockOSLayer)
+
+	// Act
+	_, stop, err := virtualDisplay.Start(mockLogger, t.TempDir())
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrNoDisplay)
+	assert.ErrorContains(t, err, "--display-mode=nodesktop")
+	assert.Nil(t, stop)
+}
+
+func TestVirtualDisplay_Start_XvfbExitsBeforeReportingADisplay(t *testing.T) {
+	// Arrange
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	sessionDir := t.TempDir()
+	xvfbPath := writeFakeXvfb(t, `echo "cannot open display" >&2; exit 1`)
+
+	mockOSLayer.EXPECT().
+		LookPath("Xvfb").
+		Return(xvfbPath, nil).
+		Once()
+
+	virtualDisplay := virtualdisplay.New(mockOSLayer)
+
+	// Act
+	_, stop, err := virtualDisplay.Start(mockLogger, sessionDir)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrNoDisplay)
+	assert.ErrorContains(t, err, filepath.Join(sessionDir, "xvfb.log"))
+	assert.Nil(t, stop)
+
+	xvfbLog, err := os.ReadFile(filepath.Join(sessionDir, "xvfb.log")) //nolint:gosec // Test file
+	require.NoError(t, err)
+	assert.Contains(t, string(xvfbLog), "cannot open display")
+}
@@ -0,0 +1,17 @@
+// Copyright 2025 The MathWorks, Inc.
+//go:build windows
+
+package virtualdisplay
+
+import (
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Start is not supported on Windows, where MATLAB does not need an X server.
+func (v *VirtualDisplay) Start(_ entities.Logger, _ string) (Display, func(), error) {
+	return Display{}, nil, fmt.Errorf("%w: virtual displays are only supported on Linux", entities.ErrNoDisplay)
+}
+
+func (v *VirtualDisplay) Stop(_ entities.Logger, _ int) {}
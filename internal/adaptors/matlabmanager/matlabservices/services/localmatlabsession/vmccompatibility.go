@@ -0,0 +1,66 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// supportedMATLABReleases lists the MATLAB releases that each release of Vitis Model Composer supports,
+// as given in the "Supported MATLAB Versions" section of the Vitis Model Composer User Guide (UG1483) for that release.
+// Add each new release from its user guide. Releases that are missing from the table are not checked, so that newer releases keep working,
+// and --skip-vmc-compatibility-check turns the errors into warnings in case the table is wrong.
+var supportedMATLABReleases = map[string][]string{
+	"2023.2": {"R2022a", "R2022b", "R2023a"},
+	"2024.1": {"R2022b", "R2023a", "R2023b"},
+	"2024.2": {"R2023a", "R2023b", "R2024a"},
+	"2025.1": {"R2023b", "R2024a", "R2024b"},
+	"2025.2": {"R2024a", "R2024b", "R2025a"},
+}
+
+var vmcReleasePattern = regexp.MustCompile(`^\d{4}\.\d$`)
+
+// checkVMCCompatibility returns an error if the Vitis Model Composer installation does not support the MATLAB release.
+// The Vitis Model Composer release is read from the installation path, such as /tools/Xilinx/2025.2/Model_Composer.
+func checkVMCCompatibility(logger entities.Logger, vmcRoot string, matlabRelease string) error {
+	vmcRelease, found := vmcReleaseFromPath(vmcRoot)
+	if !found || matlabRelease == "" {
+		logger.
+			With("vmc_root", vmcRoot).
+			With("matlab_release", matlabRelease).
+			Debug("Unknown Vitis Model Composer or MATLAB release, skipping the compatibility check")
+		return nil
+	}
+
+	supportedReleases, known := supportedMATLABReleases[vmcRelease]
+	if !known {
+		logger.
+			With("vmc_release", vmcRelease).
+			With("matlab_release", matlabRelease).
+			Warn("The MATLAB releases supported by this Vitis Model Composer release are not known, skipping the compatibility check")
+		return nil
+	}
+
+	if slices.Contains(supportedReleases, matlabRelease) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: Vitis Model Composer %s supports MATLAB %s, but the MATLAB root is %s",
+		entities.ErrIncompatibleMATLABRelease, vmcRelease, strings.Join(supportedReleases, ", "), matlabRelease)
+}
+
+func vmcReleaseFromPath(vmcRoot string) (string, bool) {
+	elements := strings.Split(filepath.ToSlash(filepath.Clean(vmcRoot)), "/")
+	for i := len(elements) - 1; i >= 0; i-- {
+		if vmcReleasePattern.MatchString(elements[i]) {
+			return elements[i], true
+		}
+	}
+	return "", false
+}
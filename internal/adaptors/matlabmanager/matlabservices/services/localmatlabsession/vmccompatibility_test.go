@@ -0,0 +1,85 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package localmatlabsession_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckVMCCompatibility(t *testing.T) {
+	testCases := []struct {
+		name          string
+		vmcRoot       string
+		matlabRelease string
+		expectedError error
+	}{
+		{
+			name:          "supported release",
+			vmcRoot:       filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+			matlabRelease: "R2024b",
+		},
+		{
+			name:          "release after the installation folder",
+			vmcRoot:       filepath.Join("tools", "Xilinx", "Model_Composer", "2024.2"),
+			matlabRelease: "R2023b",
+		},
+		{
+			name:          "unsupported release",
+			vmcRoot:       filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+			matlabRelease: "R2022a",
+			expectedError: entities.ErrIncompatibleMATLABRelease,
+		},
+		{
+			name:          "unknown Vitis Model Composer release",
+			vmcRoot:       filepath.Join("tools", "Xilinx", "2099.1", "Model_Composer"),
+			matlabRelease: "R2022a",
+		},
+		{
+			name:          "no release in the Vitis Model Composer path",
+			vmcRoot:       filepath.Join("opt", "Model_Composer"),
+			matlabRelease: "R2022a",
+		},
+		{
+			name:          "unknown MATLAB release",
+			vmcRoot:       filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+			matlabRelease: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			// Act
+			err := localmatlabsession.CheckVMCCompatibility(mockLogger, testCase.vmcRoot, testCase.matlabRelease)
+
+			// Assert
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, testCase.expectedError)
+			assert.Contains(t, err.Error(), "Vitis Model Composer 2025.1 supports MATLAB R2023b, R2024a, R2024b")
+		})
+	}
+}
+
+func TestCheckVMCCompatibility_WarnsForUnknownVMCRelease(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	// Act
+	err := localmatlabsession.CheckVMCCompatibility(mockLogger, filepath.Join("tools", "Xilinx", "2099.1", "Model_Composer"), "R2022a")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, mockLogger.WarnLogs(), "The MATLAB releases supported by this Vitis Model Composer release are not known, skipping the compatibility check")
+}
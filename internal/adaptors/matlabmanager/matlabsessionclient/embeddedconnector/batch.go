@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Batch sends the requests in one payload for each run of consecutive requests of the same kind.
+// The connector runs the messages of one kind in the order of the payload, but does not order messages of different kinds.
+// Once a request of a run fails, the later runs are not sent, and their responses hold ErrBatchRequestNotRun.
+func (c *Client) Batch(ctx context.Context, logger entities.Logger, requests []entities.BatchRequest) ([]entities.BatchResponse, error) {
+	stopProgress := c.reportElapsedProgress(ctx, "Evaluating MATLAB code")
+	defer stopProgress()
+
+	responses := make([]entities.BatchResponse, 0, len(requests))
+	start := 0
+	for start < len(requests) {
+		end := start + 1
+		for end < len(requests) && sameKind(requests[start], requests[end]) {
+			end++
+		}
+
+		runResponses, err := c.sendBatch(ctx, logger, requests[start:end])
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, runResponses...)
+
+		start = end
+		if entities.FirstBatchError(runResponses) != nil {
+			break
+		}
+	}
+
+	for range requests[start:] {
+		responses = append(responses, entities.BatchResponse{Err: entities.ErrBatchRequestNotRun})
+	}
+
+	return responses, nil
+}
+
+func (c *Client) sendBatch(ctx context.Context, logger entities.Logger, requests []entities.BatchRequest) ([]entities.BatchResponse, error) {
+	var payload ConnectorPayload
+	for _, request := range requests {
+		switch r := request.(type) {
+		case entities.EvalRequest:
+			payload.Messages.Eval = append(payload.Messages.Eval, EvalMessage{Code: r.Code})
+		case entities.FEvalRequest:
+			message, err := newFevalMessage(r)
+			if err != nil {
+				return nil, err
+			}
+			payload.Messages.FEval = append(payload.Messages.FEval, message)
+		default:
+			return nil, fmt.Errorf("unsupported batch request %T", request)
+		}
+	}
+
+	tracedCtx, requestSent := traceRequestSent(ctx)
+	response, err := c.sendRequestToEvaluationEndpoint(tracedCtx, logger, payload)
+	if err != nil {
+		return nil, c.interruptIfCancelled(ctx, logger, requestSent.Load(), err)
+	}
+
+	evalResponses := response.Messages.EvalResponse
+	fevalResponses := response.Messages.FevalResponse
+	if len(evalResponses) != len(payload.Messages.Eval) || len(fevalResponses) != len(payload.Messages.FEval) {
+		logger.
+			With("eval-responses", len(evalResponses)).
+			With("feval-responses", len(fevalResponses)).
+			Error("Unexpected number of response messages received")
+		return nil, fmt.Errorf("received %d response messages for %d requests", len(evalResponses)+len(fevalResponses), len(requests))
+	}
+
+	// Responses of each kind are in the order of the requests of that kind.
+	responses := make([]entities.BatchResponse, len(requests))
+	for i, request := range requests {
+		switch request.(type) {
+		case entities.EvalRequest:
+			responses[i].Eval, responses[i].Err = evalResult(evalResponses[0])
+			evalResponses = evalResponses[1:]
+		case entities.FEvalRequest:
+			responses[i].FEval, responses[i].Err = fevalResult(logger, fevalResponses[0])
+			fevalResponses = fevalResponses[1:]
+		}
+	}
+
+	return responses, nil
+}
+
+func sameKind(a entities.BatchRequest, b entities.BatchRequest) bool {
+	_, aIsEval := a.(entities.EvalRequest)
+	_, bIsEval := b.(entities.EvalRequest)
+	return aIsEval == bIsEval
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -18,6 +17,8 @@ import (
 
 const defaultPingRetry = 100 * time.Millisecond
 const defaultPingTimeout = 1 * time.Second
+const defaultProgressInterval = 10 * time.Second
+const defaultInterruptTimeout = 5 * time.Second
 
 type HttpClientFactory interface {
 	NewClientForSelfSignedTLSServer(certificatePEM []byte) (httpclientfactory.HttpClient, error)
@@ -36,8 +37,10 @@ type Client struct {
 	apiKey     string
 	httpClient httpclientfactory.HttpClient
 
-	pingRetry   time.Duration
-	pingTimeout time.Duration
+	pingRetry        time.Duration
+	pingTimeout      time.Duration
+	progressInterval time.Duration
+	interruptTimeout time.Duration
 }
 
 func NewClient(
@@ -55,8 +58,10 @@ func NewClient(
 		apiKey:     endpoint.APIKey,
 		httpClient: httpClient,
 
-		pingRetry:   defaultPingRetry,
-		pingTimeout: defaultPingTimeout,
+		pingRetry:        defaultPingRetry,
+		pingTimeout:      defaultPingTimeout,
+		progressInterval: defaultProgressInterval,
+		interruptTimeout: defaultInterruptTimeout,
 	}, nil
 }
 
@@ -68,6 +73,14 @@ func (c *Client) SetPingRetry(retry time.Duration) {
 	c.pingRetry = retry
 }
 
+func (c *Client) SetProgressInterval(interval time.Duration) {
+	c.progressInterval = interval
+}
+
+func (c *Client) SetInterruptTimeout(timeout time.Duration) {
+	c.interruptTimeout = timeout
+}
+
 func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
 	payload := ConnectorPayload{
 		Messages: ConnectorMessage{
@@ -79,9 +92,12 @@ func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entitie
 		},
 	}
 
-	response, err := c.sendRequestToEvaluationEndpoint(ctx, logger, payload)
+	stopProgress := c.reportElapsedProgress(ctx, "Evaluating MATLAB code")
+	tracedCtx, requestSent := traceRequestSent(ctx)
+	response, err := c.sendRequestToEvaluationEndpoint(tracedCtx, logger, payload)
+	stopProgress()
 	if err != nil {
-		return entities.EvalResponse{}, err
+		return entities.EvalResponse{}, c.interruptIfCancelled(ctx, logger, requestSent.Load(), err)
 	}
 
 	if len(response.Messages.EvalResponse) == 0 {
@@ -89,24 +105,19 @@ func (c *Client) Eval(ctx context.Context, logger entities.Logger, input entitie
 		return entities.EvalResponse{}, fmt.Errorf("no response messages received")
 	}
 
-	if response.Messages.EvalResponse[0].IsError {
-		return entities.EvalResponse{}, newMATLABError(response.Messages.EvalResponse[0].ResponseStr)
-	}
-
-	return entities.EvalResponse{
-		ConsoleOutput: response.Messages.EvalResponse[0].ResponseStr,
-		Images:        nil,
-	}, nil
+	return evalResult(response.Messages.EvalResponse[0])
 }
 
 func (c *Client) EvalWithCapture(ctx context.Context, logger entities.Logger, input entities.EvalRequest) (entities.EvalResponse, error) {
 	fevalRequest := entities.FEvalRequest{
 		Function:   "matlab_mcp.mcpEval",
-		Arguments:  []string{input.Code},
+		Arguments:  []entities.MATLABValue{entities.MATLABChar{Value: input.Code}},
 		NumOutputs: 1,
 	}
 
-	response, err := c.FEval(ctx, logger, fevalRequest)
+	stopProgress := c.reportElapsedProgress(ctx, "Evaluating MATLAB code")
+	response, err := c.feval(ctx, logger, fevalRequest)
+	stopProgress()
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
@@ -120,21 +131,28 @@ func (c *Client) EvalWithCapture(ctx context.Context, logger entities.Logger, in
 }
 
 func (c *Client) FEval(ctx context.Context, logger entities.Logger, input entities.FEvalRequest) (entities.FEvalResponse, error) {
+	stopProgress := c.reportElapsedProgress(ctx, "Running MATLAB function "+input.Function)
+	defer stopProgress()
+
+	return c.feval(ctx, logger, input)
+}
+
+func (c *Client) feval(ctx context.Context, logger entities.Logger, input entities.FEvalRequest) (entities.FEvalResponse, error) {
+	message, err := newFevalMessage(input)
+	if err != nil {
+		return entities.FEvalResponse{}, err
+	}
+
 	payload := ConnectorPayload{
 		Messages: ConnectorMessage{
-			FEval: []FevalMessage{
-				{
-					Function:  input.Function,
-					Arguments: input.Arguments,
-					Nargout:   input.NumOutputs,
-				},
-			},
+			FEval: []FevalMessage{message},
 		},
 	}
 
-	response, err := c.sendRequestToEvaluationEndpoint(ctx, logger, payload)
+	tracedCtx, requestSent := traceRequestSent(ctx)
+	response, err := c.sendRequestToEvaluationEndpoint(tracedCtx, logger, payload)
 	if err != nil {
-		return entities.FEvalResponse{}, err
+		return entities.FEvalResponse{}, c.interruptIfCancelled(ctx, logger, requestSent.Load(), err)
 	}
 
 	if len(response.Messages.FevalResponse) == 0 {
@@ -142,25 +160,51 @@ func (c *Client) FEval(ctx context.Context, logger entities.Logger, input entiti
 		return entities.FEvalResponse{}, fmt.Errorf("no response messages received")
 	}
 
-	if response.Messages.FevalResponse[0].IsError {
-		if len(response.Messages.FevalResponse[0].MessageFaults) == 0 {
+	return fevalResult(logger, response.Messages.FevalResponse[0])
+}
+
+func newFevalMessage(input entities.FEvalRequest) (FevalMessage, error) {
+	arguments, err := encodeValues(input.Arguments)
+	if err != nil {
+		return FevalMessage{}, err
+	}
+
+	return FevalMessage{
+		Function:  input.Function,
+		Arguments: arguments,
+		Nargout:   input.NumOutputs,
+	}, nil
+}
+
+func evalResult(message EvalResponseMessage) (entities.EvalResponse, error) {
+	if message.IsError {
+		return entities.EvalResponse{}, newMATLABErrorFromReport(message.ResponseStr)
+	}
+
+	return entities.EvalResponse{
+		ConsoleOutput: message.ResponseStr,
+		Images:        nil,
+	}, nil
+}
+
+func fevalResult(logger entities.Logger, message FevalResponseMessage) (entities.FEvalResponse, error) {
+	if message.IsError {
+		if len(message.MessageFaults) == 0 {
 			logger.Error("Response was in error state but no fault messages received")
 			return entities.FEvalResponse{}, fmt.Errorf("response was in error state but no fault messages received")
 		}
 
-		var errorMessage string
-		for _, rawFault := range response.Messages.FevalResponse[0].MessageFaults {
-			var f Fault
-			if err := json.Unmarshal(rawFault, &f); err != nil {
-				logger.WithError(err).Warn("Failed to deserialize fault message into a fault")
-			}
-			errorMessage += f.Message + "\n\n"
-		}
-		return entities.FEvalResponse{}, newMATLABError(errorMessage)
+		return entities.FEvalResponse{}, newMATLABErrorFromFaults(logger, message.MessageFaults)
+	}
+
+	outputs, err := decodeValues(message.Results)
+	if err != nil {
+		logger.WithError(err).Error("Failed to decode FEval results")
+		return entities.FEvalResponse{}, err
 	}
 
 	return entities.FEvalResponse{
-		Outputs: response.Messages.FevalResponse[0].Results,
+		Outputs: outputs,
 	}, nil
 }
 
@@ -210,16 +254,7 @@ func (c *Client) pingMATLAB(ctx context.Context, logger entities.Logger) (bool,
 
 	messageFaults := response.Messages.PingResponse[0].MessageFaults
 	if len(messageFaults) > 0 {
-		var errorMessage strings.Builder
-		for _, rawFault := range response.Messages.PingResponse[0].MessageFaults {
-			var f Fault
-			if err := json.Unmarshal(rawFault, &f); err != nil {
-				logger.WithError(err).Warn("Failed to deserialize fault message into a fault")
-			}
-			errorMessage.WriteString(f.Message)
-			errorMessage.WriteString("\n\n")
-		}
-		return false, newMATLABError(errorMessage.String())
+		return false, newMATLABErrorFromFaults(logger, messageFaults)
 	}
 
 	return true, nil
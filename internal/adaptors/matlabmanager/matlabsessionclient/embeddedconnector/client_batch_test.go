@@ -0,0 +1,241 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	httpclientfactorymocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/httpclientfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// connectorResponse returns an HTTP response that holds payload.
+func connectorResponse(t *testing.T, payload embeddedconnector.ConnectorPayload) *http.Response {
+	t.Helper()
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+// sentPayload decodes the payload of a request sent to the connector.
+func sentPayload(t *testing.T, request *http.Request) embeddedconnector.ConnectorPayload {
+	t.Helper()
+
+	var payload embeddedconnector.ConnectorPayload
+	require.NoError(t, json.NewDecoder(request.Body).Decode(&payload))
+	return payload
+}
+
+func TestClient_Batch_SendsRequestsOfTheSameKindTogether(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	var sent embeddedconnector.ConnectorPayload
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(request *http.Request) (*http.Response, error) {
+			sent = sentPayload(t, request)
+			return connectorResponse(t, embeddedconnector.ConnectorPayload{
+				Messages: embeddedconnector.ConnectorMessage{
+					EvalResponse: []embeddedconnector.EvalResponseMessage{
+						{IsError: false, ResponseStr: ""},
+						{IsError: true, ResponseStr: "Error in myScript (line 3)"},
+					},
+				},
+			}), nil
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	requests := []entities.BatchRequest{
+		entities.EvalRequest{Code: "cd('folder')"},
+		entities.EvalRequest{Code: "myScript"},
+	}
+
+	// Act
+	responses, err := client.Batch(t.Context(), mockLogger, requests)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []embeddedconnector.EvalMessage{{Code: "cd('folder')"}, {Code: "myScript"}}, sent.Messages.Eval, "Both requests should be sent in one payload")
+	assert.Empty(t, sent.Messages.FEval)
+
+	require.Len(t, responses, 2)
+	require.NoError(t, responses[0].Err)
+
+	var matlabError *entities.MATLABError
+	require.ErrorAs(t, responses[1].Err, &matlabError)
+	assert.Equal(t, []entities.MATLABStackFrame{{Name: "myScript", Line: 3}}, matlabError.Stack)
+}
+
+func TestClient_Batch_KeepsTheOrderOfRequestsOfDifferentKinds(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	var sent []embeddedconnector.ConnectorPayload
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(request *http.Request) (*http.Response, error) {
+			payload := sentPayload(t, request)
+			sent = append(sent, payload)
+
+			var response embeddedconnector.ConnectorPayload
+			for _, message := range payload.Messages.Eval {
+				response.Messages.EvalResponse = append(response.Messages.EvalResponse, embeddedconnector.EvalResponseMessage{ResponseStr: message.Code})
+			}
+			for range payload.Messages.FEval {
+				response.Messages.FevalResponse = append(response.Messages.FevalResponse, embeddedconnector.FevalResponseMessage{
+					Results: []json.RawMessage{json.RawMessage(`42`)},
+				})
+			}
+			return connectorResponse(t, response), nil
+		}).
+		Times(3)
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	requests := []entities.BatchRequest{
+		entities.EvalRequest{Code: "x = 1"},
+		entities.FEvalRequest{Function: "answer", NumOutputs: 1},
+		entities.EvalRequest{Code: "y = 2"},
+	}
+
+	// Act
+	responses, err := client.Batch(t.Context(), mockLogger, requests)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sent, 3, "Requests of different kinds should be sent in separate payloads, in order")
+	assert.Len(t, sent[0].Messages.Eval, 1)
+	assert.Len(t, sent[1].Messages.FEval, 1)
+	assert.Len(t, sent[2].Messages.Eval, 1)
+
+	assert.Equal(t, []entities.BatchResponse{
+		{Eval: entities.EvalResponse{ConsoleOutput: "x = 1"}},
+		{FEval: entities.FEvalResponse{Outputs: []entities.MATLABValue{entities.NewMATLABDouble(42)}}},
+		{Eval: entities.EvalResponse{ConsoleOutput: "y = 2"}},
+	}, responses)
+}
+
+func TestClient_Batch_StopsAtTheFirstFailedRun(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		Return(connectorResponse(t, embeddedconnector.ConnectorPayload{
+			Messages: embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{
+					{IsError: true, ResponseStr: "Undefined function 'missing'"},
+				},
+			},
+		}), nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	requests := []entities.BatchRequest{
+		entities.EvalRequest{Code: "missing"},
+		entities.FEvalRequest{Function: "answer", NumOutputs: 1},
+		entities.EvalRequest{Code: "y = 2"},
+	}
+
+	// Act
+	responses, err := client.Batch(t.Context(), mockLogger, requests)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, responses, 3, "Every request should have a response")
+
+	var matlabError *entities.MATLABError
+	require.ErrorAs(t, responses[0].Err, &matlabError)
+	require.ErrorIs(t, responses[1].Err, entities.ErrBatchRequestNotRun)
+	require.ErrorIs(t, responses[2].Err, entities.ErrBatchRequestNotRun)
+}
+
+func TestClient_Batch_MissingResponseMessages(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		Return(connectorResponse(t, embeddedconnector.ConnectorPayload{
+			Messages: embeddedconnector.ConnectorMessage{
+				EvalResponse: []embeddedconnector.EvalResponseMessage{{ResponseStr: ""}},
+			},
+		}), nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	requests := []entities.BatchRequest{
+		entities.EvalRequest{Code: "cd('folder')"},
+		entities.EvalRequest{Code: "code"},
+	}
+
+	// Act
+	responses, err := client.Batch(t.Context(), mockLogger, requests)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, responses)
+}
+
+func TestClient_Batch_DoErrors(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		Return(nil, assert.AnError).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	requests := []entities.BatchRequest{
+		entities.EvalRequest{Code: "x = 1"},
+		entities.FEvalRequest{Function: "answer", NumOutputs: 1},
+	}
+
+	// Act
+	responses, err := client.Batch(t.Context(), mockLogger, requests)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, responses)
+}
@@ -9,3 +9,5 @@ import (
 func (c *Client) SetHttpClient(httpClient httpclientfactory.HttpClient) {
 	c.httpClient = httpClient
 }
+
+var NewMATLABErrorFromReport = newMATLABErrorFromReport
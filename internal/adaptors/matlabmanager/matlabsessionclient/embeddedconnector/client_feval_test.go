@@ -3,8 +3,12 @@
 package embeddedconnector_test
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
@@ -74,3 +78,87 @@ func TestClient_FEval_ContextPropagation(t *testing.T) {
 	require.Error(t, err)
 	assert.Empty(t, response)
 }
+
+func TestClient_FEval_ExamplePayloads(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	expectedRequest, err := os.ReadFile(filepath.Join("testdata", "feval_request.json"))
+	require.NoError(t, err)
+	exampleResponse, err := os.ReadFile(filepath.Join("testdata", "feval_logical_response.json"))
+	require.NoError(t, err)
+
+	var sentRequest []byte
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(request *http.Request) (*http.Response, error) {
+			var err error
+			sentRequest, err = io.ReadAll(request.Body)
+			require.NoError(t, err)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(exampleResponse)),
+			}, nil
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	fevalRequest := entities.FEvalRequest{
+		Function: "isfield",
+		Arguments: []entities.MATLABValue{
+			entities.MATLABStruct{
+				Dimensions: []int{1, 1},
+				Fields:     []string{"Block", "Latency"},
+				Values: []map[string]entities.MATLABValue{{
+					"Block":   entities.MATLABChar{Value: "model/Gain"},
+					"Latency": entities.MATLABNumeric{NumericClass: entities.MATLABClassUint8, Dimensions: []int{1, 1}, Values: []float64{2}},
+				}},
+			},
+			entities.MATLABString{Dimensions: []int{1, 2}, Values: []*string{stringPointer("Latency"), stringPointer("Gain")}},
+		},
+		NumOutputs: 1,
+	}
+
+	// Act
+	response, err := client.FEval(t.Context(), mockLogger, fevalRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expectedRequest), string(sentRequest))
+	assert.Equal(t, []entities.MATLABValue{
+		entities.MATLABLogical{Dimensions: []int{1, 2}, Values: []bool{true, false}},
+	}, response.Outputs)
+}
+
+func TestClient_FEval_UnsupportedResult(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"messages":{"FEvalResponse":[{"isError":false,"messageFaults":[],"results":[{"mwtype":"double","mwsize":[1,1],"mwdata":[1],"mwcomplex":true}]}]}}`,
+			)),
+		}, nil).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+
+	// Act
+	response, err := client.FEval(t.Context(), mockLogger, entities.FEvalRequest{Function: "sqrt", NumOutputs: 1})
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, response)
+}
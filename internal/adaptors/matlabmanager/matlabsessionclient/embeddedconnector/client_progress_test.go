@@ -0,0 +1,151 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	httpclientfactorymocks "github.com/matlab/matlab-mcp-core-server/mocks/utils/httpclientfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Eval_ReportsElapsedProgress(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockProgressReporter := &entitiesmocks.MockProgressReporter{}
+	defer mockProgressReporter.AssertExpectations(t)
+
+	reported := make(chan struct{}, 1)
+
+	mockProgressReporter.EXPECT().
+		Report(mock.MatchedBy(func(message string) bool {
+			return strings.HasPrefix(message, "Evaluating MATLAB code: ") && strings.HasSuffix(message, " elapsed")
+		})).
+		Run(func(string) {
+			select {
+			case reported <- struct{}{}:
+			default:
+			}
+		})
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(*http.Request) (*http.Response, error) {
+			select {
+			case <-reported:
+			case <-time.After(5 * time.Second):
+			}
+			return nil, assert.AnError
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+	client.SetProgressInterval(time.Millisecond)
+
+	ctx := entities.WithProgressReporter(t.Context(), mockProgressReporter)
+	evalRequest := entities.EvalRequest{
+		Code: "pause(60)",
+	}
+
+	// Act
+	_, err := client.Eval(ctx, mockLogger, evalRequest)
+
+	// Assert
+	require.Error(t, err)
+	mockProgressReporter.AssertCalled(t, "Report", mock.Anything)
+}
+
+func TestClient_FEval_ReportsElapsedProgressWithFunctionName(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockProgressReporter := &entitiesmocks.MockProgressReporter{}
+	defer mockProgressReporter.AssertExpectations(t)
+
+	reported := make(chan struct{}, 1)
+
+	mockProgressReporter.EXPECT().
+		Report(mock.MatchedBy(func(message string) bool {
+			return strings.HasPrefix(message, "Running MATLAB function sim: ")
+		})).
+		Run(func(string) {
+			select {
+			case reported <- struct{}{}:
+			default:
+			}
+		})
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(*http.Request) (*http.Response, error) {
+			select {
+			case <-reported:
+			case <-time.After(5 * time.Second):
+			}
+			return nil, assert.AnError
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+	client.SetProgressInterval(time.Millisecond)
+
+	ctx := entities.WithProgressReporter(t.Context(), mockProgressReporter)
+	fevalRequest := entities.FEvalRequest{
+		Function: "sim",
+	}
+
+	// Act
+	_, err := client.FEval(ctx, mockLogger, fevalRequest)
+
+	// Assert
+	require.Error(t, err)
+	mockProgressReporter.AssertCalled(t, "Report", mock.Anything)
+}
+
+func TestClient_Eval_NoProgressReporter_DoesNotReport(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockHttpClient := &httpclientfactorymocks.MockHttpClient{}
+	defer mockHttpClient.AssertExpectations(t)
+
+	mockHttpClient.EXPECT().
+		Do(mock.AnythingOfType("*http.Request")).
+		RunAndReturn(func(*http.Request) (*http.Response, error) {
+			time.Sleep(10 * time.Millisecond)
+			return nil, assert.AnError
+		}).
+		Once()
+
+	client := embeddedconnector.Client{}
+	client.SetHttpClient(mockHttpClient)
+	client.SetProgressInterval(time.Millisecond)
+
+	evalRequest := entities.EvalRequest{
+		Code: "ver",
+	}
+
+	// Act
+	_, err := client.Eval(t.Context(), mockLogger, evalRequest)
+
+	// Assert
+	require.Error(t, err)
+}
@@ -15,12 +15,14 @@ type ConnectorPayload struct {
 }
 
 type ConnectorMessage struct {
-	Eval          []EvalMessage          `json:"Eval,omitempty"`
-	FEval         []FevalMessage         `json:"FEval,omitempty"`
-	EvalResponse  []EvalResponseMessage  `json:"EvalResponse,omitempty"`
-	FevalResponse []FevalResponseMessage `json:"FEvalResponse,omitempty"`
-	Ping          []PingMessage          `json:"Ping,omitempty"`
-	PingResponse  []PingResponseMessage  `json:"PingResponse,omitempty"`
+	Eval              []EvalMessage              `json:"Eval,omitempty"`
+	FEval             []FevalMessage             `json:"FEval,omitempty"`
+	EvalResponse      []EvalResponseMessage      `json:"EvalResponse,omitempty"`
+	FevalResponse     []FevalResponseMessage     `json:"FEvalResponse,omitempty"`
+	Ping              []PingMessage              `json:"Ping,omitempty"`
+	PingResponse      []PingResponseMessage      `json:"PingResponse,omitempty"`
+	Interrupt         []InterruptMessage         `json:"Interrupt,omitempty"`
+	InterruptResponse []InterruptResponseMessage `json:"InterruptResponse,omitempty"`
 }
 
 type EvalMessage struct {
@@ -32,17 +34,18 @@ type EvalResponseMessage struct {
 	ResponseStr string `json:"responseStr"`
 }
 
+// FevalMessage and FevalResponseMessage hold MATLAB values in the JSON notation of the embedded connector, see values.go.
 type FevalMessage struct {
-	Function  string   `json:"function"`
-	Arguments []string `json:"arguments"`
-	Nargout   int      `json:"nargout"`
-	DequeMode string   `json:"dequeMode"`
+	Function  string            `json:"function"`
+	Arguments []json.RawMessage `json:"arguments"`
+	Nargout   int               `json:"nargout"`
+	DequeMode string            `json:"dequeMode"`
 }
 
 type FevalResponseMessage struct {
 	IsError       bool              `json:"isError"`
 	MessageFaults []json.RawMessage `json:"messageFaults"`
-	Results       []interface{}     `json:"results"`
+	Results       []json.RawMessage `json:"results"`
 }
 
 type PingMessage struct {
@@ -52,8 +55,26 @@ type PingResponseMessage struct {
 	MessageFaults []json.RawMessage `json:"messageFaults"`
 }
 
+type InterruptMessage struct {
+}
+
+type InterruptResponseMessage struct {
+	MessageFaults []json.RawMessage `json:"messageFaults"`
+}
+
+// Fault is a fault reported by the embedded connector. For an error thrown by MATLAB code,
+// it holds the identifier, stack and causes of the MException when MATLAB reported them.
 type Fault struct {
-	Message string `json:"message"`
+	Message    string       `json:"message"`
+	Identifier string       `json:"identifier,omitempty"`
+	Stack      []FaultFrame `json:"stack,omitempty"`
+	Cause      []Fault      `json:"cause,omitempty"`
+}
+
+type FaultFrame struct {
+	File string `json:"file"`
+	Name string `json:"name"`
+	Line int    `json:"line"`
 }
 
 type LiveEditorResponseEntry struct {
@@ -135,13 +156,13 @@ func parseEvalWithCaptureResponse(response entities.FEvalResponse) (entities.Eva
 		return entities.EvalResponse{}, fmt.Errorf("unexpected number of outputs from MATLAB session")
 	}
 
-	consoleData, ok := response.Outputs[0].(string)
+	consoleData, ok := response.Outputs[0].(entities.MATLABChar)
 	if !ok {
 		return entities.EvalResponse{}, fmt.Errorf("failed to cast output to string")
 	}
 
 	var parsedResponse []json.RawMessage
-	err := json.Unmarshal([]byte(consoleData), &parsedResponse)
+	err := json.Unmarshal([]byte(consoleData.Value), &parsedResponse)
 	if err != nil {
 		return entities.EvalResponse{}, fmt.Errorf("failed to parse JSON response: %w", err)
 	}
@@ -2,18 +2,104 @@
 
 package embeddedconnector
 
-import "fmt"
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
 
-type matlabError struct {
-	message string
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+var (
+	// hyperlinkPattern matches the hyperlinks that MATLAB adds to error reports when hotlinks are on.
+	hyperlinkPattern = regexp.MustCompile(`<a\s(?:[^>"]|"[^"]*")*>|</a>`)
+	// openToLinePattern matches the hyperlink to the file and line of a stack frame, where quotes in the file are doubled.
+	openToLinePattern = regexp.MustCompile(`opentoline\('((?:[^']|'')*)',\s*(\d+)`)
+	// stackFramePattern matches a stack frame in an error report, such as "Error in myScript (line 12)".
+	stackFramePattern = regexp.MustCompile(`^\s*Error (?:in|using) (.+?) \(line (\d+)\)`)
+	// syntaxErrorPattern matches the location of a syntax error, such as "Error: File: myScript.m Line: 3 Column: 5".
+	syntaxErrorPattern = regexp.MustCompile(`^\s*Error: File: (.+?) Line: (\d+) Column: \d+`)
+)
+
+// newMATLABErrorFromFaults returns the error for the faults of a response.
+// Its message joins the messages of all faults, and its other details are those of the first fault.
+func newMATLABErrorFromFaults(logger entities.Logger, rawFaults []json.RawMessage) *entities.MATLABError {
+	matlabError := &entities.MATLABError{}
+	messages := make([]string, len(rawFaults))
+	for i, rawFault := range rawFaults {
+		var f Fault
+		if err := json.Unmarshal(rawFault, &f); err != nil {
+			logger.WithError(err).Warn("Failed to deserialize fault message into a fault")
+		}
+		if i == 0 {
+			*matlabError = f.toMATLABError()
+		}
+		messages[i] = f.Message
+	}
+
+	matlabError.Message = strings.Join(messages, "\n\n")
+	return matlabError
 }
 
-func newMATLABError(message string) matlabError {
-	return matlabError{
-		message: message,
+func (f Fault) toMATLABError() entities.MATLABError {
+	matlabError := entities.MATLABError{
+		Identifier: f.Identifier,
+		Message:    f.Message,
+	}
+
+	for _, frame := range f.Stack {
+		matlabError.Stack = append(matlabError.Stack, entities.MATLABStackFrame{
+			File: frame.File,
+			Name: frame.Name,
+			Line: frame.Line,
+		})
 	}
+
+	for _, cause := range f.Cause {
+		matlabError.Causes = append(matlabError.Causes, cause.toMATLABError())
+	}
+
+	return matlabError
 }
 
-func (e matlabError) Error() string {
-	return fmt.Sprintf("matlab error: %v", e.message)
+// newMATLABErrorFromReport returns the error for the report that MATLAB prints for an error, which is all that an Eval response holds.
+// The stack is recovered from the lines of the report that locate the error; the identifier is not part of the report.
+func newMATLABErrorFromReport(report string) *entities.MATLABError {
+	matlabError := &entities.MATLABError{
+		Message: strings.TrimSpace(hyperlinkPattern.ReplaceAllString(report, "")),
+	}
+
+	for _, line := range strings.Split(report, "\n") {
+		frame, found := parseStackFrame(line)
+		if found {
+			matlabError.Stack = append(matlabError.Stack, frame)
+		}
+	}
+
+	return matlabError
+}
+
+func parseStackFrame(line string) (entities.MATLABStackFrame, bool) {
+	var frame entities.MATLABStackFrame
+
+	if match := openToLinePattern.FindStringSubmatch(line); match != nil {
+		frame.File = strings.ReplaceAll(match[1], "''", "'")
+	}
+
+	text := hyperlinkPattern.ReplaceAllString(line, "")
+
+	if match := syntaxErrorPattern.FindStringSubmatch(text); match != nil {
+		frame.File = match[1]
+		frame.Line, _ = strconv.Atoi(match[2])
+		return frame, true
+	}
+
+	if match := stackFramePattern.FindStringSubmatch(text); match != nil {
+		frame.Name = match[1]
+		frame.Line, _ = strconv.Atoi(match[2])
+		return frame, true
+	}
+
+	return entities.MATLABStackFrame{}, false
 }
@@ -0,0 +1,71 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMATLABErrorFromReport(t *testing.T) {
+	testCases := []struct {
+		name          string
+		report        string
+		expectedError *entities.MATLABError
+	}{
+		{
+			name:   "no stack",
+			report: "Undefined function or variable 'x'.\n",
+			expectedError: &entities.MATLABError{
+				Message: "Undefined function or variable 'x'.",
+			},
+		},
+		{
+			name:   "stack of functions",
+			report: "Error using myFunction (line 7)\nInvalid gain.\n\nError in myScript (line 12)\n    myFunction(-1)\n",
+			expectedError: &entities.MATLABError{
+				Message: "Error using myFunction (line 7)\nInvalid gain.\n\nError in myScript (line 12)\n    myFunction(-1)",
+				Stack: []entities.MATLABStackFrame{
+					{Name: "myFunction", Line: 7},
+					{Name: "myScript", Line: 12},
+				},
+			},
+		},
+		{
+			name: "stack with hyperlinks",
+			report: "Error using <a href=\"matlab:matlab.lang.internal.introspective.errorDocCallback('myFunction', '/work/my''s/myFunction.m', 7)\" style=\"font-weight:bold\">myFunction</a> (<a href=\"matlab: opentoline('/work/my''s/myFunction.m',7,0)\">line 7</a>)\n" +
+				"Invalid gain.\n\n" +
+				"Error in <a href=\"matlab:matlab.lang.internal.introspective.errorDocCallback('myScript>helper', '/work/myScript.m', 20)\" style=\"font-weight:bold\">myScript>helper</a> (<a href=\"matlab: opentoline('/work/myScript.m',20,0)\">line 20</a>)\n",
+			expectedError: &entities.MATLABError{
+				Message: "Error using myFunction (line 7)\nInvalid gain.\n\nError in myScript>helper (line 20)",
+				Stack: []entities.MATLABStackFrame{
+					{File: "/work/my's/myFunction.m", Name: "myFunction", Line: 7},
+					{File: "/work/myScript.m", Name: "myScript>helper", Line: 20},
+				},
+			},
+		},
+		{
+			name:   "syntax error",
+			report: "Error: File: /work/myScript.m Line: 3 Column: 5\nInvalid expression.\n",
+			expectedError: &entities.MATLABError{
+				Message: "Error: File: /work/myScript.m Line: 3 Column: 5\nInvalid expression.",
+				Stack: []entities.MATLABStackFrame{
+					{File: "/work/myScript.m", Line: 3},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			matlabError := embeddedconnector.NewMATLABErrorFromReport(testCase.report)
+
+			// Assert
+			assert.Equal(t, testCase.expectedError, matlabError)
+		})
+	}
+}
@@ -103,6 +103,10 @@ func TestClient_Eval_MATLABError(t *testing.T) {
 	assert.Contains(t, err.Error(), expectedErrorMessage)
 	assert.Empty(t, response.ConsoleOutput)
 	assert.Nil(t, response.Images)
+
+	var matlabError *entities.MATLABError
+	require.ErrorAs(t, err, &matlabError)
+	assert.Equal(t, expectedErrorMessage, matlabError.Message)
 }
 
 func TestClient_Eval_HTTPError(t *testing.T) {
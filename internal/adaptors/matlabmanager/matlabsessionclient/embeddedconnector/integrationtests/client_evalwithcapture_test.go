@@ -25,7 +25,7 @@ func TestClient_EvalWithCapture_HappyPath(t *testing.T) {
 	const expectedOutput = "Hello World"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -42,8 +42,8 @@ func TestClient_EvalWithCapture_HappyPath(t *testing.T) {
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -83,7 +83,7 @@ func TestClient_EvalWithCapture_ReturnImages(t *testing.T) {
 	expectedImageBase64 := base64.StdEncoding.EncodeToString(expectedImageData)
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -100,8 +100,8 @@ func TestClient_EvalWithCapture_ReturnImages(t *testing.T) {
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -140,7 +140,7 @@ func TestClient_EvalWithCapture_ReturnStreams(t *testing.T) {
 	const expectedName = "stderr"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -162,8 +162,8 @@ func TestClient_EvalWithCapture_ReturnStreams(t *testing.T) {
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -200,7 +200,7 @@ func TestClient_EvalWithCapture_MultipleStreams_SameName(t *testing.T) {
 	const expectedCode = "disp('line1'); disp('line2')"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -232,8 +232,8 @@ func TestClient_EvalWithCapture_MultipleStreams_SameName(t *testing.T) {
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -270,7 +270,7 @@ func TestClient_EvalWithCapture_MultipleStreams_DifferentNames(t *testing.T) {
 	const expectedCode = "fprintf('output'); warning('warning message')"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -312,8 +312,8 @@ func TestClient_EvalWithCapture_MultipleStreams_DifferentNames(t *testing.T) {
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -353,7 +353,7 @@ func TestClient_EvalWithCapture_MixedStreamsAndResults(t *testing.T) {
 	expectedImageBase64 := base64.StdEncoding.EncodeToString(expectedImageData)
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -395,8 +395,8 @@ func TestClient_EvalWithCapture_MixedStreamsAndResults(t *testing.T) {
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -432,7 +432,7 @@ func TestClient_EvalWithCapture_StreamsWithInterruptionByExecuteResult(t *testin
 	const expectedCode = "warning('first'); x = 1; warning('second')"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, "matlab_mcp.mcpEval", []string{expectedCode}, 1)
+		assertFevalMessage(t, request, "matlab_mcp.mcpEval", string(marshalJSON(t, []string{expectedCode})), 1)
 
 		liveEditorResponseEntries := []embeddedconnector.LiveEditorResponseEntry{
 			{
@@ -479,8 +479,8 @@ func TestClient_EvalWithCapture_StreamsWithInterruptionByExecuteResult(t *testin
 				FevalResponse: []embeddedconnector.FevalResponseMessage{
 					{
 						IsError: false,
-						Results: []interface{}{
-							string(data),
+						Results: []json.RawMessage{
+							marshalJSON(t, string(data)),
 						},
 					},
 				},
@@ -22,12 +22,14 @@ func TestClient_FEval_HappyPath(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "sum"
-	expectedArguments := []string{"1", "2"}
+	expectedArguments := []entities.MATLABValue{entities.NewMATLABDouble(1), entities.NewMATLABDouble(2)}
+	expectedEncodedArguments := `[1, 2]`
 	expectedNumOutputs := 1
-	expectedResults := []interface{}{"3"}
+	expectedResults := []json.RawMessage{json.RawMessage(`3`)}
+	expectedOutputs := []entities.MATLABValue{entities.NewMATLABDouble(3)}
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, expectedFunction, expectedArguments, expectedNumOutputs)
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
 
 		response := embeddedconnector.ConnectorPayload{
 			Messages: embeddedconnector.ConnectorMessage{
@@ -60,7 +62,7 @@ func TestClient_FEval_HappyPath(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, expectedResults, response.Outputs)
+	assert.Equal(t, expectedOutputs, response.Outputs)
 }
 
 func TestClient_FEval_MultipleOutputs(t *testing.T) {
@@ -69,12 +71,18 @@ func TestClient_FEval_MultipleOutputs(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "size"
-	expectedArguments := []string{"a"}
+	expectedArguments := []entities.MATLABValue{entities.MATLABNumeric{
+		NumericClass: entities.MATLABClassDouble,
+		Dimensions:   []int{2, 3},
+		Values:       []float64{1, 2, 3, 4, 5, 6},
+	}}
+	expectedEncodedArguments := `[{"mwtype": "double", "mwsize": [2, 3], "mwdata": [1, 2, 3, 4, 5, 6]}]`
 	expectedNumOutputs := 2
-	expectedResults := []interface{}{"2", "3"}
+	expectedResults := []json.RawMessage{json.RawMessage(`2`), json.RawMessage(`3`)}
+	expectedOutputs := []entities.MATLABValue{entities.NewMATLABDouble(2), entities.NewMATLABDouble(3)}
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, expectedFunction, expectedArguments, expectedNumOutputs)
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
 
 		response := embeddedconnector.ConnectorPayload{
 			Messages: embeddedconnector.ConnectorMessage{
@@ -107,7 +115,7 @@ func TestClient_FEval_MultipleOutputs(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, expectedResults, response.Outputs)
+	assert.Equal(t, expectedOutputs, response.Outputs)
 }
 
 func TestClient_FEval_NoArguments(t *testing.T) {
@@ -116,12 +124,14 @@ func TestClient_FEval_NoArguments(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "rand"
-	expectedArguments := []string{}
+	expectedArguments := []entities.MATLABValue{}
+	expectedEncodedArguments := `[]`
 	expectedNumOutputs := 1
-	expectedResults := []interface{}{"0.8147"}
+	expectedResults := []json.RawMessage{json.RawMessage(`0.8147`)}
+	expectedOutputs := []entities.MATLABValue{entities.NewMATLABDouble(0.8147)}
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, expectedFunction, expectedArguments, expectedNumOutputs)
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
 
 		response := embeddedconnector.ConnectorPayload{
 			Messages: embeddedconnector.ConnectorMessage{
@@ -154,7 +164,7 @@ func TestClient_FEval_NoArguments(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, expectedResults, response.Outputs)
+	assert.Equal(t, expectedOutputs, response.Outputs)
 }
 
 func TestClient_FEval_MATLABError(t *testing.T) {
@@ -163,12 +173,13 @@ func TestClient_FEval_MATLABError(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "invalid_function"
-	expectedArguments := []string{}
+	expectedArguments := []entities.MATLABValue{}
+	expectedEncodedArguments := `[]`
 	expectedNumOutputs := 1
 	expectedErrorMessage := "Undefined function 'invalid_function'"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, expectedFunction, expectedArguments, expectedNumOutputs)
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
 
 		faultMessage := embeddedconnector.Fault{
 			Message: expectedErrorMessage,
@@ -211,19 +222,100 @@ func TestClient_FEval_MATLABError(t *testing.T) {
 	assert.Nil(t, response.Outputs)
 }
 
+func TestClient_FEval_MATLABErrorWithDetails(t *testing.T) {
+	// Arrange
+	httpClientFactory := httpclientfactory.New()
+	mockLogger := testutils.NewInspectableLogger()
+
+	expectedFunction := "myFunction"
+	expectedEncodedArguments := `[]`
+	expectedNumOutputs := 0
+
+	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
+
+		faultMessage := embeddedconnector.Fault{
+			Message:    "Failed to update the model.",
+			Identifier: "vmc:model:UpdateFailed",
+			Stack: []embeddedconnector.FaultFrame{
+				{File: "/work/myFunction.m", Name: "myFunction", Line: 12},
+			},
+			Cause: []embeddedconnector.Fault{
+				{
+					Message:    "Invalid gain.",
+					Identifier: "vmc:block:InvalidParameter",
+					Stack: []embeddedconnector.FaultFrame{
+						{File: "/work/setGain.m", Name: "setGain", Line: 4},
+					},
+				},
+			},
+		}
+		faultBytes, err := json.Marshal(faultMessage)
+		assert.NoError(t, err)
+
+		response := embeddedconnector.ConnectorPayload{
+			Messages: embeddedconnector.ConnectorMessage{
+				FevalResponse: []embeddedconnector.FevalResponseMessage{
+					{
+						IsError:       true,
+						MessageFaults: []json.RawMessage{faultBytes},
+					},
+				},
+			},
+		}
+
+		responseWriter.Header().Set("Content-Type", "application/json")
+		responseWriter.WriteHeader(http.StatusOK)
+		assert.NoError(t, json.NewEncoder(responseWriter).Encode(response))
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	fevalRequest := entities.FEvalRequest{
+		Function:   expectedFunction,
+		Arguments:  []entities.MATLABValue{},
+		NumOutputs: expectedNumOutputs,
+	}
+
+	// Act
+	_, err = client.FEval(t.Context(), mockLogger, fevalRequest)
+
+	// Assert
+	var matlabError *entities.MATLABError
+	require.ErrorAs(t, err, &matlabError)
+	assert.Equal(t, &entities.MATLABError{
+		Identifier: "vmc:model:UpdateFailed",
+		Message:    "Failed to update the model.",
+		Stack: []entities.MATLABStackFrame{
+			{File: "/work/myFunction.m", Name: "myFunction", Line: 12},
+		},
+		Causes: []entities.MATLABError{
+			{
+				Identifier: "vmc:block:InvalidParameter",
+				Message:    "Invalid gain.",
+				Stack: []entities.MATLABStackFrame{
+					{File: "/work/setGain.m", Name: "setGain", Line: 4},
+				},
+			},
+		},
+	}, matlabError)
+}
+
 func TestClient_FEval_MATLABErrorWithMultipleFaults(t *testing.T) {
 	// Arrange
 	httpClientFactory := httpclientfactory.New()
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "invalid_function"
-	expectedArguments := []string{}
+	expectedArguments := []entities.MATLABValue{}
+	expectedEncodedArguments := `[]`
 	expectedNumOutputs := 1
 	expectedErrorMessage1 := "First error message"
 	expectedErrorMessage2 := "Second error message"
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, expectedFunction, expectedArguments, expectedNumOutputs)
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
 
 		fault1 := embeddedconnector.Fault{
 			Message: expectedErrorMessage1,
@@ -279,11 +371,12 @@ func TestClient_FEval_MATLABErrorWithNoFaults(t *testing.T) {
 	mockLogger := testutils.NewInspectableLogger()
 
 	expectedFunction := "invalid_function"
-	expectedArguments := []string{}
+	expectedArguments := []entities.MATLABValue{}
+	expectedEncodedArguments := `[]`
 	expectedNumOutputs := 1
 
 	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
-		assertFevalMessage(t, request, expectedFunction, expectedArguments, expectedNumOutputs)
+		assertFevalMessage(t, request, expectedFunction, expectedEncodedArguments, expectedNumOutputs)
 
 		response := embeddedconnector.ConnectorPayload{
 			Messages: embeddedconnector.ConnectorMessage{
@@ -335,7 +428,7 @@ func TestClient_FEval_HTTPError(t *testing.T) {
 	ctx := t.Context()
 	fevalRequest := entities.FEvalRequest{
 		Function:   "sum",
-		Arguments:  []string{"1", "2"},
+		Arguments:  []entities.MATLABValue{entities.NewMATLABDouble(1), entities.NewMATLABDouble(2)},
 		NumOutputs: 1,
 	}
 
@@ -372,7 +465,7 @@ func TestClient_FEval_NoResponseMessages(t *testing.T) {
 	ctx := t.Context()
 	fevalRequest := entities.FEvalRequest{
 		Function:   "sum",
-		Arguments:  []string{"1", "2"},
+		Arguments:  []entities.MATLABValue{entities.NewMATLABDouble(1), entities.NewMATLABDouble(2)},
 		NumOutputs: 1,
 	}
 
@@ -403,7 +496,7 @@ func TestClient_FEval_InvalidJSONResponse(t *testing.T) {
 	ctx := t.Context()
 	fevalRequest := entities.FEvalRequest{
 		Function:   "sum",
-		Arguments:  []string{"1", "2"},
+		Arguments:  []entities.MATLABValue{entities.NewMATLABDouble(1), entities.NewMATLABDouble(2)},
 		NumOutputs: 1,
 	}
 
@@ -433,7 +526,7 @@ func TestClient_FEval_ContextCancellation(t *testing.T) {
 
 	fevalRequest := entities.FEvalRequest{
 		Function:   "sum",
-		Arguments:  []string{"1", "2"},
+		Arguments:  []entities.MATLABValue{entities.NewMATLABDouble(1), entities.NewMATLABDouble(2)},
 		NumOutputs: 1,
 	}
 
@@ -446,7 +539,7 @@ func TestClient_FEval_ContextCancellation(t *testing.T) {
 	assert.Nil(t, response.Outputs)
 }
 
-func assertFevalMessage(t *testing.T, request *http.Request, expectedFunction string, expectedArgs []string, expectedNumOutputs int) {
+func assertFevalMessage(t *testing.T, request *http.Request, expectedFunction string, expectedArgs string, expectedNumOutputs int) {
 	var requestPayload embeddedconnector.ConnectorPayload
 	err := json.NewDecoder(request.Body).Decode(&requestPayload)
 	require.NoError(t, err)
@@ -454,6 +547,8 @@ func assertFevalMessage(t *testing.T, request *http.Request, expectedFunction st
 	require.NotNil(t, requestPayload.Messages)
 	require.Len(t, requestPayload.Messages.FEval, 1, "expected exactly one FEval message")
 	assert.Equal(t, expectedFunction, requestPayload.Messages.FEval[0].Function, "feval function does not match expected function")
-	assert.Equal(t, expectedArgs, requestPayload.Messages.FEval[0].Arguments, "feval args does not match expected args")
+	args, err := json.Marshal(requestPayload.Messages.FEval[0].Arguments)
+	require.NoError(t, err)
+	assert.JSONEq(t, expectedArgs, string(args), "feval args does not match expected args")
 	assert.Equal(t, expectedNumOutputs, requestPayload.Messages.FEval[0].Nargout, "feval nargout does not match expected nargout")
 }
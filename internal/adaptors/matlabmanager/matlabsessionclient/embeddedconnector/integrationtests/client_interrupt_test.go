@@ -0,0 +1,279 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/utils/httpclientfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const blockingCode = "pause(inf)"
+
+// standInMATLAB mimics the embedded connector of a MATLAB session that blocks on blockingCode until it is interrupted.
+type standInMATLAB struct {
+	t *testing.T
+
+	evalStarted chan struct{}
+	interrupted chan struct{}
+
+	interruptOnce sync.Once
+}
+
+func newStandInMATLAB(t *testing.T) *standInMATLAB {
+	return &standInMATLAB{
+		t:           t,
+		evalStarted: make(chan struct{}, 1),
+		interrupted: make(chan struct{}),
+	}
+}
+
+func (m *standInMATLAB) handle(responseWriter http.ResponseWriter, request *http.Request) {
+	var requestPayload embeddedconnector.ConnectorPayload
+	assert.NoError(m.t, json.NewDecoder(request.Body).Decode(&requestPayload))
+
+	var response embeddedconnector.ConnectorPayload
+
+	switch {
+	case len(requestPayload.Messages.Interrupt) > 0:
+		m.interruptOnce.Do(func() { close(m.interrupted) })
+		response.Messages.InterruptResponse = []embeddedconnector.InterruptResponseMessage{{}}
+
+	case len(requestPayload.Messages.Eval) > 0 && requestPayload.Messages.Eval[0].Code == blockingCode:
+		m.evalStarted <- struct{}{}
+		select {
+		case <-m.interrupted:
+		case <-time.After(10 * time.Second):
+			m.t.Error("stand-in MATLAB was never interrupted")
+		}
+		response.Messages.EvalResponse = []embeddedconnector.EvalResponseMessage{
+			{
+				IsError:     true,
+				ResponseStr: "Operation terminated by user during pause",
+			},
+		}
+
+	case len(requestPayload.Messages.Eval) > 0:
+		response.Messages.EvalResponse = []embeddedconnector.EvalResponseMessage{
+			{
+				IsError:     false,
+				ResponseStr: "ok\n",
+			},
+		}
+	}
+
+	responseWriter.Header().Set("Content-Type", "application/json")
+	responseWriter.WriteHeader(http.StatusOK)
+	assert.NoError(m.t, json.NewEncoder(responseWriter).Encode(response))
+}
+
+func (m *standInMATLAB) wasInterrupted() bool {
+	select {
+	case <-m.interrupted:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestClient_Eval_CancelledRequestInterruptsMATLAB(t *testing.T) {
+	// Arrange
+	httpClientFactory := httpclientfactory.New()
+	mockLogger := testutils.NewInspectableLogger()
+
+	standIn := newStandInMATLAB(t)
+	connectionDetails := startTestServerForEvaluation(t, standIn.handle)
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go func() {
+		<-standIn.evalStarted
+		cancel()
+	}()
+
+	// Act
+	_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: blockingCode})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABRequestCancelled)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "may still be busy")
+	assert.True(t, standIn.wasInterrupted())
+
+	response, err := client.Eval(t.Context(), mockLogger, entities.EvalRequest{Code: "disp('ok')"})
+	require.NoError(t, err, "session should be usable after the interrupt")
+	assert.Equal(t, "ok\n", response.ConsoleOutput)
+}
+
+func TestClient_Eval_DeadlineInterruptsMATLAB(t *testing.T) {
+	// Arrange
+	httpClientFactory := httpclientfactory.New()
+	mockLogger := testutils.NewInspectableLogger()
+
+	standIn := newStandInMATLAB(t)
+	connectionDetails := startTestServerForEvaluation(t, standIn.handle)
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	// Act
+	_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: blockingCode})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABRequestCancelled)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, standIn.wasInterrupted())
+}
+
+func TestClient_Eval_InterruptFails(t *testing.T) {
+	// Arrange
+	httpClientFactory := httpclientfactory.New()
+	mockLogger := testutils.NewInspectableLogger()
+
+	evalStarted := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+
+	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		var requestPayload embeddedconnector.ConnectorPayload
+		assert.NoError(t, json.NewDecoder(request.Body).Decode(&requestPayload))
+
+		if len(requestPayload.Messages.Interrupt) > 0 {
+			responseWriter.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		evalStarted <- struct{}{}
+		<-release
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go func() {
+		<-evalStarted
+		cancel()
+	}()
+
+	// Act
+	_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: blockingCode})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABRequestCancelled)
+	assert.Contains(t, err.Error(), "could not be interrupted")
+	assert.Contains(t, err.Error(), "may still be busy")
+}
+
+func TestClient_Eval_InterruptResponseWithFault(t *testing.T) {
+	// Arrange
+	httpClientFactory := httpclientfactory.New()
+	mockLogger := testutils.NewInspectableLogger()
+
+	evalStarted := make(chan struct{}, 1)
+	release := make(chan struct{})
+	defer close(release)
+
+	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		var requestPayload embeddedconnector.ConnectorPayload
+		assert.NoError(t, json.NewDecoder(request.Body).Decode(&requestPayload))
+
+		if len(requestPayload.Messages.Interrupt) > 0 {
+			responseWriter.Header().Set("Content-Type", "application/json")
+			assert.NoError(t, json.NewEncoder(responseWriter).Encode(embeddedconnector.ConnectorPayload{
+				Messages: embeddedconnector.ConnectorMessage{
+					InterruptResponse: []embeddedconnector.InterruptResponseMessage{
+						{MessageFaults: []json.RawMessage{json.RawMessage(`{"message": "Unknown message type"}`)}},
+					},
+				},
+			}))
+			return
+		}
+
+		evalStarted <- struct{}{}
+		<-release
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go func() {
+		<-evalStarted
+		cancel()
+	}()
+
+	// Act
+	_, err = client.Eval(ctx, mockLogger, entities.EvalRequest{Code: blockingCode})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABRequestCancelled)
+	assert.Contains(t, err.Error(), "could not be interrupted")
+	assert.Contains(t, err.Error(), "Unknown message type")
+}
+
+func TestClient_FEval_CancelledRequestInterruptsMATLAB(t *testing.T) {
+	// Arrange
+	httpClientFactory := httpclientfactory.New()
+	mockLogger := testutils.NewInspectableLogger()
+
+	evalStarted := make(chan struct{}, 1)
+	interrupted := make(chan struct{})
+
+	connectionDetails := startTestServerForEvaluation(t, func(responseWriter http.ResponseWriter, request *http.Request) {
+		var requestPayload embeddedconnector.ConnectorPayload
+		assert.NoError(t, json.NewDecoder(request.Body).Decode(&requestPayload))
+
+		if len(requestPayload.Messages.Interrupt) > 0 {
+			close(interrupted)
+			responseWriter.Header().Set("Content-Type", "application/json")
+			assert.NoError(t, json.NewEncoder(responseWriter).Encode(embeddedconnector.ConnectorPayload{
+				Messages: embeddedconnector.ConnectorMessage{
+					InterruptResponse: []embeddedconnector.InterruptResponseMessage{{}},
+				},
+			}))
+			return
+		}
+
+		evalStarted <- struct{}{}
+		<-interrupted
+	})
+
+	client, err := embeddedconnector.NewClient(connectionDetails, httpClientFactory)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go func() {
+		<-evalStarted
+		cancel()
+	}()
+
+	// Act
+	_, err = client.FEval(ctx, mockLogger, entities.FEvalRequest{Function: "sim", Arguments: []entities.MATLABValue{entities.MATLABChar{Value: "model"}}})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABRequestCancelled)
+}
@@ -4,6 +4,7 @@ package embeddedconnector_integration_test
 
 import (
 	_ "embed"
+	"encoding/json"
 	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func startTestServerForEvaluation(t *testing.T, handler func(responseWriter http.ResponseWriter, request *http.Request)) embeddedconnector.ConnectionDetails {
@@ -54,3 +56,13 @@ func startTestServerWithPath(t *testing.T, expectedPath string, handler func(res
 	}
 	return connectionDetails
 }
+
+// encodeResult encodes a value as the embedded connector would, in JSON.
+func marshalJSON(t *testing.T, value any) json.RawMessage {
+	t.Helper()
+
+	encoded, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	return encoded
+}
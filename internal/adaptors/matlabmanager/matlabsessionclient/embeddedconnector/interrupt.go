@@ -0,0 +1,80 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptrace"
+	"sync/atomic"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// Interrupt asks MATLAB to stop the evaluation it is currently running.
+// The Interrupt message is not a documented message of the embedded connector, and it is only tested against the
+// stand-in connector server in the tests. A connector may queue it behind the busy evaluation, so even an accepted
+// interrupt does not show that MATLAB stopped.
+func (c *Client) Interrupt(ctx context.Context, logger entities.Logger) error {
+	payload := ConnectorPayload{
+		Messages: ConnectorMessage{
+			Interrupt: []InterruptMessage{{}},
+		},
+	}
+
+	response, err := c.sendRequestToEvaluationEndpoint(ctx, logger, payload)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Messages.InterruptResponse) == 0 {
+		return fmt.Errorf("no interrupt response received")
+	}
+
+	messageFaults := response.Messages.InterruptResponse[0].MessageFaults
+	if len(messageFaults) > 0 {
+		return newMATLABErrorFromFaults(logger, messageFaults)
+	}
+
+	return nil
+}
+
+// traceRequestSent returns a copy of ctx that records whether the HTTP request made with it was fully written to MATLAB.
+func traceRequestSent(ctx context.Context) (context.Context, *atomic.Bool) {
+	requestSent := &atomic.Bool{}
+	trace := &httptrace.ClientTrace{
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err == nil {
+				requestSent.Store(true)
+			}
+		},
+	}
+	return httptrace.WithClientTrace(ctx, trace), requestSent
+}
+
+// interruptIfCancelled asks MATLAB to interrupt the request when err was caused by ctx being cancelled or reaching its
+// deadline after the request reached MATLAB. Requests that never reached MATLAB are not interrupted, as MATLAB may be
+// busy with another request. Whether or not MATLAB accepts the interrupt, the session may still be busy with the request.
+func (c *Client) interruptIfCancelled(ctx context.Context, logger entities.Logger, requestSent bool, err error) error {
+	if ctx.Err() == nil || !requestSent {
+		return err
+	}
+
+	cause := context.Cause(ctx)
+	logger.WithError(cause).Info("Request ended before MATLAB responded, interrupting MATLAB")
+
+	timeout := c.interruptTimeout
+	if timeout <= 0 {
+		timeout = defaultInterruptTimeout
+	}
+
+	interruptCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	if interruptErr := c.Interrupt(interruptCtx, logger); interruptErr != nil {
+		logger.WithError(interruptErr).Warn("Failed to interrupt MATLAB")
+		return fmt.Errorf("%w before MATLAB responded (%w), and MATLAB could not be interrupted, so the session may still be busy: %w", entities.ErrMATLABRequestCancelled, cause, interruptErr)
+	}
+
+	return fmt.Errorf("%w before MATLAB responded (%w); MATLAB was asked to interrupt it, but the session may still be busy", entities.ErrMATLABRequestCancelled, cause)
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// reportElapsedProgress periodically reports how long the given activity has been running to the
+// progress reporter attached to ctx, if any. The returned function stops reporting and must be called
+// once the activity has completed.
+func (c *Client) reportElapsedProgress(ctx context.Context, activity string) func() {
+	reporter, ok := entities.ProgressReporterFromContext(ctx)
+	if !ok {
+		return func() {}
+	}
+
+	interval := c.progressInterval
+	if interval <= 0 {
+		interval = defaultProgressInterval
+	}
+
+	start := time.Now()
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				elapsed := time.Since(start).Round(time.Second)
+				reporter.Report(fmt.Sprintf("%s: %s elapsed", activity, elapsed))
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
@@ -0,0 +1,524 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// The encoding of MATLAB values below is an assumption: it has not been checked against a MATLAB session.
+// It follows the JSON representation of MATLAB data documented for MATLAB Production Server,
+// on the assumption that the embedded connector uses the same two notations for FEval arguments and results.
+// The small notation uses plain JSON for scalars: a number is a double, true and false are logicals, a string is a char row vector,
+// null is missing, an object is a scalar struct, and an array is a row vector, or a cell row vector when its elements differ in class.
+// The large notation describes any array as {"mwtype": class, "mwsize": dimensions, "mwdata": elements in column-major order}.
+// Non-finite numbers are the strings "NaN", "Inf" and "-Inf", missing string elements are null,
+// and the elements of a struct array are objects that hold its fields in order.
+// The payloads in testdata are written by hand in this format; they were not captured from a MATLAB session,
+// so they test this code against the assumption, not against the connector.
+
+// notationType is the key that tells a value in the large notation from a scalar struct.
+const notationType = "mwtype"
+
+type largeNotation struct {
+	Type    string          `json:"mwtype"`
+	Size    []int           `json:"mwsize"`
+	Data    json.RawMessage `json:"mwdata"`
+	Complex bool            `json:"mwcomplex,omitempty"`
+}
+
+func encodeValues(values []entities.MATLABValue) ([]json.RawMessage, error) {
+	encoded := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		var err error
+		encoded[i], err = encodeValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode argument %d: %w", i+1, err)
+		}
+	}
+	return encoded, nil
+}
+
+func decodeValues(encoded []json.RawMessage) ([]entities.MATLABValue, error) {
+	values := make([]entities.MATLABValue, len(encoded))
+	for i, rawValue := range encoded {
+		var err error
+		values[i], err = decodeValue(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode output %d: %w", i+1, err)
+		}
+	}
+	return values, nil
+}
+
+func encodeValue(value entities.MATLABValue) (json.RawMessage, error) {
+	switch v := value.(type) {
+	case entities.MATLABNumeric:
+		return encodeNumeric(v)
+	case entities.MATLABLogical:
+		if err := checkSize(v.Class(), v.Dimensions, len(v.Values)); err != nil {
+			return nil, err
+		}
+		if isScalar(v.Dimensions) {
+			return json.Marshal(v.Values[0])
+		}
+		return encodeLarge(v.Class(), v.Dimensions, v.Values)
+	case entities.MATLABChar:
+		return json.Marshal(v.Value)
+	case entities.MATLABString:
+		if err := checkSize(v.Class(), v.Dimensions, len(v.Values)); err != nil {
+			return nil, err
+		}
+		return encodeLarge(v.Class(), v.Dimensions, v.Values)
+	case entities.MATLABCell:
+		if err := checkSize(v.Class(), v.Dimensions, len(v.Values)); err != nil {
+			return nil, err
+		}
+		elements, err := encodeElements(v.Values)
+		if err != nil {
+			return nil, err
+		}
+		return encodeLarge(v.Class(), v.Dimensions, elements)
+	case entities.MATLABStruct:
+		return encodeStruct(v)
+	case entities.MATLABMissing:
+		return encodeLarge(v.Class(), []int{1, 1}, []any{nil})
+	default:
+		return nil, fmt.Errorf("unsupported MATLAB value %T", value)
+	}
+}
+
+func encodeNumeric(value entities.MATLABNumeric) (json.RawMessage, error) {
+	if !value.NumericClass.IsNumeric() {
+		return nil, fmt.Errorf("unsupported numeric class %q", value.NumericClass)
+	}
+	if err := checkSize(value.NumericClass, value.Dimensions, len(value.Values)); err != nil {
+		return nil, err
+	}
+
+	elements := make([]any, len(value.Values))
+	for i, element := range value.Values {
+		switch {
+		case math.IsNaN(element):
+			elements[i] = "NaN"
+		case math.IsInf(element, 1):
+			elements[i] = "Inf"
+		case math.IsInf(element, -1):
+			elements[i] = "-Inf"
+		default:
+			elements[i] = element
+		}
+	}
+
+	if value.NumericClass == entities.MATLABClassDouble && isScalar(value.Dimensions) {
+		if _, finite := elements[0].(float64); finite {
+			return json.Marshal(elements[0])
+		}
+	}
+
+	return encodeLarge(value.NumericClass, value.Dimensions, elements)
+}
+
+func encodeStruct(value entities.MATLABStruct) (json.RawMessage, error) {
+	if err := checkSize(value.Class(), value.Dimensions, len(value.Values)); err != nil {
+		return nil, err
+	}
+
+	elements := make([]json.RawMessage, len(value.Values))
+	for i, element := range value.Values {
+		// The fields are written one by one, as a map would lose their order.
+		var object bytes.Buffer
+		object.WriteByte('{')
+		for j, field := range value.Fields {
+			fieldValue, found := element[field]
+			if !found {
+				return nil, fmt.Errorf("struct element %d has no field %q", i+1, field)
+			}
+
+			encodedField, err := encodeValue(fieldValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode field %q: %w", field, err)
+			}
+
+			name, err := json.Marshal(field)
+			if err != nil {
+				return nil, err
+			}
+
+			if j > 0 {
+				object.WriteByte(',')
+			}
+			object.Write(name)
+			object.WriteByte(':')
+			object.Write(encodedField)
+		}
+		object.WriteByte('}')
+		elements[i] = object.Bytes()
+	}
+
+	return encodeLarge(value.Class(), value.Dimensions, elements)
+}
+
+func encodeElements(values []entities.MATLABValue) ([]json.RawMessage, error) {
+	elements := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		var err error
+		elements[i], err = encodeValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode element %d: %w", i+1, err)
+		}
+	}
+	return elements, nil
+}
+
+func encodeLarge[T any](class entities.MATLABClass, dimensions []int, elements []T) (json.RawMessage, error) {
+	data, err := json.Marshal(elements)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(largeNotation{
+		Type: string(class),
+		Size: dimensions,
+		Data: data,
+	})
+}
+
+func decodeValue(rawValue json.RawMessage) (entities.MATLABValue, error) {
+	rawValue = bytes.TrimSpace(rawValue)
+	if len(rawValue) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+
+	switch rawValue[0] {
+	case 'n':
+		return entities.MATLABMissing{}, nil
+	case 't', 'f':
+		var value bool
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			return nil, err
+		}
+		return entities.NewMATLABLogical(value), nil
+	case '"':
+		var value string
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			return nil, err
+		}
+		return entities.MATLABChar{Value: value}, nil
+	case '[':
+		return decodeSmallArray(rawValue)
+	case '{':
+		_, object, err := decodeObject(rawValue)
+		if err != nil {
+			return nil, err
+		}
+		if _, isLarge := object[notationType]; isLarge {
+			return decodeLarge(rawValue)
+		}
+		return decodeStructElements([]int{1, 1}, []json.RawMessage{rawValue})
+	default:
+		var value float64
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			return nil, err
+		}
+		return entities.NewMATLABDouble(value), nil
+	}
+}
+
+// decodeSmallArray decodes a JSON array into a row vector of doubles or logicals, or into a cell row vector.
+func decodeSmallArray(rawValue json.RawMessage) (entities.MATLABValue, error) {
+	var rawElements []json.RawMessage
+	if err := json.Unmarshal(rawValue, &rawElements); err != nil {
+		return nil, err
+	}
+
+	if len(rawElements) == 0 {
+		return entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{0, 0}, Values: []float64{}}, nil
+	}
+
+	elements := make([]entities.MATLABValue, len(rawElements))
+	for i, rawElement := range rawElements {
+		var err error
+		elements[i], err = decodeValue(rawElement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode element %d: %w", i+1, err)
+		}
+	}
+
+	dimensions := []int{1, len(elements)}
+
+	if doubles, ok := scalarsOf(elements, func(element entities.MATLABValue) (float64, bool) {
+		numeric, ok := element.(entities.MATLABNumeric)
+		return firstIfScalar(numeric.Values), ok && numeric.NumericClass == entities.MATLABClassDouble && isScalar(numeric.Dimensions)
+	}); ok {
+		return entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: dimensions, Values: doubles}, nil
+	}
+
+	if logicals, ok := scalarsOf(elements, func(element entities.MATLABValue) (bool, bool) {
+		logical, ok := element.(entities.MATLABLogical)
+		return firstIfScalar(logical.Values), ok && isScalar(logical.Dimensions)
+	}); ok {
+		return entities.MATLABLogical{Dimensions: dimensions, Values: logicals}, nil
+	}
+
+	return entities.MATLABCell{Dimensions: dimensions, Values: elements}, nil
+}
+
+func decodeLarge(rawValue json.RawMessage) (entities.MATLABValue, error) {
+	var value largeNotation
+	if err := json.Unmarshal(rawValue, &value); err != nil {
+		return nil, err
+	}
+
+	if value.Complex {
+		return nil, fmt.Errorf("complex values are not supported")
+	}
+
+	class := entities.MATLABClass(value.Type)
+	dimensions := value.Size
+	if dimensions == nil {
+		dimensions = []int{1, 1}
+	}
+
+	if class == entities.MATLABClassMissing {
+		return entities.MATLABMissing{}, nil
+	}
+
+	if class == entities.MATLABClassChar {
+		return decodeChar(dimensions, value.Data)
+	}
+
+	var rawElements []json.RawMessage
+	if err := json.Unmarshal(value.Data, &rawElements); err != nil {
+		return nil, fmt.Errorf("failed to decode %s elements: %w", class, err)
+	}
+
+	if err := checkSize(class, dimensions, len(rawElements)); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case class.IsNumeric():
+		elements := make([]float64, len(rawElements))
+		for i, rawElement := range rawElements {
+			var err error
+			elements[i], err = decodeNumber(rawElement)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode %s element %d: %w", class, i+1, err)
+			}
+		}
+		return entities.MATLABNumeric{NumericClass: class, Dimensions: dimensions, Values: elements}, nil
+	case class == entities.MATLABClassLogical:
+		elements := make([]bool, len(rawElements))
+		for i, rawElement := range rawElements {
+			if err := json.Unmarshal(rawElement, &elements[i]); err != nil {
+				return nil, fmt.Errorf("failed to decode logical element %d: %w", i+1, err)
+			}
+		}
+		return entities.MATLABLogical{Dimensions: dimensions, Values: elements}, nil
+	case class == entities.MATLABClassString:
+		elements := make([]*string, len(rawElements))
+		for i, rawElement := range rawElements {
+			if err := json.Unmarshal(rawElement, &elements[i]); err != nil {
+				return nil, fmt.Errorf("failed to decode string element %d: %w", i+1, err)
+			}
+		}
+		return entities.MATLABString{Dimensions: dimensions, Values: elements}, nil
+	case class == entities.MATLABClassCell:
+		elements := make([]entities.MATLABValue, len(rawElements))
+		for i, rawElement := range rawElements {
+			var err error
+			elements[i], err = decodeValue(rawElement)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode cell element %d: %w", i+1, err)
+			}
+		}
+		return entities.MATLABCell{Dimensions: dimensions, Values: elements}, nil
+	case class == entities.MATLABClassStruct:
+		return decodeStructElements(dimensions, rawElements)
+	default:
+		return nil, fmt.Errorf("unsupported MATLAB class %q", class)
+	}
+}
+
+// decodeChar decodes a char row vector, given as a string or as an array holding one string.
+func decodeChar(dimensions []int, data json.RawMessage) (entities.MATLABValue, error) {
+	if len(dimensions) != 2 || dimensions[0] > 1 {
+		return nil, fmt.Errorf("char arrays with more than one row are not supported")
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		return entities.MATLABChar{Value: value}, nil
+	}
+
+	var rows []string
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode char elements: %w", err)
+	}
+	if len(rows) > 1 {
+		return nil, fmt.Errorf("char arrays with more than one row are not supported")
+	}
+	if len(rows) == 0 {
+		return entities.MATLABChar{}, nil
+	}
+
+	return entities.MATLABChar{Value: rows[0]}, nil
+}
+
+// decodeStructElements decodes the elements of a struct array. The first element gives the order of the fields.
+func decodeStructElements(dimensions []int, rawElements []json.RawMessage) (entities.MATLABValue, error) {
+	value := entities.MATLABStruct{
+		Dimensions: dimensions,
+		Values:     make([]map[string]entities.MATLABValue, len(rawElements)),
+	}
+
+	for i, rawElement := range rawElements {
+		fields, object, err := decodeObject(rawElement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode struct element %d: %w", i+1, err)
+		}
+
+		if value.Fields == nil {
+			value.Fields = fields
+		}
+		if len(fields) != len(value.Fields) {
+			return nil, fmt.Errorf("struct element %d has different fields from the first element", i+1)
+		}
+
+		value.Values[i] = make(map[string]entities.MATLABValue, len(fields))
+		for _, field := range value.Fields {
+			rawField, found := object[field]
+			if !found {
+				return nil, fmt.Errorf("struct element %d has no field %q", i+1, field)
+			}
+
+			value.Values[i][field], err = decodeValue(rawField)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode field %q: %w", field, err)
+			}
+		}
+	}
+
+	if value.Fields == nil {
+		value.Fields = []string{}
+	}
+
+	return value, nil
+}
+
+// decodeObject returns the keys of a JSON object in order, with their values.
+func decodeObject(rawValue json.RawMessage) ([]string, map[string]json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(rawValue))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("expected a JSON object")
+	}
+
+	keys := []string{}
+	object := map[string]json.RawMessage{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("expected a JSON object key")
+		}
+
+		var element json.RawMessage
+		if err := decoder.Decode(&element); err != nil {
+			return nil, nil, err
+		}
+
+		if _, duplicate := object[key]; !duplicate {
+			keys = append(keys, key)
+		}
+		object[key] = element
+	}
+
+	return keys, object, nil
+}
+
+func decodeNumber(rawValue json.RawMessage) (float64, error) {
+	var special string
+	if err := json.Unmarshal(rawValue, &special); err == nil {
+		switch special {
+		case "NaN":
+			return math.NaN(), nil
+		case "Inf":
+			return math.Inf(1), nil
+		case "-Inf":
+			return math.Inf(-1), nil
+		default:
+			return 0, fmt.Errorf("unexpected number %q", special)
+		}
+	}
+
+	var value float64
+	if err := json.Unmarshal(rawValue, &value); err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// checkSize checks that the dimensions of an array match its number of elements.
+func checkSize(class entities.MATLABClass, dimensions []int, elementCount int) error {
+	if len(dimensions) < 2 {
+		return fmt.Errorf("%s array must have at least 2 dimensions, got %v", class, dimensions)
+	}
+
+	expectedCount := 1
+	for _, dimension := range dimensions {
+		if dimension < 0 {
+			return fmt.Errorf("%s array has a negative dimension in %v", class, dimensions)
+		}
+		expectedCount *= dimension
+	}
+
+	if expectedCount != elementCount {
+		return fmt.Errorf("%s array of size %v must have %d elements, got %d", class, dimensions, expectedCount, elementCount)
+	}
+
+	return nil
+}
+
+func isScalar(dimensions []int) bool {
+	for _, dimension := range dimensions {
+		if dimension != 1 {
+			return false
+		}
+	}
+	return len(dimensions) >= 2
+}
+
+func firstIfScalar[T any](values []T) T {
+	var zeroValue T
+	if len(values) != 1 {
+		return zeroValue
+	}
+	return values[0]
+}
+
+// scalarsOf returns the scalars held by elements, if every element is a scalar of the kind that scalar recognises.
+func scalarsOf[T any](elements []entities.MATLABValue, scalar func(entities.MATLABValue) (T, bool)) ([]T, bool) {
+	scalars := make([]T, len(elements))
+	for i, element := range elements {
+		value, ok := scalar(element)
+		if !ok {
+			return nil, false
+		}
+		scalars[i] = value
+	}
+	return scalars, true
+}
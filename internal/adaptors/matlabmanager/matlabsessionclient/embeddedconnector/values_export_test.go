@@ -0,0 +1,7 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector
+
+var EncodeValue = encodeValue
+
+var DecodeValue = decodeValue
@@ -0,0 +1,292 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package embeddedconnector_test
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValues_FEvalResponses_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		name            string
+		payload         string
+		expectedOutputs []entities.MATLABValue
+	}{
+		{
+			name:    "numeric",
+			payload: "feval_numeric_response.json",
+			expectedOutputs: []entities.MATLABValue{
+				entities.NewMATLABDouble(3),
+				entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{2, 2}, Values: []float64{1, 3, 2, 4}},
+				entities.MATLABNumeric{NumericClass: entities.MATLABClassInt32, Dimensions: []int{1, 3}, Values: []float64{1, -2, 3}},
+				entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{1, 3}, Values: []float64{math.NaN(), math.Inf(1), math.Inf(-1)}},
+				entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{0, 0}, Values: []float64{}},
+			},
+		},
+		{
+			name:    "logical",
+			payload: "feval_logical_response.json",
+			expectedOutputs: []entities.MATLABValue{
+				entities.MATLABLogical{Dimensions: []int{1, 2}, Values: []bool{true, false}},
+			},
+		},
+		{
+			name:    "text",
+			payload: "feval_text_response.json",
+			expectedOutputs: []entities.MATLABValue{
+				entities.MATLABChar{Value: "hello"},
+				entities.NewMATLABString("world"),
+				entities.MATLABString{Dimensions: []int{2, 1}, Values: []*string{stringPointer("a"), nil}},
+			},
+		},
+		{
+			name:    "containers",
+			payload: "feval_container_response.json",
+			expectedOutputs: []entities.MATLABValue{
+				entities.MATLABCell{Dimensions: []int{1, 3}, Values: []entities.MATLABValue{
+					entities.NewMATLABDouble(1),
+					entities.MATLABChar{Value: "two"},
+					entities.MATLABCell{Dimensions: []int{1, 1}, Values: []entities.MATLABValue{entities.NewMATLABLogical(true)}},
+				}},
+				entities.MATLABStruct{
+					Dimensions: []int{1, 1},
+					Fields:     []string{"name", "params"},
+					Values: []map[string]entities.MATLABValue{{
+						"name": entities.MATLABChar{Value: "Gain"},
+						"params": entities.MATLABStruct{
+							Dimensions: []int{1, 2},
+							Fields:     []string{"k"},
+							Values: []map[string]entities.MATLABValue{
+								{"k": entities.NewMATLABDouble(1)},
+								{"k": entities.NewMATLABDouble(2)},
+							},
+						},
+					}},
+				},
+				entities.MATLABMissing{},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			results := readFEvalResults(t, testCase.payload)
+			require.Len(t, results, len(testCase.expectedOutputs))
+
+			for i, result := range results {
+				// Act
+				decoded, decodeErr := embeddedconnector.DecodeValue(result)
+				require.NoError(t, decodeErr)
+				encoded, encodeErr := embeddedconnector.EncodeValue(decoded)
+				require.NoError(t, encodeErr)
+
+				// Assert
+				assertMATLABValue(t, testCase.expectedOutputs[i], decoded)
+				assert.JSONEq(t, string(result), string(encoded), "Output %d should encode back to its payload", i+1)
+			}
+		})
+	}
+}
+
+func TestValues_DecodeValue_SmallNotation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		encoded       string
+		expectedValue entities.MATLABValue
+	}{
+		{
+			name:          "null is missing",
+			encoded:       `null`,
+			expectedValue: entities.MATLABMissing{},
+		},
+		{
+			name:          "array of numbers is a double row vector",
+			encoded:       `[1, 2.5, 3]`,
+			expectedValue: entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{1, 3}, Values: []float64{1, 2.5, 3}},
+		},
+		{
+			name:          "array of booleans is a logical row vector",
+			encoded:       `[false, true]`,
+			expectedValue: entities.MATLABLogical{Dimensions: []int{1, 2}, Values: []bool{false, true}},
+		},
+		{
+			name:    "mixed array is a cell row vector",
+			encoded: `[1, "a"]`,
+			expectedValue: entities.MATLABCell{Dimensions: []int{1, 2}, Values: []entities.MATLABValue{
+				entities.NewMATLABDouble(1),
+				entities.MATLABChar{Value: "a"},
+			}},
+		},
+		{
+			name:          "empty array is an empty double",
+			encoded:       `[]`,
+			expectedValue: entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{0, 0}, Values: []float64{}},
+		},
+		{
+			name:    "object is a scalar struct with ordered fields",
+			encoded: `{"z": 1, "a": "x"}`,
+			expectedValue: entities.MATLABStruct{
+				Dimensions: []int{1, 1},
+				Fields:     []string{"z", "a"},
+				Values: []map[string]entities.MATLABValue{{
+					"z": entities.NewMATLABDouble(1),
+					"a": entities.MATLABChar{Value: "x"},
+				}},
+			},
+		},
+		{
+			name:          "char in the large notation",
+			encoded:       `{"mwtype": "char", "mwsize": [1, 3], "mwdata": ["abc"]}`,
+			expectedValue: entities.MATLABChar{Value: "abc"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			value, err := embeddedconnector.DecodeValue(json.RawMessage(testCase.encoded))
+
+			// Assert
+			require.NoError(t, err)
+			assertMATLABValue(t, testCase.expectedValue, value)
+		})
+	}
+}
+
+func TestValues_DecodeValue_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		encoded string
+	}{
+		{
+			name:    "size does not match elements",
+			encoded: `{"mwtype": "double", "mwsize": [2, 2], "mwdata": [1, 2, 3]}`,
+		},
+		{
+			name:    "complex values",
+			encoded: `{"mwtype": "double", "mwsize": [1, 1], "mwdata": [1], "mwcomplex": true}`,
+		},
+		{
+			name:    "unsupported class",
+			encoded: `{"mwtype": "containers.Map", "mwsize": [1, 1], "mwdata": [{}]}`,
+		},
+		{
+			name:    "unexpected non-finite number",
+			encoded: `{"mwtype": "double", "mwsize": [1, 1], "mwdata": ["Infinity"]}`,
+		},
+		{
+			name:    "struct elements with different fields",
+			encoded: `{"mwtype": "struct", "mwsize": [1, 2], "mwdata": [{"a": 1}, {"b": 2}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			value, err := embeddedconnector.DecodeValue(json.RawMessage(testCase.encoded))
+
+			// Assert
+			require.Error(t, err)
+			assert.Nil(t, value)
+		})
+	}
+}
+
+func TestValues_EncodeValue_Errors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value entities.MATLABValue
+	}{
+		{
+			name:  "size does not match elements",
+			value: entities.MATLABNumeric{NumericClass: entities.MATLABClassDouble, Dimensions: []int{1, 2}, Values: []float64{1}},
+		},
+		{
+			name:  "numeric value with a non-numeric class",
+			value: entities.MATLABNumeric{NumericClass: entities.MATLABClassCell, Dimensions: []int{1, 1}, Values: []float64{1}},
+		},
+		{
+			name:  "single dimension",
+			value: entities.MATLABLogical{Dimensions: []int{2}, Values: []bool{true, false}},
+		},
+		{
+			name: "struct element without a field",
+			value: entities.MATLABStruct{
+				Dimensions: []int{1, 1},
+				Fields:     []string{"a"},
+				Values:     []map[string]entities.MATLABValue{{"b": entities.NewMATLABDouble(1)}},
+			},
+		},
+		{
+			name: "invalid cell element",
+			value: entities.MATLABCell{Dimensions: []int{1, 1}, Values: []entities.MATLABValue{
+				entities.MATLABString{Dimensions: []int{1, 1}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Act
+			encoded, err := embeddedconnector.EncodeValue(testCase.value)
+
+			// Assert
+			require.Error(t, err)
+			assert.Nil(t, encoded)
+		})
+	}
+}
+
+func readFEvalResults(t *testing.T, fileName string) []json.RawMessage {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", fileName))
+	require.NoError(t, err)
+
+	var payload embeddedconnector.ConnectorPayload
+	require.NoError(t, json.Unmarshal(data, &payload))
+	require.Len(t, payload.Messages.FevalResponse, 1)
+
+	return payload.Messages.FevalResponse[0].Results
+}
+
+// assertMATLABValue compares MATLAB values through their encoding, so that NaN elements compare equal.
+func assertMATLABValue(t *testing.T, expected entities.MATLABValue, actual entities.MATLABValue) {
+	t.Helper()
+
+	require.Equal(t, expected.Class(), actual.Class())
+
+	if numeric, ok := expected.(entities.MATLABNumeric); ok && hasNaN(numeric.Values) {
+		expectedEncoded, err := embeddedconnector.EncodeValue(expected)
+		require.NoError(t, err)
+		actualEncoded, err := embeddedconnector.EncodeValue(actual)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(expectedEncoded), string(actualEncoded))
+		return
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func hasNaN(values []float64) bool {
+	for _, value := range values {
+		if math.IsNaN(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
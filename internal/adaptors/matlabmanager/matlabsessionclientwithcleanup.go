@@ -4,6 +4,7 @@ package matlabmanager
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
@@ -11,16 +12,59 @@ import (
 type matlabSessionClientWithCleanup struct {
 	entities.MATLABSessionClient
 	sessionCleanup func() error
+
+	processMonitor *entities.MATLABProcessMonitor
+	stopping       *atomic.Bool
+	crashed        chan struct{}
 }
 
-func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error) *matlabSessionClientWithCleanup {
-	return &matlabSessionClientWithCleanup{
+// newMATLABSessionClientWithCleanup wraps the client of a session with the cleanup of its session.
+// When the server launched the MATLAB process, processMonitor reports its exit, and an exit that StopSession did not cause is reported by Exited.
+func newMATLABSessionClientWithCleanup(matlabSessionClient entities.MATLABSessionClient, sessionCleanup func() error, processMonitor *entities.MATLABProcessMonitor) *matlabSessionClientWithCleanup {
+	client := &matlabSessionClientWithCleanup{
 		MATLABSessionClient: matlabSessionClient,
 		sessionCleanup:      sessionCleanup,
+
+		processMonitor: processMonitor,
+		stopping:       new(atomic.Bool),
+	}
+
+	if processMonitor != nil {
+		client.crashed = make(chan struct{})
+		go func() {
+			<-processMonitor.Done()
+			if !client.stopping.Load() {
+				close(client.crashed)
+			}
+		}()
 	}
+
+	return client
+}
+
+// Exited is closed when the MATLAB process exits without being stopped. It is nil when the process is not supervised.
+func (c *matlabSessionClientWithCleanup) Exited() <-chan struct{} {
+	return c.crashed
+}
+
+func (c *matlabSessionClientWithCleanup) ProcessExit() entities.MATLABProcessExit {
+	if c.processMonitor == nil {
+		return entities.MATLABProcessExit{}
+	}
+	exit, _ := c.processMonitor.Exit()
+	return exit
 }
 
 func (c *matlabSessionClientWithCleanup) StopSession(ctx context.Context, sessionLogger entities.Logger) error {
+	c.stopping.Store(true)
+
+	// A MATLAB process that already exited cannot evaluate exit(), so only its session is cleaned up.
+	if c.processMonitor != nil {
+		if _, exited := c.processMonitor.Exit(); exited {
+			return c.sessionCleanup()
+		}
+	}
+
 	_, err := c.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "exit()"})
 	if err != nil {
 		return err
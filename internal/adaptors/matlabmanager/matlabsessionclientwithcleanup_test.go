@@ -0,0 +1,117 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMATLABSessionClientWithCleanup_StopSession_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	processMonitor := entities.NewMATLABProcessMonitor()
+	cleanupCalled := false
+
+	mockSessionClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "exit()"}).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) (entities.EvalResponse, error) {
+			processMonitor.Exited(entities.MATLABProcessExit{ProcessID: 1234})
+			return entities.EvalResponse{}, nil
+		}).
+		Once()
+
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockSessionClient, func() error {
+		cleanupCalled = true
+		return nil
+	}, processMonitor)
+
+	// Act
+	err := client.StopSession(ctx, mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cleanupCalled)
+
+	select {
+	case <-client.Exited():
+		t.Fatal("A MATLAB process stopped by StopSession should not be reported as exited")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMATLABSessionClientWithCleanup_Exited_ProcessExitedUnexpectedly(t *testing.T) {
+	// Arrange
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	processMonitor := entities.NewMATLABProcessMonitor()
+	expectedExit := entities.MATLABProcessExit{
+		ProcessID: 1234,
+		ExitCode:  -1,
+		Signal:    "SIGSEGV",
+	}
+
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockSessionClient, func() error { return nil }, processMonitor)
+
+	// Act
+	processMonitor.Exited(expectedExit)
+
+	// Assert
+	select {
+	case <-client.Exited():
+	case <-time.After(time.Second):
+		t.Fatal("An unexpected exit of the MATLAB process should be reported")
+	}
+	assert.Equal(t, expectedExit, client.ProcessExit())
+}
+
+func TestMATLABSessionClientWithCleanup_StopSession_ProcessAlreadyExited(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	processMonitor := entities.NewMATLABProcessMonitor()
+	processMonitor.Exited(entities.MATLABProcessExit{ProcessID: 1234, ExitCode: 1})
+	cleanupCalled := false
+
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockSessionClient, func() error {
+		cleanupCalled = true
+		return nil
+	}, processMonitor)
+
+	// Act
+	err := client.StopSession(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cleanupCalled, "The session of an exited MATLAB process should still be cleaned up")
+}
+
+func TestMATLABSessionClientWithCleanup_Exited_Unsupervised(t *testing.T) {
+	// Arrange
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	// Act
+	client := matlabmanager.NewMATLABSessionClientWithCleanup(mockSessionClient, func() error { return nil }, nil)
+
+	// Assert
+	assert.Nil(t, client.Exited())
+	assert.Equal(t, entities.MATLABProcessExit{}, client.ProcessExit())
+}
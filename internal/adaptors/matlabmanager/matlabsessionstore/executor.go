@@ -0,0 +1,99 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// executor runs the requests to one MATLAB session one at a time, in the order they arrive.
+// MATLAB only evaluates one request at a time anyway, so queueing them here keeps the requests of
+// concurrent tool calls, such as changing folder and then evaluating code, from interleaving.
+type executor struct {
+	maxQueue int
+
+	l       *sync.Mutex
+	busy    bool
+	waiting []chan struct{}
+}
+
+func newExecutor(maxQueue int) *executor {
+	return &executor{
+		maxQueue: maxQueue,
+		l:        new(sync.Mutex),
+	}
+}
+
+// acquire waits for the session to be free, and returns a function to free it for the next request.
+// onQueued is called with the position of the request in the queue when it has to wait.
+// It fails straight away when maxQueue requests are already waiting, and when ctx is done before the session is free.
+func (e *executor) acquire(ctx context.Context, onQueued func(position int)) (func(), error) {
+	e.l.Lock()
+	if !e.busy {
+		e.busy = true
+		e.l.Unlock()
+		return e.release, nil
+	}
+
+	if e.maxQueue > 0 && len(e.waiting) >= e.maxQueue {
+		queued := len(e.waiting)
+		e.l.Unlock()
+		return nil, fmt.Errorf("%w: %d requests are already waiting for it, retry once they complete", entities.ErrMATLABSessionQueueFull, queued)
+	}
+
+	turn := make(chan struct{})
+	e.waiting = append(e.waiting, turn)
+	position := len(e.waiting)
+	e.l.Unlock()
+
+	onQueued(position)
+
+	select {
+	case <-turn:
+		return e.release, nil
+	case <-ctx.Done():
+		e.l.Lock()
+		defer e.l.Unlock()
+
+		if i := slices.Index(e.waiting, turn); i >= 0 {
+			e.waiting = slices.Delete(e.waiting, i, i+1)
+		} else {
+			// The session was handed over at the same time, so hand it over to the next request instead.
+			e.handOver()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// queued returns the number of requests waiting for the session.
+func (e *executor) queued() int {
+	e.l.Lock()
+	defer e.l.Unlock()
+
+	return len(e.waiting)
+}
+
+func (e *executor) release() {
+	e.l.Lock()
+	defer e.l.Unlock()
+
+	e.handOver()
+}
+
+// handOver frees the session for the oldest waiting request, if any.
+// It must be called with the lock held.
+func (e *executor) handOver() {
+	if len(e.waiting) == 0 {
+		e.busy = false
+		return
+	}
+
+	next := e.waiting[0]
+	e.waiting = e.waiting[1:]
+	close(next)
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionstore_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingEvals makes the evaluations of mockClient wait until release is closed, and records the order in which they ran.
+type blockingEvals struct {
+	l       sync.Mutex
+	codes   []string
+	started chan string
+	release chan struct{}
+}
+
+func newBlockingEvals(mockClient *mocks.MockMATLABSessionClientWithCleanup) *blockingEvals {
+	evals := &blockingEvals{
+		started: make(chan string, 10),
+		release: make(chan struct{}),
+	}
+
+	mockClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, mock.AnythingOfType("entities.EvalRequest")).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+			evals.l.Lock()
+			evals.codes = append(evals.codes, request.Code)
+			evals.l.Unlock()
+
+			evals.started <- request.Code
+			<-evals.release
+			return entities.EvalResponse{ConsoleOutput: request.Code}, nil
+		})
+
+	return evals
+}
+
+func (e *blockingEvals) ran() []string {
+	e.l.Lock()
+	defer e.l.Unlock()
+
+	return append([]string{}, e.codes...)
+}
+
+func newStoreWithSession(t *testing.T, maxSessionQueue int) (*matlabsessionstore.Store, *mocks.MockMATLABSessionClientWithCleanup, entities.SessionID) {
+	t.Helper()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	mockConfig := &mocks.MockConfig{}
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	t.Cleanup(func() {
+		mockLoggerFactory.AssertExpectations(t)
+		mockConfig.AssertExpectations(t)
+		mockLifecycleSignaler.AssertExpectations(t)
+		mockClient.AssertExpectations(t)
+	})
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(maxSessionQueue).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
+
+	return store, mockClient, sessionID
+}
+
+// waitForQueuedRequests waits until the session has the expected number of requests waiting for it.
+func waitForQueuedRequests(t *testing.T, store *matlabsessionstore.Store, expected int) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		return store.Sessions()[0].QueuedRequests == expected
+	}, time.Second, time.Millisecond)
+}
+
+func evalInBackground(ctx context.Context, t *testing.T, store *matlabsessionstore.Store, sessionID entities.SessionID, code string) <-chan error {
+	t.Helper()
+
+	client, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	errC := make(chan error, 1)
+	go func() {
+		_, err := client.Eval(ctx, testutils.NewInspectableLogger(), entities.EvalRequest{Code: code})
+		errC <- err
+	}()
+
+	return errC
+}
+
+func TestStore_Get_CallsRunOneAtATimeInArrivalOrder(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	store, mockClient, sessionID := newStoreWithSession(t, 0)
+	evals := newBlockingEvals(mockClient)
+
+	firstDone := evalInBackground(ctx, t, store, sessionID, "first")
+	require.Equal(t, "first", <-evals.started)
+
+	// Act
+	secondDone := evalInBackground(ctx, t, store, sessionID, "second")
+	waitForQueuedRequests(t, store, 1)
+	thirdDone := evalInBackground(ctx, t, store, sessionID, "third")
+	waitForQueuedRequests(t, store, 2)
+
+	// Assert
+	sessions := store.Sessions()
+	assert.True(t, sessions[0].Busy)
+	assert.Equal(t, 2, sessions[0].QueuedRequests)
+	assert.Equal(t, []string{"first"}, evals.ran(), "Queued calls should not run while the session is busy")
+
+	close(evals.release)
+	require.NoError(t, <-firstDone)
+	require.NoError(t, <-secondDone)
+	require.NoError(t, <-thirdDone)
+
+	assert.Equal(t, []string{"first", "second", "third"}, evals.ran())
+	assert.Equal(t, 0, store.Sessions()[0].QueuedRequests)
+}
+
+func TestStore_Get_FullQueueReturnsError(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	store, mockClient, sessionID := newStoreWithSession(t, 1)
+	evals := newBlockingEvals(mockClient)
+
+	firstDone := evalInBackground(ctx, t, store, sessionID, "first")
+	require.Equal(t, "first", <-evals.started)
+
+	secondDone := evalInBackground(ctx, t, store, sessionID, "second")
+	waitForQueuedRequests(t, store, 1)
+
+	client, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	// Act
+	_, err = client.Eval(ctx, testutils.NewInspectableLogger(), entities.EvalRequest{Code: "third"})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABSessionQueueFull)
+	assert.ErrorContains(t, err, "1 requests are already waiting")
+
+	close(evals.release)
+	require.NoError(t, <-firstDone)
+	require.NoError(t, <-secondDone)
+	assert.Equal(t, []string{"first", "second"}, evals.ran())
+}
+
+func TestStore_Get_CancelledCallLeavesTheQueue(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	store, mockClient, sessionID := newStoreWithSession(t, 0)
+	evals := newBlockingEvals(mockClient)
+
+	firstDone := evalInBackground(ctx, t, store, sessionID, "first")
+	require.Equal(t, "first", <-evals.started)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancelledDone := evalInBackground(cancelCtx, t, store, sessionID, "cancelled")
+	waitForQueuedRequests(t, store, 1)
+
+	// Act
+	cancel()
+
+	// Assert
+	require.ErrorIs(t, <-cancelledDone, context.Canceled)
+	assert.Equal(t, 0, store.Sessions()[0].QueuedRequests)
+
+	close(evals.release)
+	require.NoError(t, <-firstDone)
+
+	require.NoError(t, <-evalInBackground(ctx, t, store, sessionID, "after"))
+	assert.Equal(t, []string{"first", "after"}, evals.ran())
+}
+
+func TestStore_Get_BatchWaitsForEarlierCalls(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+	store, mockClient, sessionID := newStoreWithSession(t, 0)
+	evals := newBlockingEvals(mockClient)
+
+	requests := []entities.BatchRequest{
+		entities.EvalRequest{Code: "cd('folder')"},
+		entities.EvalRequest{Code: "code"},
+	}
+	expectedResponses := []entities.BatchResponse{{}, {Eval: entities.EvalResponse{ConsoleOutput: "code"}}}
+
+	batchRan := make(chan struct{})
+	mockClient.EXPECT().
+		Batch(mock.Anything, mock.Anything, requests).
+		RunAndReturn(func(context.Context, entities.Logger, []entities.BatchRequest) ([]entities.BatchResponse, error) {
+			close(batchRan)
+			return expectedResponses, nil
+		}).
+		Once()
+
+	firstDone := evalInBackground(ctx, t, store, sessionID, "first")
+	require.Equal(t, "first", <-evals.started)
+
+	client, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	type batchResult struct {
+		responses []entities.BatchResponse
+		err       error
+	}
+	batchDone := make(chan batchResult, 1)
+
+	// Act
+	go func() {
+		responses, err := client.Batch(ctx, testutils.NewInspectableLogger(), requests)
+		batchDone <- batchResult{responses: responses, err: err}
+	}()
+	waitForQueuedRequests(t, store, 1)
+
+	// Assert
+	select {
+	case <-batchRan:
+		t.Fatal("A batch should not run while the session is busy")
+	default:
+	}
+
+	close(evals.release)
+	require.NoError(t, <-firstDone)
+
+	result := <-batchDone
+	require.NoError(t, result.err)
+	assert.Equal(t, expectedResponses, result.responses)
+}
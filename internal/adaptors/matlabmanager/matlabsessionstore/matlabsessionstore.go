@@ -3,14 +3,26 @@
 package matlabsessionstore
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"golang.org/x/sync/errgroup"
 )
 
+// maxReapInterval bounds how late an idle session can be stopped after it exceeds the idle timeout.
+const maxReapInterval = time.Minute
+
+type Config interface {
+	SessionIdleTimeout() time.Duration
+	PersistentSessions() bool
+	MaxSessionQueue() int
+}
+
 type LoggerFactory interface {
 	GetGlobalLogger() entities.Logger
 }
@@ -20,36 +32,82 @@ type MATLABSessionClientWithCleanup interface {
 	StopSession(ctx context.Context, sessionLogger entities.Logger) error
 }
 
+// SupervisedMATLABSessionClient is implemented by the clients of sessions whose MATLAB process is supervised.
+// Exited is closed when the process exits without being stopped, and ProcessExit then describes how it exited.
+type SupervisedMATLABSessionClient interface {
+	Exited() <-chan struct{}
+	ProcessExit() entities.MATLABProcessExit
+}
+
 type LifecycleSignaler interface {
 	AddShutdownFunction(shutdownFcn func() error)
 }
 
 type Store struct {
+	loggerFactory      LoggerFactory
+	idleTimeout        time.Duration
+	persistentSessions bool
+	maxSessionQueue    int
+	now                func() time.Time
+
 	l       *sync.RWMutex
 	next    entities.SessionID
-	clients map[entities.SessionID]MATLABSessionClientWithCleanup
+	clients map[entities.SessionID]*session
+	expired map[entities.SessionID]time.Duration
+}
+
+type session struct {
+	client   MATLABSessionClientWithCleanup
+	info     entities.MATLABSessionInfo
+	executor *executor
+	lastUsed time.Time
+	inFlight int
+	exit     *entities.MATLABProcessExit
+	removed  chan struct{}
 }
 
 func New(
+	config Config,
 	loggerFactory LoggerFactory,
 	lifecycleSignaler LifecycleSignaler,
 ) *Store {
 	store := &Store{
+		loggerFactory:      loggerFactory,
+		idleTimeout:        config.SessionIdleTimeout(),
+		persistentSessions: config.PersistentSessions(),
+		maxSessionQueue:    config.MaxSessionQueue(),
+		now:                time.Now,
+
 		l:       new(sync.RWMutex),
 		next:    1,
-		clients: map[entities.SessionID]MATLABSessionClientWithCleanup{},
+		clients: map[entities.SessionID]*session{},
+		expired: map[entities.SessionID]time.Duration{},
+	}
+
+	stopReaping := make(chan struct{})
+	if store.idleTimeout > 0 {
+		go store.reapIdleSessionsUntil(stopReaping)
 	}
 
 	lifecycleSignaler.AddShutdownFunction(func() error {
+		close(stopReaping)
+
 		store.l.Lock()
 		defer store.l.Unlock()
 
 		logger := loggerFactory.GetGlobalLogger()
+
+		// Persistent sessions are recorded in their session directories, for the next server to restore them.
+		if store.persistentSessions {
+			logger.With("count", len(store.clients)).Info("Leaving MATLAB sessions running for the next server")
+			return nil
+		}
+
 		wg := new(errgroup.Group)
 
-		for sessionID, client := range store.clients {
+		for sessionID, session := range store.clients {
 			wg.Go(func() error {
-				err := client.StopSession(context.Background(), logger)
+				err := session.client.StopSession(context.Background(), logger)
 				if err != nil {
 					return fmt.Errorf("error stopping session %v: %w", sessionID, err)
 				}
@@ -63,31 +121,283 @@ func New(
 	return store
 }
 
-func (s *Store) Add(client MATLABSessionClientWithCleanup) entities.SessionID {
+// Add stores a session with the details it was started with, and returns its new ID.
+func (s *Store) Add(client MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) entities.SessionID {
 	s.l.Lock()
 	defer s.l.Unlock()
 
 	sessionID := s.next
-	s.clients[sessionID] = client
+	session := &session{
+		client:   client,
+		info:     info,
+		executor: newExecutor(s.maxSessionQueue),
+		lastUsed: s.now(),
+		removed:  make(chan struct{}),
+	}
+	s.clients[sessionID] = session
 	s.next++
+
+	if supervisedClient, ok := client.(SupervisedMATLABSessionClient); ok && supervisedClient.Exited() != nil {
+		go s.watchForExit(sessionID, session, supervisedClient)
+	}
+
 	return entities.SessionID(sessionID)
 }
 
+// watchForExit marks the session as exited when its MATLAB process exits without being stopped, until the session is removed.
+func (s *Store) watchForExit(sessionID entities.SessionID, session *session, client SupervisedMATLABSessionClient) {
+	select {
+	case <-session.removed:
+		return
+	case <-client.Exited():
+	}
+
+	// The session may have been removed as its process exited, as both channels are then ready.
+	select {
+	case <-session.removed:
+		return
+	default:
+	}
+
+	exit := client.ProcessExit()
+
+	s.loggerFactory.GetGlobalLogger().
+		With("session-id", sessionID).
+		With("pid", exit.ProcessID).
+		With("exit-code", exit.ExitCode).
+		With("signal", exit.Signal).
+		With("crash-dumps", exit.CrashDumps).
+		Warn("MATLAB session exited unexpectedly")
+
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	session.exit = &exit
+}
+
+// Get returns the client of a session, and counts as a use of the session.
+// The client runs its calls to the session one at a time, in the order they arrive,
+// and keeps the session in use for as long as one of its calls is running.
+// When the MATLAB process of the session exited unexpectedly, Get returns an *entities.MATLABProcessExitedError
+// together with the client of the session, which can then only be used to stop the session.
 func (s *Store) Get(sessionID entities.SessionID) (MATLABSessionClientWithCleanup, error) {
-	s.l.RLock()
-	defer s.l.RUnlock()
+	s.l.Lock()
+	defer s.l.Unlock()
 
-	client, exists := s.clients[sessionID]
+	session, exists := s.clients[sessionID]
 	if !exists {
+		if idleTimeout, expired := s.expired[sessionID]; expired {
+			return nil, fmt.Errorf("%w: session %v was stopped after being idle for more than %v, start a new session to continue", entities.ErrMATLABSessionExpired, sessionID, idleTimeout)
+		}
 		return nil, fmt.Errorf("session not found: %v", sessionID)
 	}
 
-	return client, nil
+	session.lastUsed = s.now()
+
+	if session.exit != nil {
+		return session.client, &entities.MATLABProcessExitedError{
+			SessionID: sessionID,
+			Exit:      *session.exit,
+		}
+	}
+
+	return &trackedClient{
+		MATLABSessionClientWithCleanup: session.client,
+		executor:                       session.executor,
+		markInUse: func() func() {
+			return s.markInUse(sessionID)
+		},
+	}, nil
 }
 
 func (s *Store) Remove(sessionID entities.SessionID) {
 	s.l.Lock()
 	defer s.l.Unlock()
 
+	session, exists := s.clients[sessionID]
+	if !exists {
+		return
+	}
+
+	close(session.removed)
 	delete(s.clients, sessionID)
 }
+
+// SessionIDs returns the IDs of the sessions in the store, in ascending order.
+func (s *Store) SessionIDs() []entities.SessionID {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	sessionIDs := make([]entities.SessionID, 0, len(s.clients))
+	for sessionID := range s.clients {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+	slices.Sort(sessionIDs)
+
+	return sessionIDs
+}
+
+// Sessions describes the sessions in the store, in ascending order of ID.
+// A session is busy while one of its calls is running, and the calls waiting for it are queued.
+// Sessions whose MATLAB process exited unexpectedly describe how it exited.
+func (s *Store) Sessions() []entities.MATLABSessionInfo {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	sessions := make([]entities.MATLABSessionInfo, 0, len(s.clients))
+	for sessionID, session := range s.clients {
+		info := session.info
+		info.SessionID = sessionID
+		info.LastUsed = session.lastUsed
+		info.Busy = session.inFlight > 0
+		info.QueuedRequests = session.executor.queued()
+		info.Exited = session.exit
+		sessions = append(sessions, info)
+	}
+	slices.SortFunc(sessions, func(a, b entities.MATLABSessionInfo) int {
+		return cmp.Compare(a.SessionID, b.SessionID)
+	})
+
+	return sessions
+}
+
+// markInUse records that a call to the session started, and returns a function to record that it ended.
+func (s *Store) markInUse(sessionID entities.SessionID) func() {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	session, exists := s.clients[sessionID]
+	if !exists {
+		return func() {}
+	}
+
+	session.inFlight++
+	session.lastUsed = s.now()
+
+	return func() {
+		s.l.Lock()
+		defer s.l.Unlock()
+
+		session.inFlight--
+		session.lastUsed = s.now()
+	}
+}
+
+func (s *Store) reapIdleSessionsUntil(stop <-chan struct{}) {
+	ticker := time.NewTicker(min(s.idleTimeout/2, maxReapInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.reapIdleSessions()
+		}
+	}
+}
+
+// reapIdleSessions stops the sessions that have not been used for longer than the idle timeout.
+// Their IDs are remembered, so that later requests for them report that they expired.
+func (s *Store) reapIdleSessions() {
+	s.l.Lock()
+	now := s.now()
+	idleSessions := map[entities.SessionID]*session{}
+	for sessionID, session := range s.clients {
+		if session.inFlight > 0 || now.Sub(session.lastUsed) <= s.idleTimeout {
+			continue
+		}
+		idleSessions[sessionID] = session
+		close(session.removed)
+		delete(s.clients, sessionID)
+		s.expired[sessionID] = s.idleTimeout
+	}
+	s.l.Unlock()
+
+	if len(idleSessions) == 0 {
+		return
+	}
+
+	logger := s.loggerFactory.GetGlobalLogger()
+	for sessionID, session := range idleSessions {
+		sessionLogger := logger.
+			With("session-id", sessionID).
+			With("idle-time", now.Sub(session.lastUsed))
+
+		sessionLogger.Info("Stopping idle MATLAB session")
+		if err := session.client.StopSession(context.Background(), sessionLogger); err != nil {
+			sessionLogger.WithError(err).Warn("Failed to stop idle MATLAB session")
+		}
+	}
+}
+
+// trackedClient queues the calls to its session, and keeps the session in use while one of its calls is running, so that long evaluations are not reaped.
+// Ping and StopSession are not queued, so that they do not wait for a long evaluation.
+type trackedClient struct {
+	MATLABSessionClientWithCleanup
+	executor  *executor
+	markInUse func() func()
+}
+
+func (c *trackedClient) runExclusively(ctx context.Context, sessionLogger entities.Logger, fn func(client entities.MATLABSessionClient) error) error {
+	queuedAt := time.Now()
+	queued := false
+
+	release, err := c.executor.acquire(ctx, func(position int) {
+		queued = true
+		sessionLogger.With("queue-position", position).Info("MATLAB session is busy, waiting for earlier requests")
+		entities.ReportProgress(ctx, fmt.Sprintf("MATLAB session is busy, waiting for %d earlier request(s)", position))
+	})
+	if err != nil {
+		sessionLogger.WithError(err).Warn("Request to MATLAB session was not run")
+		return err
+	}
+	defer release()
+
+	if queued {
+		sessionLogger.With("wait-time", time.Since(queuedAt)).Info("MATLAB session is free, running request")
+	}
+
+	defer c.markInUse()()
+	return fn(c.MATLABSessionClientWithCleanup)
+}
+
+func (c *trackedClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response entities.EvalResponse
+	err := c.runExclusively(ctx, sessionLogger, func(client entities.MATLABSessionClient) error {
+		var err error
+		response, err = client.Eval(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *trackedClient) EvalWithCapture(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
+	var response entities.EvalResponse
+	err := c.runExclusively(ctx, sessionLogger, func(client entities.MATLABSessionClient) error {
+		var err error
+		response, err = client.EvalWithCapture(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *trackedClient) FEval(ctx context.Context, sessionLogger entities.Logger, request entities.FEvalRequest) (entities.FEvalResponse, error) {
+	var response entities.FEvalResponse
+	err := c.runExclusively(ctx, sessionLogger, func(client entities.MATLABSessionClient) error {
+		var err error
+		response, err = client.FEval(ctx, sessionLogger, request)
+		return err
+	})
+	return response, err
+}
+
+func (c *trackedClient) Batch(ctx context.Context, sessionLogger entities.Logger, requests []entities.BatchRequest) ([]entities.BatchResponse, error) {
+	var responses []entities.BatchResponse
+	err := c.runExclusively(ctx, sessionLogger, func(client entities.MATLABSessionClient) error {
+		var err error
+		responses, err = client.Batch(ctx, sessionLogger, requests)
+		return err
+	})
+	return responses, err
+}
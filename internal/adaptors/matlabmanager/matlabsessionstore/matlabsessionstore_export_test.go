@@ -0,0 +1,21 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabsessionstore
+
+import "time"
+
+func (s *Store) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+func (s *Store) ReapIdleSessions() {
+	s.reapIdleSessions()
+}
+
+// UntrackedClient returns the client that was added to the store, from a client returned by Get.
+func UntrackedClient(client MATLABSessionClientWithCleanup) MATLABSessionClientWithCleanup {
+	if trackedClient, ok := client.(*trackedClient); ok {
+		return trackedClient.MATLABSessionClientWithCleanup
+	}
+	return client
+}
@@ -3,7 +3,11 @@
 package matlabsessionstore_test
 
 import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
@@ -19,6 +23,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -30,8 +37,23 @@ func TestNew_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
 	// Act
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Assert
 	assert.NotNil(t, store)
@@ -42,6 +64,9 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -78,11 +103,26 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 		Return(nil).
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
-	store.Add(mockClient1)
-	store.Add(mockClient2)
+	store.Add(mockClient1, entities.MATLABSessionInfo{})
+	store.Add(mockClient2, entities.MATLABSessionInfo{})
 
 	// Act
 	err := capturedShutdownFunc()
@@ -91,11 +131,76 @@ func TestNew_ShutdownFunctionCallsStopSessionOnAllClients(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNew_ShutdownFunctionLeavesPersistentSessionsRunning(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(true).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	require.NotNil(t, capturedShutdownFunc)
+
+	store.Add(mockClient, entities.MATLABSessionInfo{})
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err)
+
+	fields, found := mockLogger.InfoLogs()["Leaving MATLAB sessions running for the next server"]
+	require.True(t, found, "Expected a log about the sessions left running")
+	assert.Equal(t, 1, fields["count"])
+}
+
 func TestNew_ShutdownFunctionHandlesEmptyStore(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -116,7 +221,22 @@ func TestNew_ShutdownFunctionHandlesEmptyStore(t *testing.T) {
 		Return(mockLogger).
 		Once()
 
-	matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
 	// Act
@@ -131,6 +251,9 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -168,11 +291,26 @@ func TestNew_ShutdownFunctionReturnsErrorWhenStopSessionFails(t *testing.T) {
 		Return(expectedError).
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 	require.NotNil(t, capturedShutdownFunc)
 
-	store.Add(mockClient1)
-	store.Add(mockClient2)
+	store.Add(mockClient1, entities.MATLABSessionInfo{})
+	store.Add(mockClient2, entities.MATLABSessionInfo{})
 
 	// Act
 	err := capturedShutdownFunc()
@@ -186,6 +324,9 @@ func TestStore_Add_HappyPath(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -197,10 +338,25 @@ func TestStore_Add_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
-	sessionID := store.Add(mockClient)
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
 
 	// Assert
 	assert.Equal(t, entities.SessionID(1), sessionID)
@@ -211,6 +367,9 @@ func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -228,12 +387,27 @@ func TestStore_Add_MultipleClients_ReturnsIncrementingIDs(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act
-	sessionID1 := store.Add(mockClient1)
-	sessionID2 := store.Add(mockClient2)
-	sessionID3 := store.Add(mockClient3)
+	sessionID1 := store.Add(mockClient1, entities.MATLABSessionInfo{})
+	sessionID2 := store.Add(mockClient2, entities.MATLABSessionInfo{})
+	sessionID3 := store.Add(mockClient3, entities.MATLABSessionInfo{})
 
 	// Assert
 	assert.Equal(t, entities.SessionID(1), sessionID1)
@@ -246,6 +420,9 @@ func TestStore_Get_HappyPath(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -257,15 +434,30 @@ func TestStore_Get_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
-	sessionID := store.Add(mockClient)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
 
 	// Act
 	retrievedClient, err := store.Get(sessionID)
 
 	// Assert
 	require.NoError(t, err)
-	assert.Equal(t, mockClient, retrievedClient)
+	assert.Equal(t, mockClient, matlabsessionstore.UntrackedClient(retrievedClient))
 }
 
 func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
@@ -273,6 +465,9 @@ func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -281,7 +476,22 @@ func TestStore_Get_NonExistentSession_ReturnsError(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 	nonExistentSessionID := entities.SessionID(999)
 
 	// Act
@@ -299,6 +509,9 @@ func TestStore_Remove_HappyPath(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -310,13 +523,28 @@ func TestStore_Remove_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
-	sessionID := store.Add(mockClient)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
 
 	// Verify client exists before removal
 	retrievedClient, err := store.Get(sessionID)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient, retrievedClient)
+	assert.Equal(t, mockClient, matlabsessionstore.UntrackedClient(retrievedClient))
 
 	// Act
 	store.Remove(sessionID)
@@ -333,6 +561,9 @@ func TestStore_Remove_NonExistentSession_NoError(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -341,7 +572,22 @@ func TestStore_Remove_NonExistentSession_NoError(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 	nonExistentSessionID := entities.SessionID(999)
 
 	// Act & Assert (should not panic or error)
@@ -353,6 +599,9 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
 	defer mockLoggerFactory.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
 	defer mockLifecycleSignaler.AssertExpectations(t)
 
@@ -370,25 +619,40 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 		Return().
 		Once()
 
-	store := matlabsessionstore.New(mockLoggerFactory, mockLifecycleSignaler)
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
 
 	// Act - Add multiple clients
-	sessionID1 := store.Add(mockClient1)
-	sessionID2 := store.Add(mockClient2)
-	sessionID3 := store.Add(mockClient3)
+	sessionID1 := store.Add(mockClient1, entities.MATLABSessionInfo{})
+	sessionID2 := store.Add(mockClient2, entities.MATLABSessionInfo{})
+	sessionID3 := store.Add(mockClient3, entities.MATLABSessionInfo{})
 
 	// Assert - All clients can be retrieved
 	retrievedClient1, err := store.Get(sessionID1)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient1, retrievedClient1)
+	assert.Equal(t, mockClient1, matlabsessionstore.UntrackedClient(retrievedClient1))
 
 	retrievedClient2, err := store.Get(sessionID2)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient2, retrievedClient2)
+	assert.Equal(t, mockClient2, matlabsessionstore.UntrackedClient(retrievedClient2))
 
 	retrievedClient3, err := store.Get(sessionID3)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient3, retrievedClient3)
+	assert.Equal(t, mockClient3, matlabsessionstore.UntrackedClient(retrievedClient3))
 
 	// Act - Remove middle client
 	store.Remove(sessionID2)
@@ -396,7 +660,7 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 	// Assert - Client 2 is gone, but 1 and 3 remain
 	retrievedClient1, err = store.Get(sessionID1)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient1, retrievedClient1)
+	assert.Equal(t, mockClient1, matlabsessionstore.UntrackedClient(retrievedClient1))
 
 	retrievedClient2, err = store.Get(sessionID2)
 	require.Error(t, err)
@@ -404,5 +668,627 @@ func TestStore_AddGetRemove_MultipleClients(t *testing.T) {
 
 	retrievedClient3, err = store.Get(sessionID3)
 	require.NoError(t, err)
-	assert.Equal(t, mockClient3, retrievedClient3)
+	assert.Equal(t, mockClient3, matlabsessionstore.UntrackedClient(retrievedClient3))
+}
+
+func TestStore_SessionIDs_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockClient3 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient3.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID1 := store.Add(mockClient1, entities.MATLABSessionInfo{})
+	sessionID2 := store.Add(mockClient2, entities.MATLABSessionInfo{})
+	sessionID3 := store.Add(mockClient3, entities.MATLABSessionInfo{})
+	store.Remove(sessionID2)
+
+	// Act
+	sessionIDs := store.SessionIDs()
+
+	// Assert
+	assert.Equal(t, []entities.SessionID{sessionID1, sessionID3}, sessionIDs)
+}
+
+func TestStore_SessionIDs_EmptyStore(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+
+	// Act
+	sessionIDs := store.SessionIDs()
+
+	// Assert
+	assert.Empty(t, sessionIDs)
+}
+
+func TestStore_Sessions_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient1 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient1.AssertExpectations(t)
+
+	mockClient2 := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient2.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	start := time.Date(2025, time.October, 1, 9, 0, 0, 0, time.UTC)
+	clock := &atomic.Pointer[time.Time]{}
+	clock.Store(&start)
+
+	evalStarted := make(chan struct{})
+	finishEval := make(chan struct{})
+
+	info1 := entities.MATLABSessionInfo{
+		MATLABRoot:     filepath.Join("path", "to", "matlab", "R2024b"),
+		Release:        "R2024b",
+		ProcessID:      1234,
+		StartTime:      start.Add(-time.Minute),
+		StartingFolder: filepath.Join("path", "to", "work"),
+	}
+	info2 := entities.MATLABSessionInfo{
+		MATLABRoot: filepath.Join("path", "to", "matlab", "R2025a"),
+		VMCRoot:    filepath.Join("path", "to", "vmc"),
+		Release:    "R2025a",
+		ProcessID:  5678,
+		StartTime:  start.Add(-time.Minute),
+	}
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	mockClient2.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "sim('model')"}).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) (entities.EvalResponse, error) {
+			close(evalStarted)
+			<-finishEval
+			return entities.EvalResponse{}, nil
+		}).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	store.SetClock(func() time.Time { return *clock.Load() })
+	sessionID1 := store.Add(mockClient1, info1)
+	sessionID2 := store.Add(mockClient2, info2)
+
+	later := start.Add(time.Minute)
+	clock.Store(&later)
+
+	client, err := store.Get(sessionID2)
+	require.NoError(t, err)
+
+	evalDone := make(chan error)
+	go func() {
+		_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "sim('model')"})
+		evalDone <- err
+	}()
+	<-evalStarted
+
+	// Act
+	sessions := store.Sessions()
+
+	// Assert
+	expectedSession1 := info1
+	expectedSession1.SessionID = sessionID1
+	expectedSession1.LastUsed = start
+
+	expectedSession2 := info2
+	expectedSession2.SessionID = sessionID2
+	expectedSession2.LastUsed = later
+	expectedSession2.Busy = true
+
+	assert.Equal(t, []entities.MATLABSessionInfo{expectedSession1, expectedSession2}, sessions)
+
+	close(finishEval)
+	require.NoError(t, <-evalDone)
+
+	sessions = store.Sessions()
+	require.Len(t, sessions, 2)
+	assert.False(t, sessions[1].Busy, "A session should be idle once its calls are done")
+}
+
+func TestStore_ReapIdleSessions_StopsIdleSessions(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	const idleTimeout = 30 * time.Minute
+	now := time.Date(2025, time.October, 1, 9, 0, 0, 0, time.UTC)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(mock.AnythingOfType("context.backgroundCtx"), mockLogger.AsMockArg()).
+		Return(nil).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	store.SetClock(func() time.Time { return now })
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
+
+	now = now.Add(idleTimeout + time.Second)
+
+	// Act
+	store.ReapIdleSessions()
+
+	// Assert
+	assert.Empty(t, store.SessionIDs())
+
+	fields, found := mockLogger.InfoLogs()["Stopping idle MATLAB session"]
+	require.True(t, found, "Reaping a session should be logged")
+	assert.Equal(t, sessionID, fields["session-id"])
+
+	retrievedClient, err := store.Get(sessionID)
+	require.ErrorIs(t, err, entities.ErrMATLABSessionExpired)
+	assert.Nil(t, retrievedClient)
+	assert.Contains(t, err.Error(), "idle for more than 30m0s")
+}
+
+func TestStore_ReapIdleSessions_KeepsRecentlyUsedSessions(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	const idleTimeout = 30 * time.Minute
+	now := time.Date(2025, time.October, 1, 9, 0, 0, 0, time.UTC)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	store.SetClock(func() time.Time { return now })
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
+
+	now = now.Add(idleTimeout - time.Minute)
+	_, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	now = now.Add(idleTimeout - time.Minute)
+
+	// Act
+	store.ReapIdleSessions()
+
+	// Assert
+	assert.Equal(t, []entities.SessionID{sessionID}, store.SessionIDs())
+}
+
+func TestStore_ReapIdleSessions_KeepsSessionsWithRunningCalls(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const idleTimeout = 30 * time.Minute
+	start := time.Date(2025, time.October, 1, 9, 0, 0, 0, time.UTC)
+	clock := &atomic.Pointer[time.Time]{}
+	clock.Store(&start)
+
+	evalStarted := make(chan struct{})
+	finishEval := make(chan struct{})
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	mockClient.EXPECT().
+		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: "sim('model')"}).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, _ entities.EvalRequest) (entities.EvalResponse, error) {
+			close(evalStarted)
+			<-finishEval
+			return entities.EvalResponse{}, nil
+		}).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	store.SetClock(func() time.Time { return *clock.Load() })
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
+
+	client, err := store.Get(sessionID)
+	require.NoError(t, err)
+
+	evalDone := make(chan error)
+	go func() {
+		_, err := client.Eval(ctx, mockLogger, entities.EvalRequest{Code: "sim('model')"})
+		evalDone <- err
+	}()
+	<-evalStarted
+
+	later := start.Add(2 * idleTimeout)
+	clock.Store(&later)
+
+	// Act
+	store.ReapIdleSessions()
+
+	// Assert
+	assert.Equal(t, []entities.SessionID{sessionID}, store.SessionIDs(), "A session with a running call should not be reaped")
+
+	close(finishEval)
+	require.NoError(t, <-evalDone)
+}
+
+func TestStore_ReapIdleSessions_StopSessionErrorIsLogged(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	const idleTimeout = 30 * time.Minute
+	now := time.Date(2025, time.October, 1, 9, 0, 0, 0, time.UTC)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(idleTimeout).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockClient.EXPECT().
+		StopSession(mock.AnythingOfType("context.backgroundCtx"), mockLogger.AsMockArg()).
+		Return(assert.AnError).
+		Once()
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	store.SetClock(func() time.Time { return now })
+	sessionID := store.Add(mockClient, entities.MATLABSessionInfo{})
+
+	now = now.Add(2 * idleTimeout)
+
+	// Act
+	store.ReapIdleSessions()
+
+	// Assert
+	_, found := mockLogger.WarnLogs()["Failed to stop idle MATLAB session"]
+	assert.True(t, found, "A failure to stop an idle session should be logged")
+
+	_, err := store.Get(sessionID)
+	require.ErrorIs(t, err, entities.ErrMATLABSessionExpired)
+}
+
+// supervisedClient is a session client whose MATLAB process exits when the test closes its exited channel.
+type supervisedClient struct {
+	*mocks.MockMATLABSessionClientWithCleanup
+	exited chan struct{}
+	exit   entities.MATLABProcessExit
+}
+
+func (c *supervisedClient) Exited() <-chan struct{} {
+	return c.exited
+}
+
+func (c *supervisedClient) ProcessExit() entities.MATLABProcessExit {
+	return c.exit
+}
+
+func TestStore_Get_ExitedSession_ReturnsCrashReport(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	expectedExit := entities.MATLABProcessExit{
+		ProcessID:  1234,
+		ExitCode:   -1,
+		Signal:     "SIGSEGV",
+		StderrTail: "Segmentation violation detected",
+		CrashDumps: []string{filepath.Join("session", "matlab_crash_dump.1234-1")},
+	}
+	client := &supervisedClient{
+		MockMATLABSessionClientWithCleanup: mockClient,
+		exited:                             make(chan struct{}),
+		exit:                               expectedExit,
+	}
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(client, entities.MATLABSessionInfo{ProcessID: expectedExit.ProcessID})
+
+	// Act
+	close(client.exited)
+	require.Eventually(t, func() bool {
+		return store.Sessions()[0].Exited != nil
+	}, time.Second, time.Millisecond)
+	retrievedClient, err := store.Get(sessionID)
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABProcessExited)
+	var exitedErr *entities.MATLABProcessExitedError
+	require.ErrorAs(t, err, &exitedErr)
+	assert.Equal(t, sessionID, exitedErr.SessionID)
+	assert.Equal(t, expectedExit, exitedErr.Exit)
+	assert.Contains(t, err.Error(), "SIGSEGV")
+	assert.Contains(t, err.Error(), expectedExit.StderrTail)
+	assert.Contains(t, err.Error(), expectedExit.CrashDumps[0])
+
+	assert.Equal(t, client, retrievedClient, "The client of an exited session should be returned, so that the session can be stopped")
+	assert.Equal(t, &expectedExit, store.Sessions()[0].Exited)
+
+	_, found := mockLogger.WarnLogs()["MATLAB session exited unexpectedly"]
+	assert.True(t, found, "An unexpected exit should be logged")
+}
+
+func TestStore_Remove_StopsWatchingSupervisedSession(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockClient := &mocks.MockMATLABSessionClientWithCleanup{}
+	defer mockClient.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		SessionIdleTimeout().
+		Return(0).
+		Once()
+
+	mockConfig.EXPECT().
+		PersistentSessions().
+		Return(false).
+		Once()
+
+	mockConfig.EXPECT().
+		MaxSessionQueue().
+		Return(0).
+		Once()
+
+	client := &supervisedClient{
+		MockMATLABSessionClientWithCleanup: mockClient,
+		exited:                             make(chan struct{}),
+	}
+
+	store := matlabsessionstore.New(mockConfig, mockLoggerFactory, mockLifecycleSignaler)
+	sessionID := store.Add(client, entities.MATLABSessionInfo{})
+
+	// Act
+	store.Remove(sessionID)
+	close(client.exited)
+
+	// Assert
+	assert.Empty(t, store.Sessions())
+	_, err := store.Get(sessionID)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, entities.ErrMATLABProcessExited)
 }
@@ -0,0 +1,60 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+// RestoreMATLABSessions adds the sessions left running by an earlier server back to the store.
+// Each session is checked in the background, so that the server does not wait for stopped sessions to time out.
+// Sessions that stopped are forgotten, and their directories removed.
+func (m *MATLABManager) RestoreMATLABSessions(ctx context.Context, logger entities.Logger) {
+	sessions, err := m.matlabServices.FindPersistedLocalMATLABSessions(logger)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to find MATLAB sessions left by an earlier server")
+		return
+	}
+
+	logger.With("count", len(sessions)).Info("Restoring MATLAB sessions left by an earlier server")
+
+	for _, session := range sessions {
+		m.restoring.Add(1)
+		go func() {
+			defer m.restoring.Done()
+			m.restoreMATLABSession(ctx, logger.With("session_dir", session.SessionDirectory).With("pid", session.ProcessID), session)
+		}()
+	}
+}
+
+func (m *MATLABManager) restoreMATLABSession(ctx context.Context, logger entities.Logger, session datatypes.PersistedLocalSession) {
+	client, err := m.clientFactory.New(session.Endpoint)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to connect to MATLAB session left by an earlier server")
+		return
+	}
+
+	if !client.Ping(ctx, logger).IsAlive {
+		logger.Info("MATLAB session left by an earlier server has stopped, removing its session directory")
+		if err := m.matlabServices.DiscardLocalMATLABSession(logger, session); err != nil {
+			logger.WithError(err).Warn("Failed to remove session directory")
+		}
+		return
+	}
+
+	sessionCleanup := m.matlabServices.RestoreLocalMATLABSession(logger, session)
+
+	sessionID := m.sessionStore.Add(newMATLABSessionClientWithCleanup(client, sessionCleanup, nil), entities.MATLABSessionInfo{
+		MATLABRoot:     session.MATLABRoot,
+		VMCRoot:        session.VMCRoot,
+		Release:        session.Release,
+		ProcessID:      session.ProcessID,
+		StartTime:      session.StartTime,
+		StartingFolder: session.StartingDirectory,
+	})
+
+	logger.With("session-id", sessionID).Info("Restored MATLAB session left by an earlier server")
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPersistedSession() datatypes.PersistedLocalSession {
+	return datatypes.PersistedLocalSession{
+		LocalSession: datatypes.LocalSession{
+			Endpoint: embeddedconnector.ConnectionDetails{
+				Host:   "localhost",
+				Port:   "31515",
+				APIKey: "api-key",
+			},
+			ProcessID:         2468,
+			Release:           "R2024b",
+			StartingDirectory: filepath.Join("home", "user", "project"),
+		},
+		MATLABRoot:       filepath.Join("opt", "matlab", "R2024b"),
+		VMCRoot:          filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer"),
+		StartTime:        time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC),
+		SessionDirectory: filepath.Join("tmp", "logs", "matlab-session-1-abc"),
+	}
+}
+
+func TestMATLABManager_RestoreMATLABSessions_AliveSessionIsAddedToStore(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	session := newPersistedSession()
+	sessionCleanupCalled := false
+
+	mockMATLABServices.EXPECT().
+		FindPersistedLocalMATLABSessions(mockLogger.AsMockArg()).
+		Return([]datatypes.PersistedLocalSession{session}, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(session.Endpoint).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Ping(ctx, mock.Anything).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		RestoreLocalMATLABSession(mock.Anything, session).
+		Return(func() error {
+			sessionCleanupCalled = true
+			return nil
+		}).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Eval(mock.Anything, mock.Anything, entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	var storedClient matlabsessionstore.MATLABSessionClientWithCleanup
+	var storedInfo entities.MATLABSessionInfo
+	mockSessionStore.EXPECT().
+		Add(mock.Anything, mock.Anything).
+		Run(func(client matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) {
+			storedClient = client
+			storedInfo = info
+		}).
+		Return(entities.SessionID(1)).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	manager.RestoreMATLABSessions(ctx, mockLogger)
+	require.NoError(t, shutdown(), "Shutdown should wait for the sessions being restored")
+
+	// Assert
+	assert.Equal(t, entities.MATLABSessionInfo{
+		MATLABRoot:     session.MATLABRoot,
+		VMCRoot:        session.VMCRoot,
+		Release:        session.Release,
+		ProcessID:      session.ProcessID,
+		StartTime:      session.StartTime,
+		StartingFolder: session.StartingDirectory,
+	}, storedInfo)
+
+	require.NotNil(t, storedClient)
+	require.NoError(t, storedClient.StopSession(ctx, mockLogger))
+	assert.True(t, sessionCleanupCalled, "Stopping a restored session should remove its session directory")
+}
+
+func TestMATLABManager_RestoreMATLABSessions_StoppedSessionIsDiscarded(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t) // Implicit assertion here, Add should not be called
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	session := newPersistedSession()
+
+	mockMATLABServices.EXPECT().
+		FindPersistedLocalMATLABSessions(mockLogger.AsMockArg()).
+		Return([]datatypes.PersistedLocalSession{session}, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(session.Endpoint).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Ping(ctx, mock.Anything).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	mockMATLABServices.EXPECT().
+		DiscardLocalMATLABSession(mock.Anything, session).
+		Return(nil).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	manager.RestoreMATLABSessions(ctx, mockLogger)
+	require.NoError(t, shutdown(), "Shutdown should wait for the sessions being restored")
+
+	// Assert
+	_, found := mockLogger.InfoLogs()["MATLAB session left by an earlier server has stopped, removing its session directory"]
+	assert.True(t, found, "Expected a log about the stopped session")
+}
+
+func TestMATLABManager_RestoreMATLABSessions_ClientFactoryError(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	session := newPersistedSession()
+
+	mockMATLABServices.EXPECT().
+		FindPersistedLocalMATLABSessions(mockLogger.AsMockArg()).
+		Return([]datatypes.PersistedLocalSession{session}, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(session.Endpoint).
+		Return(nil, assert.AnError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	manager.RestoreMATLABSessions(ctx, mockLogger)
+	require.NoError(t, shutdown(), "Shutdown should wait for the sessions being restored")
+
+	// Assert
+	_, found := mockLogger.WarnLogs()["Failed to connect to MATLAB session left by an earlier server"]
+	assert.True(t, found, "Expected a warning about the failed connection")
+}
+
+func TestMATLABManager_RestoreMATLABSessions_FindError(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	var shutdown func() error
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			shutdown = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		FindPersistedLocalMATLABSessions(mockLogger.AsMockArg()).
+		Return(nil, assert.AnError).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	manager.RestoreMATLABSessions(ctx, mockLogger)
+	require.NoError(t, shutdown(), "Shutdown should wait for the sessions being restored")
+
+	// Assert
+	_, found := mockLogger.WarnLogs()["Failed to find MATLAB sessions left by an earlier server"]
+	assert.True(t, found, "Expected a warning about the failed search")
+}
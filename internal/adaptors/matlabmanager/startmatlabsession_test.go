@@ -5,13 +5,16 @@ package matlabmanager_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	sessionstoremocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager/matlabsessionstore"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -20,6 +23,8 @@ import (
 
 func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	// Arrange
+	ctx := t.Context()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockMATLABServices := &mocks.MockMATLABServices{}
@@ -31,13 +36,30 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	expectedSessionID := entities.SessionID(123)
 
-	connectionDetails := embeddedconnector.ConnectionDetails{
-		Host: "localhost",
-		Port: "1234",
+	expectedStartingFolder := filepath.Join("path", "to", "session")
+	localSession := datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host: "localhost",
+			Port: "1234",
+		},
+		ProcessID:         4321,
+		Release:           "R2023a",
+		StartingDirectory: expectedStartingFolder,
 	}
 
 	sessionCleanupFunc := func() error { return nil }
@@ -48,22 +70,30 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	}
 
 	mockMATLABServices.EXPECT().
-		StartLocalMATLABSession(mock.Anything, expectedLocalSessionDetails).
-		Return(connectionDetails, sessionCleanupFunc, nil).
+		StartLocalMATLABSession(ctx, mock.Anything, expectedLocalSessionDetails).
+		Return(localSession, sessionCleanupFunc, nil).
 		Once()
 
 	mockClientFactory.EXPECT().
-		New(connectionDetails).
+		New(localSession.Endpoint).
 		Return(mockSessionClient, nil).
 		Once()
 
+	var storedInfo entities.MATLABSessionInfo
 	mockSessionStore.EXPECT().
-		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup")).
+		Add(mock.AnythingOfType("*matlabmanager.matlabSessionClientWithCleanup"), mock.Anything).
+		Run(func(_ matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) {
+			storedInfo = info
+		}).
 		Return(expectedSessionID).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
-	ctx := t.Context()
+	mockWarmPool.EXPECT().
+		Take(mock.Anything, entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(nil, entities.MATLABSessionInfo{}, false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -76,10 +106,17 @@ func TestMATLABManager_StartMATLABSession_HappyPath(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 	assert.Equal(t, expectedSessionID, sessionID)
+	assert.Equal(t, expectedMATLABRoot, storedInfo.MATLABRoot)
+	assert.Equal(t, "R2023a", storedInfo.Release)
+	assert.Equal(t, 4321, storedInfo.ProcessID)
+	assert.Equal(t, expectedStartingFolder, storedInfo.StartingFolder)
+	assert.WithinDuration(t, time.Now(), storedInfo.StartTime, time.Minute)
 }
 
 func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	// Arrange
+	ctx := t.Context()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockMATLABServices := &mocks.MockMATLABServices{}
@@ -91,6 +128,17 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
 	expectedError := assert.AnError
 
@@ -100,12 +148,16 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 	}
 
 	mockMATLABServices.EXPECT().
-		StartLocalMATLABSession(mock.Anything, expectedLocalSessionDetails).
-		Return(embeddedconnector.ConnectionDetails{}, nil, expectedError).
+		StartLocalMATLABSession(ctx, mock.Anything, expectedLocalSessionDetails).
+		Return(datatypes.LocalSession{}, nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
-	ctx := t.Context()
+	mockWarmPool.EXPECT().
+		Take(mock.Anything, entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(nil, entities.MATLABSessionInfo{}, false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -122,6 +174,8 @@ func TestMATLABManager_StartMATLABSession_MATLABServicesError(t *testing.T) {
 
 func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	// Arrange
+	ctx := t.Context()
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockMATLABServices := &mocks.MockMATLABServices{}
@@ -133,10 +187,23 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
 	defer mockClientFactory.AssertExpectations(t)
 
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
 	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
-	connectionDetails := embeddedconnector.ConnectionDetails{
-		Host: "localhost",
-		Port: "12345",
+	localSession := datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host: "localhost",
+			Port: "12345",
+		},
 	}
 	sessionCleanupFunc := func() error { return nil }
 	expectedError := assert.AnError
@@ -147,17 +214,21 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	}
 
 	mockMATLABServices.EXPECT().
-		StartLocalMATLABSession(mock.Anything, expectedLocalSessionDetails).
-		Return(connectionDetails, sessionCleanupFunc, nil).
+		StartLocalMATLABSession(ctx, mock.Anything, expectedLocalSessionDetails).
+		Return(localSession, sessionCleanupFunc, nil).
 		Once()
 
 	mockClientFactory.EXPECT().
-		New(connectionDetails).
+		New(localSession.Endpoint).
 		Return(nil, expectedError).
 		Once()
 
-	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory)
-	ctx := t.Context()
+	mockWarmPool.EXPECT().
+		Take(mock.Anything, entities.LocalSessionDetails{MATLABRoot: expectedMATLABRoot}).
+		Return(nil, entities.MATLABSessionInfo{}, false).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
 
 	startRequest := entities.LocalSessionDetails{
 		MATLABRoot:             expectedMATLABRoot,
@@ -171,3 +242,64 @@ func TestMATLABManager_StartMATLABSession_ClientFactoryError(t *testing.T) {
 	require.ErrorIs(t, err, expectedError)
 	assert.Empty(t, sessionID)
 }
+
+func TestMATLABManager_StartMATLABSession_UsesWarmSession(t *testing.T) {
+	// Arrange
+	ctx := t.Context()
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockMATLABSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockWarmPool := &mocks.MockWarmSessionPool{}
+	defer mockWarmPool.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	mockWarmClient := &sessionstoremocks.MockMATLABSessionClientWithCleanup{}
+	defer mockWarmClient.AssertExpectations(t)
+
+	expectedMATLABRoot := filepath.Join("path", "to", "matlab", "R2023a")
+	expectedSessionID := entities.SessionID(7)
+
+	warmSessionInfo := entities.MATLABSessionInfo{
+		MATLABRoot: expectedMATLABRoot,
+		ProcessID:  4321,
+	}
+
+	startRequest := entities.LocalSessionDetails{
+		MATLABRoot: expectedMATLABRoot,
+	}
+
+	mockWarmPool.EXPECT().
+		Take(mock.Anything, startRequest).
+		Return(mockWarmClient, warmSessionInfo, true).
+		Once()
+
+	mockSessionStore.EXPECT().
+		Add(mockWarmClient, warmSessionInfo).
+		Return(expectedSessionID).
+		Once()
+
+	manager := matlabmanager.New(mockMATLABServices, mockSessionStore, mockClientFactory, mockWarmPool, mockLifecycleSignaler)
+
+	// Act
+	sessionID, err := manager.StartMATLABSession(ctx, mockLogger, startRequest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, sessionID)
+}
@@ -5,6 +5,7 @@ package matlabmanager
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
@@ -14,31 +15,72 @@ import (
 func (m *MATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	var zeroValue entities.SessionID
 	var client matlabsessionstore.MATLABSessionClientWithCleanup
+	var info entities.MATLABSessionInfo
 
 	switch request := startRequest.(type) {
 	case entities.LocalSessionDetails:
 		sessionLogger := sessionLogger.With("matlab-root", request.MATLABRoot)
-		// For now, we return embedded connector details, to decouple the session start logic from the client creation.
-		embeddedConnectorEndpoint, sessionCleanup, err := m.matlabServices.StartLocalMATLABSession(sessionLogger,
-			datatypes.LocalSessionDetails{
-				MATLABRoot:             request.MATLABRoot,
-				VMCRoot:                request.VMCRoot,
-				IsStartingDirectorySet: request.IsStartingDirectorySet,
-				StartingDirectory:      request.StartingDirectory,
-				ShowMATLABDesktop:      request.ShowMATLABDesktop,
-			},
-		)
+
+		warmClient, warmInfo, ok := m.warmPool.Take(sessionLogger, request)
+		if ok {
+			client, info = warmClient, warmInfo
+			break
+		}
+
+		coldClient, coldInfo, err := startLocalSession(ctx, sessionLogger, m.matlabServices, m.clientFactory, request)
 		if err != nil {
 			return zeroValue, err
 		}
-		embeddedConnectorClient, err := m.clientFactory.New(embeddedConnectorEndpoint)
+		client, info = coldClient, coldInfo
+	case entities.AttachedSessionDetails:
+		attachedClient, attachedInfo, err := attachToSession(sessionLogger, m.matlabServices, m.clientFactory, request)
 		if err != nil {
 			return zeroValue, err
 		}
-		client = newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup)
+		client, info = attachedClient, attachedInfo
 	default:
 		return zeroValue, fmt.Errorf("unknown request type: %T", request)
 	}
 
-	return m.sessionStore.Add(client), nil
+	return m.sessionStore.Add(client, info), nil
+}
+
+// startLocalSession starts a local MATLAB session, connects a client to it, and describes the session.
+// It is shared by cold starts and the warm pool, so that both produce identical sessions.
+func startLocalSession(
+	ctx context.Context,
+	sessionLogger entities.Logger,
+	matlabServices MATLABServices,
+	clientFactory MATLABSessionClientFactory,
+	request entities.LocalSessionDetails,
+) (matlabsessionstore.MATLABSessionClientWithCleanup, entities.MATLABSessionInfo, error) {
+	// For now, we return embedded connector details, to decouple the session start logic from the client creation.
+	localSession, sessionCleanup, err := matlabServices.StartLocalMATLABSession(ctx, sessionLogger,
+		datatypes.LocalSessionDetails{
+			MATLABRoot:             request.MATLABRoot,
+			VMCRoot:                request.VMCRoot,
+			IsStartingDirectorySet: request.IsStartingDirectorySet,
+			StartingDirectory:      request.StartingDirectory,
+			ShowMATLABDesktop:      request.ShowMATLABDesktop,
+		},
+	)
+	if err != nil {
+		return nil, entities.MATLABSessionInfo{}, err
+	}
+
+	embeddedConnectorClient, err := clientFactory.New(localSession.Endpoint)
+	if err != nil {
+		return nil, entities.MATLABSessionInfo{}, err
+	}
+
+	info := entities.MATLABSessionInfo{
+		MATLABRoot:     request.MATLABRoot,
+		VMCRoot:        request.VMCRoot,
+		Release:        localSession.Release,
+		ProcessID:      localSession.ProcessID,
+		StartTime:      time.Now(),
+		StartingFolder: localSession.StartingDirectory,
+	}
+
+	return newMATLABSessionClientWithCleanup(embeddedConnectorClient, sessionCleanup, localSession.ProcessMonitor), info, nil
 }
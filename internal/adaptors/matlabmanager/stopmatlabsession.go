@@ -4,13 +4,15 @@ package matlabmanager
 
 import (
 	"context"
+	"errors"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
 func (m *MATLABManager) StopMATLABSession(ctx context.Context, sessionLogger entities.Logger, sessionID entities.SessionID) error {
+	// A session whose MATLAB process exited can still be stopped, to clean it up.
 	client, err := m.sessionStore.Get(sessionID)
-	if err != nil {
+	if err != nil && !errors.Is(err, entities.ErrMATLABProcessExited) {
 		return err
 	}
 
@@ -0,0 +1,267 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"golang.org/x/sync/errgroup"
+)
+
+type WarmPoolConfig interface {
+	WarmPools() []entities.WarmPoolSize
+}
+
+type LifecycleSignaler interface {
+	AddShutdownFunction(shutdownFcn func() error)
+}
+
+// WarmPool keeps spare MATLAB sessions started in the background, one pool per MATLAB root,
+// so that StartMATLABSession can hand one out instead of waiting for MATLAB to start.
+type WarmPool struct {
+	matlabServices MATLABServices
+	clientFactory  MATLABSessionClientFactory
+
+	l        *sync.Mutex
+	pools    map[string]*rootPool
+	starting *sync.WaitGroup
+	logger   entities.Logger
+	ctx      context.Context
+	cancel   context.CancelFunc
+	started  bool
+	closed   bool
+}
+
+type rootPool struct {
+	size     entities.WarmPoolSize
+	target   int
+	starting int
+	spares   []warmSession
+}
+
+type warmSession struct {
+	client matlabsessionstore.MATLABSessionClientWithCleanup
+	info   entities.MATLABSessionInfo
+}
+
+func NewWarmPool(
+	config WarmPoolConfig,
+	lifecycleSignaler LifecycleSignaler,
+	matlabServices MATLABServices,
+	clientFactory MATLABSessionClientFactory,
+) *WarmPool {
+	pools := map[string]*rootPool{}
+	for _, size := range config.WarmPools() {
+		pools[size.MATLABRoot] = &rootPool{
+			size:   size,
+			target: size.Min,
+		}
+	}
+
+	warmPool := &WarmPool{
+		matlabServices: matlabServices,
+		clientFactory:  clientFactory,
+
+		l:        new(sync.Mutex),
+		pools:    pools,
+		starting: new(sync.WaitGroup),
+	}
+
+	lifecycleSignaler.AddShutdownFunction(warmPool.shutdown)
+
+	return warmPool
+}
+
+// Start fills every pool up to its minimum size in the background.
+// Sessions are only started once Start is called, so that the pools stay empty in single session mode.
+func (w *WarmPool) Start(ctx context.Context, logger entities.Logger) {
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	if w.started || w.closed {
+		return
+	}
+
+	w.started = true
+	w.logger = logger
+	w.ctx, w.cancel = context.WithCancel(ctx)
+
+	for _, pool := range w.pools {
+		logger.
+			With("matlab-root", pool.size.MATLABRoot).
+			With("min", pool.size.Min).
+			With("max", pool.size.Max).
+			Info("Starting warm pool of MATLAB sessions")
+		w.refill(pool)
+	}
+}
+
+// Take hands out a spare session started with the same details as the request, if one is ready.
+// Either way, the pool is refilled in the background, and grows towards its maximum size when it was found empty.
+func (w *WarmPool) Take(sessionLogger entities.Logger, request entities.LocalSessionDetails) (matlabsessionstore.MATLABSessionClientWithCleanup, entities.MATLABSessionInfo, bool) {
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	if !w.started || w.closed {
+		return nil, entities.MATLABSessionInfo{}, false
+	}
+
+	request.MATLABRoot = filepath.Clean(request.MATLABRoot)
+
+	pool, found := w.pools[request.MATLABRoot]
+	if !found {
+		return nil, entities.MATLABSessionInfo{}, false
+	}
+
+	if request != pool.sessionDetails() {
+		sessionLogger.Debug("Session options differ from the warm pool sessions, starting a new MATLAB session")
+		return nil, entities.MATLABSessionInfo{}, false
+	}
+
+	pool.dropExitedSpares(sessionLogger)
+
+	if len(pool.spares) == 0 {
+		if pool.target < pool.size.Max {
+			pool.target++
+		}
+		pool.logState(sessionLogger, "Warm pool is empty, starting a new MATLAB session")
+		w.refill(pool)
+		return nil, entities.MATLABSessionInfo{}, false
+	}
+
+	spare := pool.spares[0]
+	pool.spares = pool.spares[1:]
+
+	pool.logState(sessionLogger, "Using a MATLAB session from the warm pool")
+	w.refill(pool)
+
+	return spare.client, spare.info, true
+}
+
+// refill starts sessions until the spare and starting sessions of the pool reach its target.
+// It must be called with the lock held.
+func (w *WarmPool) refill(pool *rootPool) {
+	for len(pool.spares)+pool.starting < pool.target {
+		pool.starting++
+		w.starting.Add(1)
+		go w.startSpare(pool)
+	}
+}
+
+func (w *WarmPool) startSpare(pool *rootPool) {
+	defer w.starting.Done()
+
+	logger := w.logger.With("matlab-root", pool.size.MATLABRoot)
+	logger.Debug("Starting a MATLAB session for the warm pool")
+
+	client, info, err := startLocalSession(w.ctx, logger, w.matlabServices, w.clientFactory, pool.sessionDetails())
+
+	w.l.Lock()
+	pool.starting--
+
+	if err != nil {
+		w.l.Unlock()
+		logger.WithError(err).Warn("Failed to start a MATLAB session for the warm pool")
+		return
+	}
+
+	if w.closed {
+		w.l.Unlock()
+		if err := client.StopSession(context.Background(), logger); err != nil {
+			logger.WithError(err).Warn("Failed to stop a MATLAB session started for the warm pool during shutdown")
+		}
+		return
+	}
+
+	pool.spares = append(pool.spares, warmSession{client: client, info: info})
+	pool.logState(logger, "MATLAB session is ready in the warm pool")
+	w.l.Unlock()
+}
+
+func (w *WarmPool) shutdown() error {
+	w.l.Lock()
+	w.closed = true
+	if w.cancel != nil {
+		w.cancel()
+	}
+
+	spares := []warmSession{}
+	for _, pool := range w.pools {
+		spares = append(spares, pool.spares...)
+		pool.spares = nil
+	}
+	logger := w.logger
+	w.l.Unlock()
+
+	wg := new(errgroup.Group)
+	for _, spare := range spares {
+		wg.Go(func() error {
+			err := spare.client.StopSession(context.Background(), logger)
+			if err != nil {
+				return fmt.Errorf("error stopping warm pool session: %w", err)
+			}
+			return nil
+		})
+	}
+
+	err := wg.Wait()
+
+	// Sessions still starting stop themselves once they are ready, as the pool is closed.
+	w.starting.Wait()
+
+	return err
+}
+
+// dropExitedSpares removes the spare sessions whose MATLAB process exited while they waited, and cleans them up in the background.
+// It must be called with the lock held.
+func (p *rootPool) dropExitedSpares(logger entities.Logger) {
+	spares := p.spares[:0]
+	for _, spare := range p.spares {
+		if !hasExited(spare.client) {
+			spares = append(spares, spare)
+			continue
+		}
+
+		spareLogger := logger.With("pid", spare.info.ProcessID)
+		spareLogger.Warn("MATLAB session in the warm pool exited unexpectedly, discarding it")
+		go func() {
+			if err := spare.client.StopSession(context.Background(), spareLogger); err != nil {
+				spareLogger.WithError(err).Warn("Failed to clean up an exited MATLAB session from the warm pool")
+			}
+		}()
+	}
+	p.spares = spares
+}
+
+func hasExited(client matlabsessionstore.MATLABSessionClientWithCleanup) bool {
+	supervisedClient, ok := client.(matlabsessionstore.SupervisedMATLABSessionClient)
+	if !ok {
+		return false
+	}
+
+	select {
+	case <-supervisedClient.Exited():
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *rootPool) sessionDetails() entities.LocalSessionDetails {
+	return entities.LocalSessionDetails{
+		MATLABRoot: p.size.MATLABRoot,
+	}
+}
+
+func (p *rootPool) logState(logger entities.Logger, message string) {
+	logger.
+		With("spare", len(p.spares)).
+		With("starting", p.starting).
+		With("target", p.target).
+		Info(message)
+}
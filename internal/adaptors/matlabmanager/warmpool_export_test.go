@@ -0,0 +1,36 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager
+
+// SpareSessionCount returns the number of spare sessions ready in the pool of a MATLAB root.
+func (w *WarmPool) SpareSessionCount(matlabRoot string) int {
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	pool, found := w.pools[matlabRoot]
+	if !found {
+		return 0
+	}
+
+	return len(pool.spares)
+}
+
+// ExitedSpareSessionCount returns the number of spare sessions in the pool of a MATLAB root whose MATLAB process exited.
+func (w *WarmPool) ExitedSpareSessionCount(matlabRoot string) int {
+	w.l.Lock()
+	defer w.l.Unlock()
+
+	pool, found := w.pools[matlabRoot]
+	if !found {
+		return 0
+	}
+
+	count := 0
+	for _, spare := range pool.spares {
+		if hasExited(spare.client) {
+			count++
+		}
+	}
+
+	return count
+}
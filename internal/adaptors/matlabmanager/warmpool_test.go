@@ -0,0 +1,516 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package matlabmanager_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/matlabmanager"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const warmPoolWaitTimeout = 5 * time.Second
+const warmPoolPollInterval = 10 * time.Millisecond
+
+func TestNewWarmPool_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{}).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	// Act
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+
+	// Assert
+	assert.NotNil(t, warmPool)
+}
+
+func TestWarmPool_Take_HandsOutSessionStartedInBackground(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2025a")
+	localSession := datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host: "localhost",
+			Port: "1234",
+		},
+		ProcessID: 4321,
+		Release:   "R2025a",
+	}
+	refillStarted := make(chan struct{})
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: matlabRoot, Min: 1, Max: 1}}).
+		Once()
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(localSession, func() error { return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(localSession.Endpoint).
+		Return(mockSessionClient, nil).
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+	warmPool.Start(ctx, mockLogger)
+
+	require.Eventually(t, func() bool {
+		return warmPool.SpareSessionCount(matlabRoot) == 1
+	}, warmPoolWaitTimeout, warmPoolPollInterval)
+
+	// Handing out the spare session refills the pool
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, _ datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error) {
+			close(refillStarted)
+			return datatypes.LocalSession{}, nil, assert.AnError
+		}).
+		Once()
+
+	// Act
+	client, info, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: matlabRoot})
+
+	// Assert
+	require.True(t, ok, "A spare session should be handed out")
+	require.NotNil(t, client)
+	assert.Equal(t, matlabRoot, info.MATLABRoot)
+	assert.Equal(t, 4321, info.ProcessID)
+	assert.Equal(t, "R2025a", info.Release)
+	assert.Equal(t, 0, warmPool.SpareSessionCount(matlabRoot))
+
+	select {
+	case <-refillStarted:
+	case <-time.After(warmPoolWaitTimeout):
+		t.Fatal("The pool should be refilled after handing out a session")
+	}
+
+	// Wait for the refill to complete before inspecting logs
+	require.NoError(t, capturedShutdownFunc())
+
+	_, found := mockLogger.InfoLogs()["Using a MATLAB session from the warm pool"]
+	assert.True(t, found, "Handing out a session should be logged")
+}
+
+func TestWarmPool_Take_DiscardsExitedSpareSessions(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2025a")
+	processMonitor := entities.NewMATLABProcessMonitor()
+	localSession := datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host: "localhost",
+			Port: "1234",
+		},
+		ProcessID:      4321,
+		Release:        "R2025a",
+		ProcessMonitor: processMonitor,
+	}
+	cleanedUp := make(chan struct{})
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: matlabRoot, Min: 1, Max: 1}}).
+		Once()
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(localSession, func() error { close(cleanedUp); return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(localSession.Endpoint).
+		Return(mockSessionClient, nil).
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+	warmPool.Start(ctx, mockLogger)
+
+	require.Eventually(t, func() bool {
+		return warmPool.SpareSessionCount(matlabRoot) == 1
+	}, warmPoolWaitTimeout, warmPoolPollInterval)
+
+	processMonitor.Exited(entities.MATLABProcessExit{ProcessID: localSession.ProcessID, ExitCode: 1})
+
+	require.Eventually(t, func() bool {
+		return warmPool.ExitedSpareSessionCount(matlabRoot) == 1
+	}, warmPoolWaitTimeout, warmPoolPollInterval)
+
+	// Discarding the exited session refills the pool
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(datatypes.LocalSession{}, nil, assert.AnError).
+		Once()
+
+	// Act
+	client, _, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: matlabRoot})
+
+	// Assert
+	require.False(t, ok, "An exited spare session should not be handed out")
+	assert.Nil(t, client)
+	assert.Equal(t, 0, warmPool.SpareSessionCount(matlabRoot))
+
+	select {
+	case <-cleanedUp:
+	case <-time.After(warmPoolWaitTimeout):
+		t.Fatal("The exited spare session should be cleaned up")
+	}
+
+	require.NoError(t, capturedShutdownFunc())
+
+	_, found := mockLogger.WarnLogs()["MATLAB session in the warm pool exited unexpectedly, discarding it"]
+	assert.True(t, found, "Discarding an exited session should be logged")
+}
+
+func TestWarmPool_Take_EmptyPoolGrowsUpToMax(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2025a")
+	spareStarted := make(chan struct{}, 1)
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: matlabRoot, Min: 0, Max: 1}}).
+		Once()
+
+	var capturedShutdownFunc func() error
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		RunAndReturn(func(_ context.Context, _ entities.Logger, _ datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error) {
+			spareStarted <- struct{}{}
+			return datatypes.LocalSession{}, nil, assert.AnError
+		}).
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+	warmPool.Start(ctx, mockLogger)
+
+	// Act
+	client, _, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: matlabRoot})
+
+	// Assert
+	assert.False(t, ok, "An empty pool should not hand out a session")
+	assert.Nil(t, client)
+
+	select {
+	case <-spareStarted:
+	case <-time.After(warmPoolWaitTimeout):
+		t.Fatal("An empty pool should grow and start a spare session")
+	}
+
+	// Wait for the failed start to complete before inspecting logs
+	require.NoError(t, capturedShutdownFunc())
+
+	_, found := mockLogger.WarnLogs()["Failed to start a MATLAB session for the warm pool"]
+	assert.True(t, found, "Failing to start a spare session should be logged")
+}
+
+func TestWarmPool_Take_DifferentOptionsAreNotServed(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2025a")
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: matlabRoot, Min: 0, Max: 1}}).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+	warmPool.Start(ctx, mockLogger)
+
+	// Act
+	client, _, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: matlabRoot, ShowMATLABDesktop: true})
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, client)
+}
+
+func TestWarmPool_Take_UnknownMATLABRoot(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: filepath.Join("path", "to", "matlab", "R2025a"), Min: 0, Max: 1}}).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+	warmPool.Start(ctx, mockLogger)
+
+	// Act
+	client, _, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: filepath.Join("path", "to", "matlab", "R2024b")})
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, client)
+}
+
+func TestWarmPool_Take_BeforeStart(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2025a")
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: matlabRoot, Min: 1, Max: 1}}).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Return().
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+
+	// Act
+	client, _, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: matlabRoot})
+
+	// Assert
+	assert.False(t, ok, "No session should be started before the pool is started")
+	assert.Nil(t, client)
+}
+
+func TestWarmPool_Shutdown_StopsSpareSessions(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockWarmPoolConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockMATLABServices := &mocks.MockMATLABServices{}
+	defer mockMATLABServices.AssertExpectations(t)
+
+	mockClientFactory := &mocks.MockMATLABSessionClientFactory{}
+	defer mockClientFactory.AssertExpectations(t)
+
+	mockSessionClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockSessionClient.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	matlabRoot := filepath.Join("path", "to", "matlab", "R2025a")
+	localSession := datatypes.LocalSession{
+		Endpoint: embeddedconnector.ConnectionDetails{
+			Host: "localhost",
+			Port: "1234",
+		},
+		ProcessID: 4321,
+		Release:   "R2025a",
+	}
+	cleanupCalled := false
+
+	var capturedShutdownFunc func() error
+
+	mockConfig.EXPECT().
+		WarmPools().
+		Return([]entities.WarmPoolSize{{MATLABRoot: matlabRoot, Min: 1, Max: 1}}).
+		Once()
+
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFunc = shutdownFcn
+		}).
+		Return().
+		Once()
+
+	mockMATLABServices.EXPECT().
+		StartLocalMATLABSession(mock.Anything, mock.Anything, datatypes.LocalSessionDetails{MATLABRoot: matlabRoot}).
+		Return(localSession, func() error { cleanupCalled = true; return nil }, nil).
+		Once()
+
+	mockClientFactory.EXPECT().
+		New(localSession.Endpoint).
+		Return(mockSessionClient, nil).
+		Once()
+
+	mockSessionClient.EXPECT().
+		Eval(mock.Anything, mockLogger.AsMockArg(), entities.EvalRequest{Code: "exit()"}).
+		Return(entities.EvalResponse{}, nil).
+		Once()
+
+	warmPool := matlabmanager.NewWarmPool(mockConfig, mockLifecycleSignaler, mockMATLABServices, mockClientFactory)
+	require.NotNil(t, capturedShutdownFunc)
+
+	warmPool.Start(ctx, mockLogger)
+
+	require.Eventually(t, func() bool {
+		return warmPool.SpareSessionCount(matlabRoot) == 1
+	}, warmPoolWaitTimeout, warmPoolPollInterval)
+
+	// Act
+	err := capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, cleanupCalled, "Spare sessions should be cleaned up on shutdown")
+	assert.Equal(t, 0, warmPool.SpareSessionCount(matlabRoot))
+
+	// Act
+	client, _, ok := warmPool.Take(mockLogger, entities.LocalSessionDetails{MATLABRoot: matlabRoot})
+
+	// Assert
+	assert.False(t, ok, "A pool that is shut down should not hand out sessions")
+	assert.Nil(t, client)
+}
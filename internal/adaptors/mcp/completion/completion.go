@@ -0,0 +1,240 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MCP only lets clients ask for completions of prompt arguments and resource template variables,
+// so suggestions are chosen by argument name, whatever the reference.
+// blocks, session_id and matlab_root are arguments of the Vitis Model Composer prompts,
+// and category and block are the variables of the block help resource template.
+const (
+	blocksArgument     = "blocks"
+	blockArgument      = "block"
+	categoryArgument   = "category"
+	sessionIDArgument  = "session_id"
+	matlabRootArgument = "matlab_root"
+)
+
+const (
+	// maxValues is the maximum number of values in a completion result, as set by the MCP specification.
+	maxValues = 100
+
+	// matlabRootsCacheDuration bounds how long discovered MATLAB roots are reused,
+	// so that completing as the user types does not walk the file system on every keystroke.
+	matlabRootsCacheDuration = 30 * time.Second
+)
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type SessionStore interface {
+	SessionIDs() []entities.SessionID
+}
+
+type MATLABLocator interface {
+	ListDiscoveredMatlabInfo(logger entities.Logger) datatypes.ListMatlabInfo
+}
+
+type Completer struct {
+	loggerFactory LoggerFactory
+	sessionStore  SessionStore
+	matlabLocator MATLABLocator
+	listBlocks    func() ([]vmcblockhelp.Block, error)
+
+	blocksLock sync.Mutex
+	blocks     []vmcblockhelp.Block
+
+	matlabRootsLock     sync.Mutex
+	matlabRoots         []string
+	matlabRootsExpireAt time.Time
+}
+
+func New(
+	loggerFactory LoggerFactory,
+	sessionStore SessionStore,
+	matlabLocator MATLABLocator,
+) *Completer {
+	return &Completer{
+		loggerFactory: loggerFactory,
+		sessionStore:  sessionStore,
+		matlabLocator: matlabLocator,
+		listBlocks:    vmcblockhelp.Blocks,
+	}
+}
+
+// Complete handles completion/complete requests. It only reads in-memory state, the embedded block help and the file system, never MATLAB.
+func (c *Completer) Complete(_ context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error) {
+	argument := req.Params.Argument
+
+	logger := c.loggerFactory.NewMCPSessionLogger(req.Session).With("argument", argument.Name)
+	logger.Debug("Handling completion request")
+
+	var resolvedArguments map[string]string
+	if req.Params.Context != nil {
+		resolvedArguments = req.Params.Context.Arguments
+	}
+
+	var values []string
+	switch argument.Name {
+	case blocksArgument:
+		values = c.completeBlockList(logger, argument.Value)
+	case blockArgument:
+		values = match(c.blockIDs(logger, resolvedArguments[categoryArgument]), argument.Value)
+	case categoryArgument:
+		values = match(categories(), argument.Value)
+	case sessionIDArgument:
+		values = match(c.sessionIDs(), argument.Value)
+	case matlabRootArgument:
+		values = match(c.discoveredMATLABRoots(logger), argument.Value)
+	default:
+		logger.Debug("No completions for argument")
+	}
+
+	return result(values), nil
+}
+
+// completeBlockList completes the last block of a comma-separated list of blocks.
+func (c *Completer) completeBlockList(logger entities.Logger, value string) []string {
+	prefix := ""
+	last := value
+	if i := strings.LastIndex(value, ","); i >= 0 {
+		prefix = strings.TrimSpace(value[:i+1]) + " "
+		last = value[i+1:]
+	}
+
+	values := match(c.blockTitles(logger), last)
+	for i, v := range values {
+		values[i] = prefix + v
+	}
+
+	return values
+}
+
+func (c *Completer) blockTitles(logger entities.Logger) []string {
+	var titles []string
+	for _, block := range c.embeddedBlocks(logger) {
+		titles = append(titles, block.Title)
+	}
+	return titles
+}
+
+func (c *Completer) blockIDs(logger entities.Logger, category string) []string {
+	var ids []string
+	for _, block := range c.embeddedBlocks(logger) {
+		if category == "" || strings.EqualFold(block.Category, category) {
+			ids = append(ids, block.ID)
+		}
+	}
+	return ids
+}
+
+// embeddedBlocks lists the embedded block help pages once they could be read, and tries again on the next request otherwise.
+func (c *Completer) embeddedBlocks(logger entities.Logger) []vmcblockhelp.Block {
+	c.blocksLock.Lock()
+	defer c.blocksLock.Unlock()
+
+	if c.blocks != nil {
+		return c.blocks
+	}
+
+	blocks, err := c.listBlocks()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to list embedded block help for completion")
+		return nil
+	}
+	c.blocks = blocks
+
+	return c.blocks
+}
+
+func (c *Completer) sessionIDs() []string {
+	sessionIDs := c.sessionStore.SessionIDs()
+
+	values := make([]string, len(sessionIDs))
+	for i, sessionID := range sessionIDs {
+		values[i] = strconv.Itoa(int(sessionID))
+	}
+	return values
+}
+
+func (c *Completer) discoveredMATLABRoots(logger entities.Logger) []string {
+	c.matlabRootsLock.Lock()
+	defer c.matlabRootsLock.Unlock()
+
+	if time.Now().Before(c.matlabRootsExpireAt) {
+		return slices.Clone(c.matlabRoots)
+	}
+
+	matlabInfos := c.matlabLocator.ListDiscoveredMatlabInfo(logger)
+
+	matlabRoots := make([]string, 0, len(matlabInfos.MatlabInfo))
+	for _, matlabInfo := range matlabInfos.MatlabInfo {
+		matlabRoots = append(matlabRoots, matlabInfo.Location)
+	}
+
+	c.matlabRoots = matlabRoots
+	c.matlabRootsExpireAt = time.Now().Add(matlabRootsCacheDuration)
+
+	return slices.Clone(matlabRoots)
+}
+
+func categories() []string {
+	values := make([]string, len(vmcblockhelp.Categories))
+	for i, category := range vmcblockhelp.Categories {
+		values[i] = strings.ToLower(category)
+	}
+	return values
+}
+
+// match returns the candidates that start with value, followed by those that only contain it.
+// Matching is case-insensitive, and the order of the candidates is otherwise kept.
+func match(candidates []string, value string) []string {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	var prefixMatches, containsMatches []string
+	for _, candidate := range candidates {
+		lowerCandidate := strings.ToLower(candidate)
+		switch {
+		case strings.HasPrefix(lowerCandidate, value):
+			prefixMatches = append(prefixMatches, candidate)
+		case strings.Contains(lowerCandidate, value):
+			containsMatches = append(containsMatches, candidate)
+		}
+	}
+
+	return append(prefixMatches, containsMatches...)
+}
+
+func result(values []string) *mcp.CompleteResult {
+	total := len(values)
+	hasMore := total > maxValues
+	if hasMore {
+		values = values[:maxValues]
+	}
+
+	if values == nil {
+		values = []string{}
+	}
+
+	return &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{
+			Values:  values,
+			Total:   total,
+			HasMore: hasMore,
+		},
+	}
+}
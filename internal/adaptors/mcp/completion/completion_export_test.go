@@ -0,0 +1,9 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+
+func (c *Completer) SetBlockLister(listBlocks func() ([]vmcblockhelp.Block, error)) {
+	c.listBlocks = listBlocks
+}
@@ -0,0 +1,299 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package completion_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/completion"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockMATLABLocator := &mocks.MockMATLABLocator{}
+	defer mockMATLABLocator.AssertExpectations(t)
+
+	// Act
+	completer := completion.New(mockLoggerFactory, mockSessionStore, mockMATLABLocator)
+
+	// Assert
+	assert.NotNil(t, completer)
+}
+
+func TestCompleter_Complete_BlockNames(t *testing.T) {
+	tests := []struct {
+		name           string
+		argumentName   string
+		value          string
+		arguments      map[string]string
+		expectedValues []string
+	}{
+		{
+			name:           "blocks prefix",
+			argumentName:   "blocks",
+			value:          "gate",
+			expectedValues: []string{"Gateway In", "Gateway Out"},
+		},
+		{
+			name:           "blocks substring",
+			argumentName:   "blocks",
+			value:          "kernel",
+			expectedValues: []string{"Vitis HLS Kernel"},
+		},
+		{
+			name:           "blocks completes the last block of the list",
+			argumentName:   "blocks",
+			value:          "Gateway In,gateway o",
+			expectedValues: []string{"Gateway In, Gateway Out"},
+		},
+		{
+			name:           "block of a resource template category",
+			argumentName:   "block",
+			value:          "",
+			arguments:      map[string]string{"category": "hdl"},
+			expectedValues: []string{"gateway_in", "gateway_out"},
+		},
+		{
+			name:           "category",
+			argumentName:   "category",
+			value:          "hl",
+			expectedValues: []string{"hls"},
+		},
+		{
+			name:           "unknown argument",
+			argumentName:   "model_path",
+			value:          "1",
+			expectedValues: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockLoggerFactory := &mocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			mockSessionStore := &mocks.MockSessionStore{}
+			defer mockSessionStore.AssertExpectations(t)
+
+			mockMATLABLocator := &mocks.MockMATLABLocator{}
+			defer mockMATLABLocator.AssertExpectations(t)
+
+			mockLoggerFactory.EXPECT().
+				NewMCPSessionLogger(mock.Anything).
+				Return(mockLogger).
+				Once()
+
+			completer := completion.New(mockLoggerFactory, mockSessionStore, mockMATLABLocator)
+
+			// Act
+			result, err := completer.Complete(t.Context(), newCompleteRequest(tt.argumentName, tt.value, tt.arguments))
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedValues, result.Completion.Values)
+			assert.Equal(t, len(tt.expectedValues), result.Completion.Total)
+			assert.False(t, result.Completion.HasMore)
+		})
+	}
+}
+
+func TestCompleter_Complete_RetriesFailedBlockListing(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockMATLABLocator := &mocks.MockMATLABLocator{}
+	defer mockMATLABLocator.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Times(3)
+
+	listings := 0
+	completer := completion.New(mockLoggerFactory, mockSessionStore, mockMATLABLocator)
+	completer.SetBlockLister(func() ([]vmcblockhelp.Block, error) {
+		listings++
+		if listings == 1 {
+			return nil, assert.AnError
+		}
+		return []vmcblockhelp.Block{{Title: "Gateway In"}}, nil
+	})
+
+	// Act
+	failedResult, err := completer.Complete(t.Context(), newCompleteRequest("blocks", "gate", nil))
+	require.NoError(t, err)
+
+	retriedResult, err := completer.Complete(t.Context(), newCompleteRequest("blocks", "gate", nil))
+	require.NoError(t, err)
+
+	cachedResult, err := completer.Complete(t.Context(), newCompleteRequest("blocks", "gate", nil))
+	require.NoError(t, err)
+
+	// Assert
+	assert.Empty(t, failedResult.Completion.Values)
+	assert.Equal(t, []string{"Gateway In"}, retriedResult.Completion.Values)
+	assert.Equal(t, []string{"Gateway In"}, cachedResult.Completion.Values)
+	assert.Equal(t, 2, listings, "Blocks should be listed again after a failure, and then reused")
+}
+
+func TestCompleter_Complete_SessionIDs(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockMATLABLocator := &mocks.MockMATLABLocator{}
+	defer mockMATLABLocator.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	mockSessionStore.EXPECT().
+		SessionIDs().
+		Return([]entities.SessionID{1, 2, 12}).
+		Once()
+
+	completer := completion.New(mockLoggerFactory, mockSessionStore, mockMATLABLocator)
+
+	// Act
+	result, err := completer.Complete(t.Context(), newCompleteRequest("session_id", "1", nil))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "12"}, result.Completion.Values)
+}
+
+func TestCompleter_Complete_MATLABRootsAreCached(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockMATLABLocator := &mocks.MockMATLABLocator{}
+	defer mockMATLABLocator.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Twice()
+
+	mockMATLABLocator.EXPECT().
+		ListDiscoveredMatlabInfo(mockLogger.AsMockArg()).
+		Return(datatypes.ListMatlabInfo{
+			MatlabInfo: []datatypes.MatlabInfo{
+				{Location: "/usr/local/MATLAB/R2024b"},
+				{Location: "/usr/local/MATLAB/R2025a"},
+			},
+		}).
+		Once()
+
+	completer := completion.New(mockLoggerFactory, mockSessionStore, mockMATLABLocator)
+
+	// Act
+	firstResult, err := completer.Complete(t.Context(), newCompleteRequest("matlab_root", "", nil))
+	require.NoError(t, err)
+
+	secondResult, err := completer.Complete(t.Context(), newCompleteRequest("matlab_root", "/usr/local/MATLAB/R2025", nil))
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"/usr/local/MATLAB/R2024b", "/usr/local/MATLAB/R2025a"}, firstResult.Completion.Values)
+	assert.Equal(t, []string{"/usr/local/MATLAB/R2025a"}, secondResult.Completion.Values)
+}
+
+func TestCompleter_Complete_LimitsNumberOfValues(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSessionStore := &mocks.MockSessionStore{}
+	defer mockSessionStore.AssertExpectations(t)
+
+	mockMATLABLocator := &mocks.MockMATLABLocator{}
+	defer mockMATLABLocator.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	blocks := make([]vmcblockhelp.Block, 150)
+	for i := range blocks {
+		blocks[i] = vmcblockhelp.Block{Title: fmt.Sprintf("Block %d", i+1)}
+	}
+
+	completer := completion.New(mockLoggerFactory, mockSessionStore, mockMATLABLocator)
+	completer.SetBlockLister(func() ([]vmcblockhelp.Block, error) {
+		return blocks, nil
+	})
+
+	// Act
+	result, err := completer.Complete(t.Context(), newCompleteRequest("blocks", "", nil))
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, result.Completion.Values, 100)
+	assert.Equal(t, 150, result.Completion.Total)
+	assert.True(t, result.Completion.HasMore)
+	assert.Equal(t, "Block 1", result.Completion.Values[0])
+}
+
+func newCompleteRequest(argumentName string, value string, arguments map[string]string) *mcp.CompleteRequest {
+	params := &mcp.CompleteParams{
+		Ref: &mcp.CompleteReference{
+			Type: "ref/prompt",
+			Name: "test_prompt",
+		},
+		Argument: mcp.CompleteParamsArgument{
+			Name:  argumentName,
+			Value: value,
+		},
+	}
+
+	if arguments != nil {
+		params.Context = &mcp.CompleteContext{
+			Arguments: arguments,
+		}
+	}
+
+	return &mcp.CompleteRequest{
+		Params: params,
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseprompt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const UnexpectedErrorPrefix = "unexpected error occurred: "
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleAssistant Role = "assistant"
+)
+
+type Argument struct {
+	Name        string
+	Title       string
+	Description string
+	Required    bool
+}
+
+// EmbeddedResource is the content of a server resource, included in a prompt so that the agent doesn't need to read it separately.
+type EmbeddedResource struct {
+	URI      string
+	MIMEType string
+	Text     string
+}
+
+// Message is a single prompt message. Either Text or Resource is set.
+type Message struct {
+	Role     Role
+	Text     string
+	Resource *EmbeddedResource
+}
+
+type GetPromptResult struct {
+	Description string
+	Messages    []Message
+}
+
+type PromptHandler func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*GetPromptResult, error)
+
+func New(
+	name string,
+	title string,
+	description string,
+	arguments []Argument,
+	loggerFactory LoggerFactory,
+	handler PromptHandler,
+) (*Prompt, error) {
+	if err := validateArguments(arguments); err != nil {
+		return nil, err
+	}
+
+	return &Prompt{
+		name:          name,
+		title:         title,
+		description:   description,
+		arguments:     arguments,
+		loggerFactory: loggerFactory,
+		handler:       handler,
+	}, nil
+}
+
+type Prompt struct {
+	name          string
+	title         string
+	description   string
+	arguments     []Argument
+	loggerFactory LoggerFactory
+	handler       PromptHandler
+}
+
+func (p *Prompt) AddToServer(server prompts.Server) {
+	mcpArguments := make([]*mcp.PromptArgument, len(p.arguments))
+	for i, argument := range p.arguments {
+		mcpArguments[i] = &mcp.PromptArgument{
+			Name:        argument.Name,
+			Title:       argument.Title,
+			Description: argument.Description,
+			Required:    argument.Required,
+		}
+	}
+
+	server.AddPrompt(
+		&mcp.Prompt{
+			Name:        p.name,
+			Title:       p.title,
+			Description: p.description,
+			Arguments:   mcpArguments,
+		},
+		p.promptHandler(),
+	)
+}
+
+func (p *Prompt) promptHandler() mcp.PromptHandler {
+	return func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		logger := p.loggerFactory.NewMCPSessionLogger(req.Session).With("prompt-name", p.name)
+		logger.Debug("Handling prompt request")
+		defer logger.Debug("Handled prompt request")
+
+		if p.handler == nil {
+			err := fmt.Errorf(UnexpectedErrorPrefix + "no prompt handler available")
+			logger.WithError(err).Warn("Prompt handler is nil")
+			return nil, err
+		}
+
+		arguments := req.Params.Arguments
+		if arguments == nil {
+			arguments = map[string]string{}
+		}
+
+		for _, argument := range p.arguments {
+			if argument.Required && strings.TrimSpace(arguments[argument.Name]) == "" {
+				err := fmt.Errorf("missing required argument: %s", argument.Name)
+				logger.WithError(err).Warn("Prompt request is missing a required argument")
+				return nil, err
+			}
+		}
+
+		result, err := p.handler(ctx, logger, arguments)
+		if err != nil {
+			logger.WithError(err).Warn("Prompt handler returned an error")
+			return nil, err
+		}
+
+		mcpMessages := make([]*mcp.PromptMessage, len(result.Messages))
+		for i, message := range result.Messages {
+			var content mcp.Content = &mcp.TextContent{
+				Text: message.Text,
+			}
+			if message.Resource != nil {
+				content = &mcp.EmbeddedResource{
+					Resource: &mcp.ResourceContents{
+						URI:      message.Resource.URI,
+						MIMEType: message.Resource.MIMEType,
+						Text:     message.Resource.Text,
+					},
+				}
+			}
+
+			mcpMessages[i] = &mcp.PromptMessage{
+				Role:    mcp.Role(message.Role),
+				Content: content,
+			}
+		}
+
+		return &mcp.GetPromptResult{
+			Description: result.Description,
+			Messages:    mcpMessages,
+		}, nil
+	}
+}
+
+func (p *Prompt) Name() string {
+	return p.name
+}
+
+func (p *Prompt) Title() string {
+	return p.title
+}
+
+func (p *Prompt) Description() string {
+	return p.description
+}
+
+func (p *Prompt) Arguments() []Argument {
+	return p.arguments
+}
+
+// ParseTemplate parses an embedded prompt template.
+// Arguments that were not provided render as empty strings, so templates can test for them with `{{if .name}}`.
+func ParseTemplate(name string, text string) *template.Template {
+	return template.Must(template.New(name).Option("missingkey=zero").Parse(text))
+}
+
+// Render renders a prompt template with the prompt arguments.
+func Render(promptTemplate *template.Template, arguments map[string]string) (string, error) {
+	var rendered strings.Builder
+	if err := promptTemplate.Execute(&rendered, arguments); err != nil {
+		return "", fmt.Errorf("failed to render prompt %q: %w", promptTemplate.Name(), err)
+	}
+	return rendered.String(), nil
+}
+
+// SplitList splits a comma-separated argument value, dropping empty entries.
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func validateArguments(arguments []Argument) error {
+	seen := make(map[string]struct{}, len(arguments))
+	for _, argument := range arguments {
+		if argument.Name == "" {
+			return fmt.Errorf("invalid prompt argument: empty name")
+		}
+		if _, found := seen[argument.Name]; found {
+			return fmt.Errorf("invalid prompt argument %q: duplicate name", argument.Name)
+		}
+		seen[argument.Name] = struct{}{}
+	}
+	return nil
+}
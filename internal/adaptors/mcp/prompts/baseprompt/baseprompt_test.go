@@ -0,0 +1,318 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseprompt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_prompt"
+		title       = "Test Prompt"
+		description = "A test prompt"
+	)
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	arguments := []baseprompt.Argument{
+		{Name: "first", Required: true},
+		{Name: "second"},
+	}
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		return &baseprompt.GetPromptResult{}, nil
+	}
+
+	// Act
+	p, err := baseprompt.New(name, title, description, arguments, mockLoggerFactory, handler)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+	assert.Equal(t, name, p.Name())
+	assert.Equal(t, title, p.Title())
+	assert.Equal(t, description, p.Description())
+	assert.Equal(t, arguments, p.Arguments())
+}
+
+func TestNew_InvalidArguments(t *testing.T) {
+	tests := []struct {
+		name             string
+		arguments        []baseprompt.Argument
+		expectedErrorMsg string
+	}{
+		{
+			name:             "empty name",
+			arguments:        []baseprompt.Argument{{Name: ""}},
+			expectedErrorMsg: "empty name",
+		},
+		{
+			name:             "duplicate name",
+			arguments:        []baseprompt.Argument{{Name: "first"}, {Name: "first"}},
+			expectedErrorMsg: "duplicate name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			// Act
+			p, err := baseprompt.New("test_prompt", "Test Prompt", "A test prompt", tt.arguments, mockLoggerFactory, nil)
+
+			// Assert
+			require.ErrorContains(t, err, tt.expectedErrorMsg)
+			assert.Nil(t, p)
+		})
+	}
+}
+
+func TestPrompt_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	const (
+		name        = "test_prompt"
+		title       = "Test Prompt"
+		description = "A test prompt"
+	)
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	p, err := baseprompt.New(name, title, description, []baseprompt.Argument{
+		{Name: "first", Title: "First", Description: "The first argument", Required: true},
+	}, mockLoggerFactory, nil)
+	require.NoError(t, err)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddPrompt(
+		&mcp.Prompt{
+			Name:        name,
+			Title:       title,
+			Description: description,
+			Arguments: []*mcp.PromptArgument{
+				{Name: "first", Title: "First", Description: "The first argument", Required: true},
+			},
+		},
+		mock.AnythingOfType("mcp.PromptHandler"),
+	).Return()
+
+	// Act
+	p.AddToServer(mockServer)
+
+	// Assert
+}
+
+func capturePromptHandler(t *testing.T, p *baseprompt.Prompt) mcp.PromptHandler {
+	t.Helper()
+
+	var capturedHandler mcp.PromptHandler
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddPrompt(
+		mock.Anything,
+		mock.AnythingOfType("mcp.PromptHandler"),
+	).Run(func(prompt *mcp.Prompt, h mcp.PromptHandler) {
+		capturedHandler = h
+	}).Return()
+
+	p.AddToServer(mockServer)
+
+	return capturedHandler
+}
+
+func TestPrompt_PromptHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	expectedArguments := map[string]string{"first": "value"}
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		assert.Equal(t, expectedArguments, arguments)
+		return &baseprompt.GetPromptResult{
+			Description: "A rendered prompt",
+			Messages: []baseprompt.Message{
+				{Role: baseprompt.RoleUser, Text: "Do something"},
+				{Role: baseprompt.RoleUser, Resource: &baseprompt.EmbeddedResource{URI: "test://resource", MIMEType: "text/markdown", Text: "context"}},
+			},
+		}, nil
+	}
+
+	p, err := baseprompt.New("test_prompt", "Test Prompt", "A test prompt", []baseprompt.Argument{{Name: "first", Required: true}}, mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name:      "test_prompt",
+			Arguments: expectedArguments,
+		},
+	})
+
+	// Assert
+	require.NoError(t, handlerErr)
+	assert.Equal(t, "A rendered prompt", result.Description)
+	require.Len(t, result.Messages, 2)
+
+	assert.Equal(t, mcp.Role("user"), result.Messages[0].Role)
+	assert.Equal(t, &mcp.TextContent{Text: "Do something"}, result.Messages[0].Content)
+
+	assert.Equal(t, mcp.Role("user"), result.Messages[1].Role)
+	assert.Equal(t, &mcp.EmbeddedResource{
+		Resource: &mcp.ResourceContents{URI: "test://resource", MIMEType: "text/markdown", Text: "context"},
+	}, result.Messages[1].Content)
+}
+
+func TestPrompt_PromptHandler_MissingRequiredArgument(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		t.Fatal("Handler should not be called when a required argument is missing")
+		return nil, nil
+	}
+
+	p, err := baseprompt.New("test_prompt", "Test Prompt", "A test prompt", []baseprompt.Argument{{Name: "first", Required: true}}, mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name:      "test_prompt",
+			Arguments: map[string]string{"first": "  "},
+		},
+	})
+
+	// Assert
+	require.ErrorContains(t, handlerErr, "missing required argument: first")
+	assert.Nil(t, result)
+}
+
+func TestPrompt_PromptHandler_HandlerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	expectedError := assert.AnError
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		return nil, expectedError
+	}
+
+	p, err := baseprompt.New("test_prompt", "Test Prompt", "A test prompt", nil, mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name: "test_prompt",
+		},
+	})
+
+	// Assert
+	require.ErrorIs(t, handlerErr, expectedError)
+	assert.Nil(t, result)
+}
+
+func TestPrompt_PromptHandler_NilHandler(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	p, err := baseprompt.New("test_prompt", "Test Prompt", "A test prompt", nil, mockLoggerFactory, nil)
+	require.NoError(t, err)
+
+	capturedHandler := capturePromptHandler(t, p)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name: "test_prompt",
+		},
+	})
+
+	// Assert
+	require.ErrorContains(t, handlerErr, baseprompt.UnexpectedErrorPrefix)
+	assert.Nil(t, result)
+}
+
+func TestRender_HappyPath(t *testing.T) {
+	// Arrange
+	promptTemplate := baseprompt.ParseTemplate("test", "Model {{.model}}{{if .device}} on {{.device}}{{end}}.")
+
+	// Act
+	withDevice, errWithDevice := baseprompt.Render(promptTemplate, map[string]string{"model": "top", "device": "xcvm1802"})
+	withoutDevice, errWithoutDevice := baseprompt.Render(promptTemplate, map[string]string{"model": "top"})
+
+	// Assert
+	require.NoError(t, errWithDevice)
+	assert.Equal(t, "Model top on xcvm1802.", withDevice)
+
+	require.NoError(t, errWithoutDevice)
+	assert.Equal(t, "Model top.", withoutDevice, "Missing arguments should render as empty strings")
+}
+
+func TestSplitList_HappyPath(t *testing.T) {
+	// Arrange
+	value := " Gateway In, ,Gateway Out ,"
+
+	// Act
+	result := baseprompt.SplitList(value)
+
+	// Assert
+	assert.Equal(t, []string{"Gateway In", "Gateway Out"}, result)
+}
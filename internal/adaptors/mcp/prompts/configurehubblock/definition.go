@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configurehubblock
+
+const (
+	name        = "configure_hub_block_for_codegen"
+	title       = "Configure the Hub Block for Code Generation"
+	description = "Guides the agent through configuring the Vitis Model Composer Hub block of a model for code generation, with the Hub API examples."
+
+	modelPathArgument       = "model_path"
+	compilationTypeArgument = "compilation_type"
+	deviceArgument          = "device"
+)
@@ -0,0 +1,80 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configurehubblock
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/promptcontext"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+//go:embed prompt.md
+var promptText string
+
+var promptTemplate = baseprompt.ParseTemplate(name, promptText)
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(
+	loggerFactory baseprompt.LoggerFactory,
+) (*Prompt, error) {
+	basePrompt, err := baseprompt.New(
+		name,
+		title,
+		description,
+		[]baseprompt.Argument{
+			{
+				Name:        modelPathArgument,
+				Title:       "Model Path",
+				Description: "Absolute path to the Simulink model (.slx) to configure.",
+				Required:    true,
+			},
+			{
+				Name:        compilationTypeArgument,
+				Title:       "Compilation Type",
+				Description: "The Hub block compilation type to generate code for. Example: 'IP Catalog'.",
+			},
+			{
+				Name:        deviceArgument,
+				Title:       "Device",
+				Description: "The FPGA device part to target. Example: 'xcvm1802-vfvc1760-1LHP-i-L'.",
+			},
+		},
+		loggerFactory,
+		Handler(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Prompt{
+		Prompt: basePrompt,
+	}, nil
+}
+
+func Handler() baseprompt.PromptHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		logger.Info("Returning configure Hub block prompt")
+
+		instructions, err := baseprompt.Render(promptTemplate, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		return &baseprompt.GetPromptResult{
+			Description: description,
+			Messages: []baseprompt.Message{
+				{
+					Role: baseprompt.RoleUser,
+					Text: instructions,
+				},
+				promptcontext.HubAPIExamples(),
+			},
+		}, nil
+	}
+}
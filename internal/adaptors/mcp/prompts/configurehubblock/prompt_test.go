@@ -0,0 +1,77 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package configurehubblock_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/configurehubblock"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	// Act
+	prompt, err := configurehubblock.New(mockLoggerFactory)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, prompt)
+	assert.Equal(t, "configure_hub_block_for_codegen", prompt.Name())
+	assert.Equal(t, "Configure the Hub Block for Code Generation", prompt.Title())
+	require.NotEmpty(t, prompt.Arguments())
+	assert.Equal(t, "model_path", prompt.Arguments()[0].Name)
+	assert.True(t, prompt.Arguments()[0].Required)
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := configurehubblock.Handler()
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{
+		"model_path":       "/home/user/models/fir.slx",
+		"compilation_type": "IP Catalog",
+		"device":           "xcvm1802-vfvc1760-1LHP-i-L",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Messages, 2)
+
+	instructions := result.Messages[0].Text
+	assert.Contains(t, instructions, "/home/user/models/fir.slx")
+	assert.Contains(t, instructions, "IP Catalog")
+	assert.Contains(t, instructions, "xcvm1802-vfvc1760-1LHP-i-L")
+
+	require.NotNil(t, result.Messages[1].Resource, "Hub API examples should be embedded")
+	assert.Equal(t, "vmc-hub://api-examples", result.Messages[1].Resource.URI)
+}
+
+func TestHandler_OptionalArgumentsOmitted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := configurehubblock.Handler()
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{
+		"model_path": "/home/user/models/fir.slx",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Messages)
+	assert.NotContains(t, result.Messages[0].Text, "<no value>")
+	assert.NotContains(t, result.Messages[0].Text, "compilation type")
+}
@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package createhlsmodel
+
+const (
+	name        = "create_hls_model"
+	title       = "Create an HLS Model from a Specification"
+	description = "Guides the agent through creating a Vitis Model Composer HLS model from a written specification, with the Hub API examples and the help of the blocks to use."
+
+	specificationArgument = "specification"
+	modelNameArgument     = "model_name"
+	blocksArgument        = "blocks"
+)
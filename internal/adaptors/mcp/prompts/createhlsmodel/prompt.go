@@ -0,0 +1,89 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package createhlsmodel
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/promptcontext"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
+)
+
+//go:embed prompt.md
+var promptText string
+
+var promptTemplate = baseprompt.ParseTemplate(name, promptText)
+
+type BlockHelpUsecase interface {
+	Execute(ctx context.Context, blockName string) (*queryvmcblockhelp.Result, error)
+}
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(
+	loggerFactory baseprompt.LoggerFactory,
+	blockHelpUsecase BlockHelpUsecase,
+) (*Prompt, error) {
+	basePrompt, err := baseprompt.New(
+		name,
+		title,
+		description,
+		[]baseprompt.Argument{
+			{
+				Name:        specificationArgument,
+				Title:       "Specification",
+				Description: "The specification of the design to implement: its interfaces, data types, rates and algorithm.",
+				Required:    true,
+			},
+			{
+				Name:        modelNameArgument,
+				Title:       "Model Name",
+				Description: "The name of the Simulink model to create.",
+			},
+			{
+				Name:        blocksArgument,
+				Title:       "Blocks",
+				Description: "Comma-separated names of Vitis Model Composer blocks the model is expected to use, whose help is included in the prompt. Example: 'Gateway In, Gateway Out'.",
+			},
+		},
+		loggerFactory,
+		Handler(blockHelpUsecase),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Prompt{
+		Prompt: basePrompt,
+	}, nil
+}
+
+func Handler(blockHelpUsecase BlockHelpUsecase) baseprompt.PromptHandler {
+	return func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		logger.Info("Returning create HLS model prompt")
+
+		instructions, err := baseprompt.Render(promptTemplate, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		messages := []baseprompt.Message{
+			{
+				Role: baseprompt.RoleUser,
+				Text: instructions,
+			},
+			promptcontext.HubAPIExamples(),
+		}
+		messages = append(messages, promptcontext.BlockHelp(ctx, logger, blockHelpUsecase, baseprompt.SplitList(arguments[blocksArgument]))...)
+
+		return &baseprompt.GetPromptResult{
+			Description: description,
+			Messages:    messages,
+		}, nil
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package createhlsmodel_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/createhlsmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/createhlsmodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockBlockHelpUsecase.AssertExpectations(t)
+
+	// Act
+	prompt, err := createhlsmodel.New(mockLoggerFactory, mockBlockHelpUsecase)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, prompt)
+	assert.Equal(t, "create_hls_model", prompt.Name())
+	assert.Equal(t, "Create an HLS Model from a Specification", prompt.Title())
+	require.NotEmpty(t, prompt.Arguments())
+	assert.Equal(t, "specification", prompt.Arguments()[0].Name)
+	assert.True(t, prompt.Arguments()[0].Required)
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockBlockHelpUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockBlockHelpUsecase.EXPECT().
+		Execute(ctx, "Gateway In").
+		Return(&queryvmcblockhelp.Result{Documentation: "Gateway In documentation"}, nil).
+		Once()
+
+	handler := createhlsmodel.Handler(mockBlockHelpUsecase)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{
+		"specification": "A FIR filter with 16 taps",
+		"model_name":    "fir16",
+		"blocks":        "Gateway In",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Messages)
+
+	instructions := result.Messages[0].Text
+	assert.Contains(t, instructions, "A FIR filter with 16 taps")
+	assert.Contains(t, instructions, "fir16")
+	assert.Contains(t, result.Messages[len(result.Messages)-1].Text, "Gateway In documentation")
+}
+
+func TestHandler_OptionalArgumentsOmitted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockBlockHelpUsecase.AssertExpectations(t)
+
+	handler := createhlsmodel.Handler(mockBlockHelpUsecase)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{
+		"specification": "A FIR filter with 16 taps",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Messages)
+	assert.NotContains(t, result.Messages[0].Text, "<no value>")
+}
@@ -0,0 +1,15 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugvmcsimulation
+
+const (
+	name        = "debug_vmc_simulation"
+	title       = "Debug a Failing Vitis Model Composer Simulation"
+	description = "Guides the agent through diagnosing and fixing a Vitis Model Composer model whose simulation fails or produces wrong results, with the help of the blocks involved."
+
+	modelPathArgument    = "model_path"
+	errorMessageArgument = "error_message"
+	blocksArgument       = "blocks"
+	sessionIDArgument    = "session_id"
+	matlabRootArgument   = "matlab_root"
+)
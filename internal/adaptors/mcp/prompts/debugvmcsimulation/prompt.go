@@ -0,0 +1,98 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugvmcsimulation
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/promptcontext"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
+)
+
+//go:embed prompt.md
+var promptText string
+
+var promptTemplate = baseprompt.ParseTemplate(name, promptText)
+
+type BlockHelpUsecase interface {
+	Execute(ctx context.Context, blockName string) (*queryvmcblockhelp.Result, error)
+}
+
+type Prompt struct {
+	*baseprompt.Prompt
+}
+
+func New(
+	loggerFactory baseprompt.LoggerFactory,
+	blockHelpUsecase BlockHelpUsecase,
+) (*Prompt, error) {
+	basePrompt, err := baseprompt.New(
+		name,
+		title,
+		description,
+		[]baseprompt.Argument{
+			{
+				Name:        modelPathArgument,
+				Title:       "Model Path",
+				Description: "Absolute path to the Simulink model (.slx) whose simulation fails.",
+				Required:    true,
+			},
+			{
+				Name:        errorMessageArgument,
+				Title:       "Error Message",
+				Description: "The error or warning reported by the failing simulation, if known.",
+			},
+			{
+				Name:        blocksArgument,
+				Title:       "Blocks",
+				Description: "Comma-separated names of the Vitis Model Composer blocks involved in the failure, whose help is included in the prompt. Example: 'Buffer IO, Gateway In'.",
+			},
+			{
+				Name:        sessionIDArgument,
+				Title:       "Session ID",
+				Description: "ID of the running MATLAB session, as listed by list_matlab_sessions, in which to debug the model.",
+			},
+			{
+				Name:        matlabRootArgument,
+				Title:       "MATLAB Root",
+				Description: "MATLAB installation in which to start a session for debugging, when no session ID is given.",
+			},
+		},
+		loggerFactory,
+		Handler(blockHelpUsecase),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Prompt{
+		Prompt: basePrompt,
+	}, nil
+}
+
+func Handler(blockHelpUsecase BlockHelpUsecase) baseprompt.PromptHandler {
+	return func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseprompt.GetPromptResult, error) {
+		logger.Info("Returning debug VMC simulation prompt")
+
+		instructions, err := baseprompt.Render(promptTemplate, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		messages := []baseprompt.Message{
+			{
+				Role: baseprompt.RoleUser,
+				Text: instructions,
+			},
+		}
+		messages = append(messages, promptcontext.BlockHelp(ctx, logger, blockHelpUsecase, baseprompt.SplitList(arguments[blocksArgument]))...)
+
+		return &baseprompt.GetPromptResult{
+			Description: description,
+			Messages:    messages,
+		}, nil
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package debugvmcsimulation_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugvmcsimulation"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
+	basepromptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/baseprompt"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/debugvmcsimulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basepromptmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockBlockHelpUsecase.AssertExpectations(t)
+
+	// Act
+	prompt, err := debugvmcsimulation.New(mockLoggerFactory, mockBlockHelpUsecase)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, prompt)
+	assert.Equal(t, "debug_vmc_simulation", prompt.Name())
+	assert.Equal(t, "Debug a Failing Vitis Model Composer Simulation", prompt.Title())
+	require.NotEmpty(t, prompt.Arguments())
+	assert.Equal(t, "model_path", prompt.Arguments()[0].Name)
+	assert.True(t, prompt.Arguments()[0].Required)
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockBlockHelpUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockBlockHelpUsecase.EXPECT().
+		Execute(ctx, "Gateway In").
+		Return(&queryvmcblockhelp.Result{Documentation: "Gateway In documentation"}, nil).
+		Once()
+
+	handler := debugvmcsimulation.Handler(mockBlockHelpUsecase)
+
+	// Act
+	result, err := handler(ctx, mockLogger, map[string]string{
+		"model_path":    "/home/user/models/fir.slx",
+		"error_message": "AIEImportedIpBlock supports only discrete sample times",
+		"blocks":        "Gateway In",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Messages)
+
+	instructions := result.Messages[0].Text
+	assert.Contains(t, instructions, "/home/user/models/fir.slx")
+	assert.Contains(t, instructions, "AIEImportedIpBlock supports only discrete sample times")
+	assert.Contains(t, result.Messages[len(result.Messages)-1].Text, "Gateway In documentation")
+}
+
+func TestHandler_OptionalArgumentsOmitted(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockBlockHelpUsecase.AssertExpectations(t)
+
+	handler := debugvmcsimulation.Handler(mockBlockHelpUsecase)
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{
+		"model_path": "/home/user/models/fir.slx",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotEmpty(t, result.Messages)
+	assert.NotContains(t, result.Messages[0].Text, "<no value>")
+}
+
+func TestHandler_SessionArguments(t *testing.T) {
+	testCases := []struct {
+		name         string
+		arguments    map[string]string
+		expectedText string
+	}{
+		{
+			name:         "session ID",
+			arguments:    map[string]string{"model_path": "/home/user/models/fir.slx", "session_id": "3", "matlab_root": "/usr/local/MATLAB/R2025a"},
+			expectedText: "Run every step in the MATLAB session `3`",
+		},
+		{
+			name:         "MATLAB root",
+			arguments:    map[string]string{"model_path": "/home/user/models/fir.slx", "matlab_root": "/usr/local/MATLAB/R2025a"},
+			expectedText: "Start a MATLAB session for the MATLAB installation `/usr/local/MATLAB/R2025a`",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Arrange
+			mockLogger := testutils.NewInspectableLogger()
+
+			mockBlockHelpUsecase := &mocks.MockBlockHelpUsecase{}
+			defer mockBlockHelpUsecase.AssertExpectations(t)
+
+			handler := debugvmcsimulation.Handler(mockBlockHelpUsecase)
+
+			// Act
+			result, err := handler(t.Context(), mockLogger, testCase.arguments)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotEmpty(t, result.Messages)
+			assert.Contains(t, result.Messages[0].Text, testCase.expectedText)
+		})
+	}
+}
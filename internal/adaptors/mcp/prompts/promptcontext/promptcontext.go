@@ -0,0 +1,50 @@
+// Copyright 2025 The MathWorks, Inc.
+
+// Package promptcontext provides the Vitis Model Composer reference content that prompts embed,
+// so that agents start from the right context without having to read resources or call tools first.
+package promptcontext
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmchubapi"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
+)
+
+type BlockHelpUsecase interface {
+	Execute(ctx context.Context, blockName string) (*queryvmcblockhelp.Result, error)
+}
+
+// HubAPIExamples embeds the Vitis Model Composer Hub API examples resource.
+func HubAPIExamples() baseprompt.Message {
+	return baseprompt.Message{
+		Role: baseprompt.RoleUser,
+		Resource: &baseprompt.EmbeddedResource{
+			URI:      vmchubapi.URI,
+			MIMEType: vmchubapi.MIMEType,
+			Text:     vmchubapi.Content(),
+		},
+	}
+}
+
+// BlockHelp returns one message with the help of each block.
+// Blocks without help are skipped, as the agent can still query it later with a better name.
+func BlockHelp(ctx context.Context, logger entities.Logger, usecase BlockHelpUsecase, blockNames []string) []baseprompt.Message {
+	var messages []baseprompt.Message
+	for _, blockName := range blockNames {
+		result, err := usecase.Execute(ctx, blockName)
+		if err != nil {
+			logger.WithError(err).With("block-name", blockName).Warn("Failed to find block help for prompt")
+			continue
+		}
+
+		messages = append(messages, baseprompt.Message{
+			Role: baseprompt.RoleUser,
+			Text: fmt.Sprintf("Help for the Vitis Model Composer block %q:\n\n%s", blockName, result.Documentation),
+		})
+	}
+	return messages
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package promptcontext_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/promptcontext"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmchubapi"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts/promptcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubAPIExamples_HappyPath(t *testing.T) {
+	// Act
+	message := promptcontext.HubAPIExamples()
+
+	// Assert
+	assert.Equal(t, baseprompt.RoleUser, message.Role)
+	require.NotNil(t, message.Resource)
+	assert.Equal(t, "vmc-hub://api-examples", message.Resource.URI)
+	assert.Equal(t, "text/markdown", message.Resource.MIMEType)
+	assert.Equal(t, vmchubapi.Content(), message.Resource.Text)
+}
+
+func TestBlockHelp_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, "Gateway In").
+		Return(&queryvmcblockhelp.Result{Documentation: "Gateway In documentation"}, nil).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, "Gateway Out").
+		Return(&queryvmcblockhelp.Result{Documentation: "Gateway Out documentation"}, nil).
+		Once()
+
+	// Act
+	messages := promptcontext.BlockHelp(ctx, mockLogger, mockUsecase, []string{"Gateway In", "Gateway Out"})
+
+	// Assert
+	require.Len(t, messages, 2)
+	assert.Contains(t, messages[0].Text, "Gateway In documentation")
+	assert.Contains(t, messages[1].Text, "Gateway Out documentation")
+}
+
+func TestBlockHelp_SkipsUnknownBlocks(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase := &mocks.MockBlockHelpUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, "Unknown Block").
+		Return(nil, assert.AnError).
+		Once()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, "Gateway In").
+		Return(&queryvmcblockhelp.Result{Documentation: "Gateway In documentation"}, nil).
+		Once()
+
+	// Act
+	messages := promptcontext.BlockHelp(ctx, mockLogger, mockUsecase, []string{"Unknown Block", "Gateway In"})
+
+	// Assert
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0].Text, "Gateway In documentation")
+
+	fields, found := mockLogger.WarnLogs()["Failed to find block help for prompt"]
+	require.True(t, found, "Missing block help should be logged")
+	assert.Equal(t, "Unknown Block", fields["block-name"])
+}
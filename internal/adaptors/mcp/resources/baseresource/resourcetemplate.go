@@ -0,0 +1,174 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/yosida95/uritemplate/v3"
+)
+
+// ErrResourceNotFound is returned by a ResourceTemplateHandler when the URI matches the template
+// but names nothing the template can serve. It is reported to the client as an MCP "resource not found" error.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// ResourceTemplateHandler serves one resource of a template. The arguments map each template
+// variable to its value in the requested URI.
+type ResourceTemplateHandler func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*ReadResourceResult, error)
+
+func NewTemplate(
+	name string,
+	title string,
+	description string,
+	mimeType string,
+	uriTemplate string,
+	loggerFactory LoggerFactory,
+	handler ResourceTemplateHandler,
+) (*ResourceTemplate, error) {
+	if err := validateMIMEType(mimeType); err != nil {
+		return nil, err
+	}
+
+	parsedTemplate, err := parseURITemplate(uriTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceTemplate{
+		name:           name,
+		title:          title,
+		description:    description,
+		mimeType:       mimeType,
+		uriTemplate:    uriTemplate,
+		parsedTemplate: parsedTemplate,
+		loggerFactory:  loggerFactory,
+		handler:        handler,
+	}, nil
+}
+
+type ResourceTemplate struct {
+	name           string
+	title          string
+	description    string
+	mimeType       string
+	uriTemplate    string
+	parsedTemplate *uritemplate.Template
+	loggerFactory  LoggerFactory
+	handler        ResourceTemplateHandler
+}
+
+func (r *ResourceTemplate) AddToServer(server resources.Server) {
+	server.AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        r.name,
+			Title:       r.title,
+			Description: r.description,
+			MIMEType:    r.mimeType,
+			URITemplate: r.uriTemplate,
+		},
+		r.resourceHandler(),
+	)
+}
+
+func (r *ResourceTemplate) resourceHandler() mcp.ResourceHandler {
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		uri := req.Params.URI
+
+		logger := r.loggerFactory.NewMCPSessionLogger(req.Session).
+			With("resource-template-name", r.name).
+			With("uri", uri)
+		logger.Debug("Handling resource template request")
+		defer logger.Debug("Handled resource template request")
+
+		if r.handler == nil {
+			err := fmt.Errorf(UnexpectedErrorPrefix + "no resource template handler available")
+			logger.WithError(err).Warn("Resource template handler is nil")
+			return nil, err
+		}
+
+		arguments, ok := r.match(uri)
+		if !ok {
+			logger.Warn("Requested URI does not match the resource template")
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+
+		result, err := r.handler(ctx, logger, arguments)
+		if errors.Is(err, ErrResourceNotFound) {
+			logger.WithError(err).Info("Resource template has no resource for the requested URI")
+			return nil, mcp.ResourceNotFoundError(uri)
+		}
+		if err != nil {
+			logger.WithError(err).Warn("Resource template handler returned an error")
+			return nil, err
+		}
+
+		mcpContents := make([]*mcp.ResourceContents, len(result.Contents))
+		for i, c := range result.Contents {
+			mcpContents[i] = &mcp.ResourceContents{
+				URI:      uri,
+				MIMEType: c.MIMEType,
+				Text:     c.Text,
+			}
+		}
+
+		return &mcp.ReadResourceResult{
+			Contents: mcpContents,
+		}, nil
+	}
+}
+
+func (r *ResourceTemplate) match(uri string) (map[string]string, bool) {
+	values := r.parsedTemplate.Match(uri)
+	if values == nil {
+		return nil, false
+	}
+
+	arguments := make(map[string]string, len(r.parsedTemplate.Varnames()))
+	for _, varname := range r.parsedTemplate.Varnames() {
+		value := values.Get(varname)
+		if !value.Valid() || value.String() == "" {
+			return nil, false
+		}
+		arguments[varname] = value.String()
+	}
+
+	return arguments, true
+}
+
+func (r *ResourceTemplate) Name() string {
+	return r.name
+}
+
+func (r *ResourceTemplate) Title() string {
+	return r.title
+}
+
+func (r *ResourceTemplate) Description() string {
+	return r.description
+}
+
+func (r *ResourceTemplate) MimeType() string {
+	return r.mimeType
+}
+
+func (r *ResourceTemplate) URITemplate() string {
+	return r.uriTemplate
+}
+
+func parseURITemplate(uriTemplate string) (*uritemplate.Template, error) {
+	parsedTemplate, err := uritemplate.New(uriTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URI template %q: %w", uriTemplate, err)
+	}
+
+	if len(parsedTemplate.Varnames()) == 0 {
+		return nil, fmt.Errorf("invalid URI template %q: must contain at least one variable", uriTemplate)
+	}
+
+	return parsedTemplate, nil
+}
@@ -0,0 +1,347 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package baseresource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	templateName        = "test_template"
+	templateTitle       = "Test Template"
+	templateDescription = "A test resource template"
+	templateMIMEType    = "text/plain"
+	templateURI         = "test://items/{category}/{item}"
+)
+
+func TestNewTemplate_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{}, nil
+	}
+
+	// Act
+	r, err := baseresource.NewTemplate(templateName, templateTitle, templateDescription, templateMIMEType, templateURI, mockLoggerFactory, handler)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, r)
+	assert.Equal(t, templateName, r.Name())
+	assert.Equal(t, templateTitle, r.Title())
+	assert.Equal(t, templateDescription, r.Description())
+	assert.Equal(t, templateMIMEType, r.MimeType())
+	assert.Equal(t, templateURI, r.URITemplate())
+}
+
+func TestNewTemplate_InvalidArguments(t *testing.T) {
+	tests := []struct {
+		name             string
+		mimeType         string
+		uriTemplate      string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "invalid MIME type",
+			mimeType:         "invalid-mime-type",
+			uriTemplate:      templateURI,
+			expectedErrorMsg: "must be in format type/subtype",
+		},
+		{
+			name:             "unterminated expression",
+			mimeType:         templateMIMEType,
+			uriTemplate:      "test://items/{category",
+			expectedErrorMsg: "invalid URI template",
+		},
+		{
+			name:             "no variables",
+			mimeType:         templateMIMEType,
+			uriTemplate:      "test://items",
+			expectedErrorMsg: "must contain at least one variable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+			defer mockLoggerFactory.AssertExpectations(t)
+
+			handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+				return &baseresource.ReadResourceResult{}, nil
+			}
+
+			// Act
+			r, err := baseresource.NewTemplate(templateName, templateTitle, templateDescription, tt.mimeType, tt.uriTemplate, mockLoggerFactory, handler)
+
+			// Assert
+			require.Error(t, err)
+			assert.Nil(t, r)
+			assert.Contains(t, err.Error(), tt.expectedErrorMsg)
+		})
+	}
+}
+
+func TestResourceTemplate_AddToServer_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return &baseresource.ReadResourceResult{}, nil
+	}
+
+	r, err := baseresource.NewTemplate(templateName, templateTitle, templateDescription, templateMIMEType, templateURI, mockLoggerFactory, handler)
+	require.NoError(t, err)
+
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		&mcp.ResourceTemplate{
+			Name:        templateName,
+			Title:       templateTitle,
+			Description: templateDescription,
+			MIMEType:    templateMIMEType,
+			URITemplate: templateURI,
+		},
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Return()
+
+	// Act
+	r.AddToServer(mockServer)
+
+	// Assert
+}
+
+func TestResourceTemplate_ResourceHandler_HappyPath(t *testing.T) {
+	// Arrange
+	const uri = "test://items/fruit/apple"
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	var receivedArguments map[string]string
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		receivedArguments = arguments
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{MIMEType: "text/plain", Text: "apple content"},
+			},
+		}, nil
+	}
+
+	capturedHandler := captureTemplateHandler(t, mockLoggerFactory, handler)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: uri,
+		},
+	})
+
+	// Assert
+	require.NoError(t, handlerErr)
+	assert.Equal(t, map[string]string{"category": "fruit", "item": "apple"}, receivedArguments)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, uri, result.Contents[0].URI)
+	assert.Equal(t, "text/plain", result.Contents[0].MIMEType)
+	assert.Equal(t, "apple content", result.Contents[0].Text)
+}
+
+func TestResourceTemplate_ResourceHandler_DecodesArguments(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	var receivedArguments map[string]string
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		receivedArguments = arguments
+		return &baseresource.ReadResourceResult{}, nil
+	}
+
+	capturedHandler := captureTemplateHandler(t, mockLoggerFactory, handler)
+
+	// Act
+	_, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://items/fruit/green%20apple",
+		},
+	})
+
+	// Assert
+	require.NoError(t, handlerErr)
+	assert.Equal(t, "green apple", receivedArguments["item"])
+}
+
+func TestResourceTemplate_ResourceHandler_URIDoesNotMatch(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		require.Fail(t, "Handler should not be called for a URI that does not match the template")
+		return nil, nil
+	}
+
+	capturedHandler := captureTemplateHandler(t, mockLoggerFactory, handler)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://items/fruit",
+		},
+	})
+
+	// Assert
+	require.Error(t, handlerErr)
+	assert.Contains(t, handlerErr.Error(), "Resource not found")
+	assert.Nil(t, result)
+}
+
+func TestResourceTemplate_ResourceHandler_ResourceNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return nil, fmt.Errorf("no item %q: %w", arguments["item"], baseresource.ErrResourceNotFound)
+	}
+
+	capturedHandler := captureTemplateHandler(t, mockLoggerFactory, handler)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://items/fruit/durian",
+		},
+	})
+
+	// Assert
+	require.Error(t, handlerErr)
+	assert.Contains(t, handlerErr.Error(), "Resource not found")
+	assert.Nil(t, result)
+}
+
+func TestResourceTemplate_ResourceHandler_HandlerError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	expectedError := assert.AnError
+
+	handler := func(ctx context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		return nil, expectedError
+	}
+
+	capturedHandler := captureTemplateHandler(t, mockLoggerFactory, handler)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://items/fruit/apple",
+		},
+	})
+
+	// Assert
+	require.ErrorIs(t, handlerErr, expectedError)
+	assert.Nil(t, result)
+}
+
+func TestResourceTemplate_ResourceHandler_NilHandler(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &baseresourcemocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	capturedHandler := captureTemplateHandler(t, mockLoggerFactory, nil)
+
+	// Act
+	result, handlerErr := capturedHandler(t.Context(), &mcp.ReadResourceRequest{
+		Params: &mcp.ReadResourceParams{
+			URI: "test://items/fruit/apple",
+		},
+	})
+
+	// Assert
+	require.Error(t, handlerErr)
+	assert.Contains(t, handlerErr.Error(), baseresource.UnexpectedErrorPrefix)
+	assert.Nil(t, result)
+}
+
+func captureTemplateHandler(t *testing.T, loggerFactory baseresource.LoggerFactory, handler baseresource.ResourceTemplateHandler) mcp.ResourceHandler {
+	t.Helper()
+
+	r, err := baseresource.NewTemplate(templateName, templateTitle, templateDescription, templateMIMEType, templateURI, loggerFactory, handler)
+	require.NoError(t, err)
+
+	var capturedHandler mcp.ResourceHandler
+	mockServer := &mocks.MockServer{}
+	defer mockServer.AssertExpectations(t)
+
+	mockServer.EXPECT().AddResourceTemplate(
+		mock.Anything,
+		mock.AnythingOfType("mcp.ResourceHandler"),
+	).Run(func(resourceTemplate *mcp.ResourceTemplate, h mcp.ResourceHandler) {
+		capturedHandler = h
+	}).Return()
+
+	r.AddToServer(mockServer)
+
+	return capturedHandler
+}
@@ -8,6 +8,7 @@ import (
 
 type Server interface {
 	AddResource(resource *mcp.Resource, handler mcp.ResourceHandler)
+	AddResourceTemplate(resourceTemplate *mcp.ResourceTemplate, handler mcp.ResourceHandler)
 }
 
 type Resource interface {
@@ -0,0 +1,112 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockhelp
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// Categories lists the block categories in the order they are presented.
+var Categories = []string{"AIE", "HDL", "HLS", "Other"}
+
+// Block is the help page of one Vitis Model Composer block.
+type Block struct {
+	// Category is the top-level folder of the page: AIE, HDL, HLS or Other.
+	Category string
+	// ID is the page file name without its extension, e.g. gateway_in.
+	ID string
+	// Title is the first heading of the page.
+	Title   string
+	Library string
+	Path    string
+	Content string
+}
+
+// URI returns the vmc-help://blocks/{category}/{block} URI of the block page.
+func (b Block) URI() string {
+	return uri + "/" + strings.ToLower(b.Category) + "/" + b.ID
+}
+
+// Blocks returns every embedded block help page, in file system order.
+func Blocks() ([]Block, error) {
+	var blocks []Block
+
+	err := fs.WalkDir(vmcHelpFiles, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".md" {
+			return nil
+		}
+
+		content, readErr := fs.ReadFile(vmcHelpFiles, path)
+		if readErr != nil {
+			return nil // Skip files we can't read
+		}
+
+		blocks = append(blocks, newBlock(path, string(content)))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// FindBlock returns the block page of the given category and ID. Both are matched case-insensitively.
+func FindBlock(category string, id string) (Block, bool, error) {
+	blocks, err := Blocks()
+	if err != nil {
+		return Block{}, false, err
+	}
+
+	for _, block := range blocks {
+		if strings.EqualFold(block.Category, category) && strings.EqualFold(block.ID, id) {
+			return block, true, nil
+		}
+	}
+
+	return Block{}, false, nil
+}
+
+func newBlock(path string, content string) Block {
+	id := strings.TrimSuffix(filepath.Base(path), ".md")
+
+	// Extract title from first heading (skip metadata line if present)
+	title := id
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") {
+			title = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			break
+		}
+	}
+
+	return Block{
+		Category: categoryOf(path),
+		ID:       id,
+		Title:    title,
+		Library:  extractLibraryFromContent(content),
+		Path:     path,
+		Content:  content,
+	}
+}
+
+// categoryOf determines the block category from its path in the embedded file system
+func categoryOf(path string) string {
+	switch {
+	case strings.Contains(path, "AIE/") || strings.HasPrefix(path, "AIE\\"):
+		return "AIE"
+	case strings.Contains(path, "HDL/") || strings.HasPrefix(path, "HDL\\"):
+		return "HDL"
+	case strings.Contains(path, "HLS/") || strings.HasPrefix(path, "HLS\\"):
+		return "HLS"
+	default:
+		return "Other"
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockhelp_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocks_HappyPath(t *testing.T) {
+	// Act
+	blocks, err := vmcblockhelp.Blocks()
+
+	// Assert
+	require.NoError(t, err)
+	require.NotEmpty(t, blocks)
+
+	for _, block := range blocks {
+		assert.Contains(t, vmcblockhelp.Categories, block.Category)
+		assert.NotEmpty(t, block.ID)
+		assert.NotEmpty(t, block.Title)
+		assert.NotEmpty(t, block.Content)
+	}
+}
+
+func TestFindBlock_HappyPath(t *testing.T) {
+	// Act
+	block, found, err := vmcblockhelp.FindBlock("hdl", "GATEWAY_IN")
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "HDL", block.Category)
+	assert.Equal(t, "gateway_in", block.ID)
+	assert.Equal(t, "Gateway In", block.Title)
+	assert.Equal(t, "vmc-help://blocks/hdl/gateway_in", block.URI())
+}
+
+func TestFindBlock_NestedFolder(t *testing.T) {
+	// Act
+	block, found, err := vmcblockhelp.FindBlock("aie", "buffer_io")
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, "AIE", block.Category)
+	assert.Equal(t, "vmc-help://blocks/aie/buffer_io", block.URI())
+}
+
+func TestFindBlock_NotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		id       string
+	}{
+		{name: "unknown block", category: "hdl", id: "does_not_exist"},
+		{name: "wrong category", category: "hls", id: "gateway_in"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, found, err := vmcblockhelp.FindBlock(tt.category, tt.id)
+
+			// Assert
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+	}
+}
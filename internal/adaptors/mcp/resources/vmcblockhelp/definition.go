@@ -5,7 +5,7 @@ package vmcblockhelp
 const (
 	name        = "vmcblockhelp"
 	title       = "Vitis Model Composer Block Help"
-	description = "Provides comprehensive help documentation for Vitis Model Composer blocks from the VMC_Help GitHub repository. Includes block descriptions, parameters, usage examples, and best practices."
+	description = "Provides comprehensive help documentation for Vitis Model Composer blocks from the VMC_Help GitHub repository. Includes block descriptions, parameters, usage examples, and best practices. This resource is large: to read only the blocks you need, use the vmc-help://blocks/index resource and the vmc-help://blocks/{category}/{block} resource template."
 	mimeType    = "text/markdown"
 	uri         = "vmc-help://blocks"
 
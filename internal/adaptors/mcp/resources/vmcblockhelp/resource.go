@@ -6,8 +6,6 @@ import (
 	"context"
 	"embed"
 	"fmt"
-	"io/fs"
-	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -66,70 +64,7 @@ func Handler() baseresource.ResourceHandler {
 	return func(_ context.Context, logger entities.Logger) (*baseresource.ReadResourceResult, error) {
 		logger.Info("Returning Vitis Model Composer block help resource")
 
-		// First pass: collect all files and organize by category
-		type fileInfo struct {
-			path     string
-			category string
-			name     string
-			library  string
-			content  []byte
-		}
-		var files []fileInfo
-
-		err := fs.WalkDir(vmcHelpFiles, ".", func(path string, d fs.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
-			
-			// Skip directories and non-markdown files
-			if d.IsDir() || filepath.Ext(path) != ".md" {
-				return nil
-			}
-
-			// Read the file content
-			content, readErr := fs.ReadFile(vmcHelpFiles, path)
-			if readErr != nil {
-				logger.WithError(readErr).With("file", path).Warn("Failed to read embedded file")
-				return nil // Continue processing other files
-			}
-
-			contentStr := string(content)
-			
-			// Extract library metadata from first line
-			library := extractLibraryFromContent(contentStr)
-
-			// Extract title from first heading (skip metadata line if present)
-			title := strings.TrimSuffix(filepath.Base(path), ".md")
-			lines := strings.Split(contentStr, "\n")
-			for _, line := range lines {
-				trimmed := strings.TrimSpace(line)
-				if strings.HasPrefix(trimmed, "#") {
-					title = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
-					break
-				}
-			}
-
-			// Determine category from path
-			category := "Other"
-			if strings.Contains(path, "AIE/") || strings.HasPrefix(path, "AIE\\") {
-				category = "AIE"
-			} else if strings.Contains(path, "HDL/") || strings.HasPrefix(path, "HDL\\") {
-				category = "HDL"
-			} else if strings.Contains(path, "HLS/") || strings.HasPrefix(path, "HLS\\") {
-				category = "HLS"
-			}
-
-			files = append(files, fileInfo{
-				path:     path,
-				category: category,
-				name:     title,
-				library:  library,
-				content:  content,
-			})
-
-			return nil
-		})
-
+		files, err := Blocks()
 		if err != nil {
 			logger.WithError(err).Error("Failed to walk embedded filesystem")
 			return nil, err
@@ -146,11 +81,10 @@ func Handler() baseresource.ResourceHandler {
 		// Build table of contents organized by category
 		combinedContent.WriteString("## Table of Contents\n\n")
 		
-		categories := []string{"AIE", "HDL", "HLS", "Other"}
-		for _, cat := range categories {
-			var categoryFiles []fileInfo
+		for _, cat := range Categories {
+			var categoryFiles []Block
 			for _, f := range files {
-				if f.category == cat {
+				if f.Category == cat {
 					categoryFiles = append(categoryFiles, f)
 				}
 			}
@@ -164,15 +98,15 @@ func Handler() baseresource.ResourceHandler {
 				
 				for _, f := range categoryFiles {
 					combinedContent.WriteString("- [")
-					combinedContent.WriteString(f.name)
+					combinedContent.WriteString(f.Title)
 					combinedContent.WriteString("](#")
-					combinedContent.WriteString(strings.ToLower(strings.ReplaceAll(f.name, " ", "-")))
+					combinedContent.WriteString(strings.ToLower(strings.ReplaceAll(f.Title, " ", "-")))
 					combinedContent.WriteString(")")
 					
 					// Add library information if available
-					if f.library != "" {
+					if f.Library != "" {
 						combinedContent.WriteString(" - **Library:** `")
-						combinedContent.WriteString(f.library)
+						combinedContent.WriteString(f.Library)
 						combinedContent.WriteString("`")
 					}
 					
@@ -185,10 +119,10 @@ func Handler() baseresource.ResourceHandler {
 		combinedContent.WriteString("---\n\n")
 
 		// Second pass: add all block documentation organized by category
-		for _, cat := range categories {
-			var categoryFiles []fileInfo
+		for _, cat := range Categories {
+			var categoryFiles []Block
 			for _, f := range files {
-				if f.category == cat {
+				if f.Category == cat {
 					categoryFiles = append(categoryFiles, f)
 				}
 			}
@@ -204,23 +138,23 @@ func Handler() baseresource.ResourceHandler {
 			for _, f := range categoryFiles {
 				// Add block header with anchor
 				combinedContent.WriteString("## ")
-				combinedContent.WriteString(f.name)
+				combinedContent.WriteString(f.Title)
 				combinedContent.WriteString("\n\n")
 				combinedContent.WriteString("**Category:** ")
 				combinedContent.WriteString(cat)
 				combinedContent.WriteString("  \n")
 				
 				// Add library information if available
-				if f.library != "" {
+				if f.Library != "" {
 					combinedContent.WriteString("**Simulink Library:** `")
-					combinedContent.WriteString(f.library)
+					combinedContent.WriteString(f.Library)
 					combinedContent.WriteString("`  \n")
 				}
 				
 				combinedContent.WriteString("**Source File:** `")
-				combinedContent.WriteString(f.path)
+				combinedContent.WriteString(f.Path)
 				combinedContent.WriteString("`\n\n")
-				combinedContent.Write(f.content)
+				combinedContent.WriteString(f.Content)
 				combinedContent.WriteString("\n\n---\n\n")
 			}
 		}
@@ -251,65 +185,23 @@ func SearchBlock(searchTerm string) (string, error) {
 	
 	var matches []blockInfo
 
-	err := fs.WalkDir(vmcHelpFiles, ".", func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if d.IsDir() || filepath.Ext(path) != ".md" {
-			return nil
-		}
-
-		// Read file content
-		content, readErr := fs.ReadFile(vmcHelpFiles, path)
-		if readErr != nil {
-			return nil // Skip files we can't read
-		}
-
-		contentStr := string(content)
-		
-		// Extract library metadata
-		library := extractLibraryFromContent(contentStr)
-
-		// Extract title from first heading
-		title := strings.TrimSuffix(filepath.Base(path), ".md")
-		lines := strings.Split(contentStr, "\n")
-		for _, line := range lines {
-			trimmed := strings.TrimSpace(line)
-			if strings.HasPrefix(trimmed, "#") {
-				title = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
-				break
-			}
-		}
-
-		// Determine category
-		category := "Other"
-		if strings.Contains(path, "AIE/") || strings.HasPrefix(path, "AIE\\") {
-			category = "AIE"
-		} else if strings.Contains(path, "HDL/") || strings.HasPrefix(path, "HDL\\") {
-			category = "HDL"
-		} else if strings.Contains(path, "HLS/") || strings.HasPrefix(path, "HLS\\") {
-			category = "HLS"
-		}
+	blocks, err := Blocks()
+	if err != nil {
+		return "", fmt.Errorf("failed to search block help: %w", err)
+	}
 
-		// Check for match
-		titleLower := strings.ToLower(title)
+	for _, block := range blocks {
+		titleLower := strings.ToLower(block.Title)
 		if strings.Contains(titleLower, searchLower) {
 			matches = append(matches, blockInfo{
-				path:       path,
-				title:      title,
-				category:   category,
-				library:    library,
-				content:    content,
+				path:       block.Path,
+				title:      block.Title,
+				category:   block.Category,
+				library:    block.Library,
+				content:    []byte(block.Content),
 				exactMatch: titleLower == searchLower,
 			})
 		}
-
-		return nil
-	})
-
-	if err != nil {
-		return "", fmt.Errorf("failed to search block help: %w", err)
 	}
 
 	if len(matches) == 0 {
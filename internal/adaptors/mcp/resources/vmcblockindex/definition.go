@@ -0,0 +1,13 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockindex
+
+const (
+	name        = "vmcblockindex"
+	title       = "Vitis Model Composer Block Index"
+	description = "Lists every Vitis Model Composer block with its category, Simulink library and the URI of its help page. Read this index first, then read only the block pages you need."
+	mimeType    = "text/markdown"
+	uri         = "vmc-help://blocks/index"
+
+	estimatedSize = 40 * 1024 // 40 KB estimated
+)
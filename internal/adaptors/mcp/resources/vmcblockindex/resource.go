@@ -0,0 +1,90 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockindex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Resource struct {
+	*baseresource.Resource
+}
+
+func New(loggerFactory baseresource.LoggerFactory) (*Resource, error) {
+	baseRes, err := baseresource.New(
+		name,
+		title,
+		description,
+		mimeType,
+		estimatedSize,
+		uri,
+		loggerFactory,
+		Handler(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{
+		Resource: baseRes,
+	}, nil
+}
+
+func Handler() baseresource.ResourceHandler {
+	return func(_ context.Context, logger entities.Logger) (*baseresource.ReadResourceResult, error) {
+		logger.Info("Returning Vitis Model Composer block index resource")
+
+		blocks, err := vmcblockhelp.Blocks()
+		if err != nil {
+			logger.WithError(err).Error("Failed to walk embedded filesystem")
+			return nil, err
+		}
+
+		var index strings.Builder
+		index.WriteString("# Vitis Model Composer Block Index\n\n")
+		index.WriteString(fmt.Sprintf("**Total Blocks:** %d\n\n", len(blocks)))
+
+		for _, category := range vmcblockhelp.Categories {
+			var categoryBlocks []vmcblockhelp.Block
+			for _, block := range blocks {
+				if block.Category == category {
+					categoryBlocks = append(categoryBlocks, block)
+				}
+			}
+
+			if len(categoryBlocks) == 0 {
+				continue
+			}
+
+			index.WriteString(fmt.Sprintf("## %s Blocks (%d)\n\n", category, len(categoryBlocks)))
+			index.WriteString("| Block | Simulink Library | URI |\n")
+			index.WriteString("| --- | --- | --- |\n")
+			for _, block := range categoryBlocks {
+				index.WriteString(fmt.Sprintf("| %s | %s | `%s` |\n", block.Title, library(block), block.URI()))
+			}
+			index.WriteString("\n")
+		}
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     index.String(),
+				},
+			},
+		}, nil
+	}
+}
+
+func library(block vmcblockhelp.Block) string {
+	if block.Library == "" {
+		return "-"
+	}
+	return "`" + block.Library + "`"
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockindex_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockindex"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+
+	// Act
+	resource, err := vmcblockindex.New(mockLoggerFactory)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, resource)
+	assert.Equal(t, "vmcblockindex", resource.Name())
+	assert.Equal(t, "Vitis Model Composer Block Index", resource.Title())
+	assert.Equal(t, "text/markdown", resource.MimeType())
+	assert.Equal(t, "vmc-help://blocks/index", resource.URI())
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := vmcblockindex.Handler()
+
+	// Act
+	result, err := handler(t.Context(), mockLogger)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Contents, 1)
+
+	index := result.Contents[0].Text
+	assert.Contains(t, index, "## AIE Blocks")
+	assert.Contains(t, index, "| Buffer IO | `AIE/Buffer` | `vmc-help://blocks/aie/buffer_io` |")
+	assert.NotContains(t, index, "## Parameters", "The index should not include block documentation")
+}
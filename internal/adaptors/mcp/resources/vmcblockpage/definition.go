@@ -0,0 +1,14 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockpage
+
+const (
+	name        = "vmcblockpage"
+	title       = "Vitis Model Composer Block Help Page"
+	description = "Help documentation for a single Vitis Model Composer block: description, parameters and usage examples. The category is aie, hdl, hls or other, and the block is the page name listed by the vmc-help://blocks/index resource, e.g. vmc-help://blocks/hdl/gateway_in."
+	mimeType    = "text/markdown"
+	uriTemplate = "vmc-help://blocks/{category}/{block}"
+
+	categoryArgument = "category"
+	blockArgument    = "block"
+)
@@ -0,0 +1,83 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockpage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type ResourceTemplate struct {
+	*baseresource.ResourceTemplate
+}
+
+func New(loggerFactory baseresource.LoggerFactory) (*ResourceTemplate, error) {
+	baseTemplate, err := baseresource.NewTemplate(
+		name,
+		title,
+		description,
+		mimeType,
+		uriTemplate,
+		loggerFactory,
+		Handler(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceTemplate{
+		ResourceTemplate: baseTemplate,
+	}, nil
+}
+
+func Handler() baseresource.ResourceTemplateHandler {
+	return func(_ context.Context, logger entities.Logger, arguments map[string]string) (*baseresource.ReadResourceResult, error) {
+		category := arguments[categoryArgument]
+		blockID := arguments[blockArgument]
+
+		block, found, err := vmcblockhelp.FindBlock(category, blockID)
+		if err != nil {
+			logger.WithError(err).Error("Failed to walk embedded filesystem")
+			return nil, err
+		}
+
+		if !found {
+			return nil, fmt.Errorf("no %s block page named %q: %w", category, blockID, baseresource.ErrResourceNotFound)
+		}
+
+		logger.With("block", block.Title).Info("Returning Vitis Model Composer block help page resource")
+
+		return &baseresource.ReadResourceResult{
+			Contents: []baseresource.ResourceContents{
+				{
+					MIMEType: mimeType,
+					Text:     page(block),
+				},
+			},
+		}, nil
+	}
+}
+
+func page(block vmcblockhelp.Block) string {
+	var doc strings.Builder
+
+	doc.WriteString("**Category:** ")
+	doc.WriteString(block.Category)
+	doc.WriteString("  \n")
+
+	if block.Library != "" {
+		doc.WriteString("**Simulink Library:** `")
+		doc.WriteString(block.Library)
+		doc.WriteString("`  \n")
+	}
+
+	doc.WriteString("\n")
+	doc.WriteString(block.Content)
+
+	return doc.String()
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package vmcblockpage_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockpage"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	baseresourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources/baseresource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := baseresourcemocks.NewMockLoggerFactory(t)
+
+	// Act
+	resourceTemplate, err := vmcblockpage.New(mockLoggerFactory)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, resourceTemplate)
+	assert.Equal(t, "vmcblockpage", resourceTemplate.Name())
+	assert.Equal(t, "Vitis Model Composer Block Help Page", resourceTemplate.Title())
+	assert.Equal(t, "text/markdown", resourceTemplate.MimeType())
+	assert.Equal(t, "vmc-help://blocks/{category}/{block}", resourceTemplate.URITemplate())
+}
+
+func TestHandler_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := vmcblockpage.Handler()
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{
+		"category": "hdl",
+		"block":    "gateway_in",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Len(t, result.Contents, 1)
+	assert.Equal(t, "text/markdown", result.Contents[0].MIMEType)
+	assert.Contains(t, result.Contents[0].Text, "# Gateway In")
+	assert.Contains(t, result.Contents[0].Text, "**Category:** HDL")
+	assert.NotContains(t, result.Contents[0].Text, "# Gateway Out", "Only the requested block should be returned")
+}
+
+func TestHandler_BlockNotFound(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	handler := vmcblockpage.Handler()
+
+	// Act
+	result, err := handler(t.Context(), mockLogger, map[string]string{
+		"category": "hdl",
+		"block":    "does_not_exist",
+	})
+
+	// Assert
+	require.ErrorIs(t, err, baseresource.ErrResourceNotFound)
+	assert.Nil(t, result)
+}
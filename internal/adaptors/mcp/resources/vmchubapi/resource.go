@@ -51,3 +51,14 @@ func Handler() baseresource.ResourceHandler {
 		}, nil
 	}
 }
+
+// URI and MIMEType identify the resource when its content is embedded elsewhere, such as in prompts.
+const (
+	URI      = uri
+	MIMEType = mimeType
+)
+
+// Content returns the Hub API examples.
+func Content() string {
+	return vmcHubAPIContent
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package roots
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"path/filepath"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const callToolMethod = "tools/call"
+
+// methodNotFoundCode is the JSON-RPC error code of a request for a method that the receiver does not handle.
+const methodNotFoundCode = -32601
+
+type LoggerFactory interface {
+	NewMCPSessionLogger(session *mcp.ServerSession) entities.Logger
+}
+
+// clientRoots are the roots of one MCP client, as last listed.
+type clientRoots struct {
+	listed    bool
+	supported bool
+	folders   []string
+
+	// generation is incremented each time the client reports that its roots changed,
+	// so that a listing started before the change is not cached.
+	generation int
+}
+
+// Tracker lists the roots of each MCP client with roots/list, and keeps them until the client reports that they changed
+// or disconnects. Tool calls carry the roots of the calling client in their context, so that paths can be checked against them.
+type Tracker struct {
+	loggerFactory LoggerFactory
+
+	lock           sync.Mutex
+	rootsBySession map[*mcp.ServerSession]*clientRoots
+}
+
+func New(
+	loggerFactory LoggerFactory,
+) *Tracker {
+	return &Tracker{
+		loggerFactory:  loggerFactory,
+		rootsBySession: make(map[*mcp.ServerSession]*clientRoots),
+	}
+}
+
+// Middleware adds the roots of the calling client to the context of tool calls.
+func (t *Tracker) Middleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if method != callToolMethod {
+			return next(ctx, method, req)
+		}
+
+		session, ok := req.GetSession().(*mcp.ServerSession)
+		if !ok || session == nil {
+			return next(ctx, method, req)
+		}
+
+		if folders, supported := t.rootsOf(ctx, session); supported {
+			ctx = entities.WithClientRoots(ctx, folders)
+		}
+
+		return next(ctx, method, req)
+	}
+}
+
+// HandleRootsListChanged handles notifications/roots/list_changed, by listing the roots of the client again on its next tool call.
+func (t *Tracker) HandleRootsListChanged(_ context.Context, req *mcp.RootsListChangedRequest) {
+	t.loggerFactory.NewMCPSessionLogger(req.Session).Debug("MCP client roots changed")
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if roots, found := t.rootsBySession[req.Session]; found {
+		roots.listed = false
+		roots.generation++
+	}
+}
+
+func (t *Tracker) rootsOf(ctx context.Context, session *mcp.ServerSession) ([]string, bool) {
+	t.lock.Lock()
+	roots, found := t.rootsBySession[session]
+	if !found {
+		roots = &clientRoots{}
+		t.rootsBySession[session] = roots
+		go t.forgetOnDisconnect(session)
+	}
+	if roots.listed {
+		defer t.lock.Unlock()
+		return roots.folders, roots.supported
+	}
+	generation := roots.generation
+	t.lock.Unlock()
+
+	folders, supported, err := t.listRoots(ctx, session)
+	if err != nil {
+		// A failure is not cached, so that the roots are listed again on the next tool call.
+		return folders, supported
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if roots.generation == generation {
+		roots.listed = true
+		roots.supported = supported
+		roots.folders = folders
+	}
+
+	return folders, supported
+}
+
+func (t *Tracker) listRoots(ctx context.Context, session *mcp.ServerSession) ([]string, bool, error) {
+	logger := t.loggerFactory.NewMCPSessionLogger(session)
+
+	result, err := session.ListRoots(ctx, &mcp.ListRootsParams{})
+	if isMethodNotFound(err) {
+		logger.WithError(err).Debug("MCP client does not support roots, so only the allowed folders apply")
+		return nil, false, nil
+	}
+	if err != nil {
+		// The client supports roots, so paths are not allowed on the strength of a listing that failed.
+		logger.WithError(err).Warn("Failed to list MCP client roots, so only the allowed folders apply to this tool call")
+		return []string{}, true, err
+	}
+
+	folders := []string{}
+	for _, root := range result.Roots {
+		folder, ok := folderOfRootURI(root.URI)
+		if !ok {
+			logger.With("uri", root.URI).Warn("Ignoring MCP client root that is not a file URI")
+			continue
+		}
+		folders = append(folders, folder)
+	}
+
+	logger.With("roots", folders).Info("Listed MCP client roots")
+
+	return folders, true, nil
+}
+
+// isMethodNotFound reports whether err is the JSON-RPC "method not found" error of a client that does not support roots.
+// The SDK does not export its JSON-RPC error type, so the error code is read from the JSON encoding of each wrapped error.
+func isMethodNotFound(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		encoded, marshalErr := json.Marshal(err)
+		if marshalErr != nil {
+			continue
+		}
+
+		var wireError struct {
+			Code int64 `json:"code"`
+		}
+		if json.Unmarshal(encoded, &wireError) == nil && wireError.Code == methodNotFoundCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forgetOnDisconnect waits for the MCP client to disconnect, and then forgets its roots.
+func (t *Tracker) forgetOnDisconnect(session *mcp.ServerSession) {
+	_ = session.Wait()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.rootsBySession, session)
+}
+
+// folderOfRootURI returns the local folder of a file:// root URI, such as file:///home/user/project or file:///C:/Users/user/project.
+func folderOfRootURI(uri string) (string, bool) {
+	parsedURI, err := url.Parse(uri)
+	if err != nil || parsedURI.Scheme != "file" || parsedURI.Path == "" {
+		return "", false
+	}
+
+	path := parsedURI.Path
+	// Windows drive letters come after the leading slash of the URI path.
+	if len(path) >= 3 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+
+	return filepath.Clean(filepath.FromSlash(path)), true
+}
@@ -0,0 +1,7 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package roots
+
+func FolderOfRootURI(uri string) (string, bool) {
+	return folderOfRootURI(uri)
+}
@@ -0,0 +1,273 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package roots_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/roots"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/roots"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const noRoots = "<no roots>"
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	// Act
+	tracker := roots.New(mockLoggerFactory)
+
+	// Assert
+	assert.NotNil(t, tracker)
+}
+
+func TestTracker_Middleware_AddsClientRootsToToolCalls(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.AnythingOfType("*mcp.ServerSession")).
+		Return(testutils.NewInspectableLogger())
+
+	tracker := roots.New(mockLoggerFactory)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(
+		&mcp.Root{URI: "file:///work/project"},
+		&mcp.Root{URI: "file:///work/shared%20data"},
+	)
+
+	clientSession := connectInMemory(t, tracker, client)
+
+	// Act
+	rootsSeenByTool := callRootsTool(t, clientSession)
+
+	// Assert
+	assert.Equal(t, filepath.FromSlash("/work/project")+"\n"+filepath.FromSlash("/work/shared data"), rootsSeenByTool)
+}
+
+func TestTracker_HandleRootsListChanged_ListsRootsAgain(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.AnythingOfType("*mcp.ServerSession")).
+		Return(testutils.NewInspectableLogger())
+
+	tracker := roots.New(mockLoggerFactory)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(&mcp.Root{URI: "file:///work/old"})
+
+	clientSession := connectInMemory(t, tracker, client)
+	require.Equal(t, filepath.FromSlash("/work/old"), callRootsTool(t, clientSession))
+
+	// Act
+	client.RemoveRoots("file:///work/old")
+	client.AddRoots(&mcp.Root{URI: "file:///work/new"})
+
+	// Assert
+	assert.Eventually(t, func() bool {
+		return callRootsTool(t, clientSession) == filepath.FromSlash("/work/new")
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTracker_Middleware_ClientWithoutRoots(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.AnythingOfType("*mcp.ServerSession")).
+		Return(testutils.NewInspectableLogger())
+
+	tracker := roots.New(mockLoggerFactory)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddReceivingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method == "roots/list" {
+				// The SDK answers methods that no handler knows with a JSON-RPC "method not found" error.
+				return next(ctx, "roots/unsupported", req)
+			}
+			return next(ctx, method, req)
+		}
+	})
+
+	clientSession := connectInMemory(t, tracker, client)
+
+	// Act
+	rootsSeenByTool := callRootsTool(t, clientSession)
+
+	// Assert
+	assert.Equal(t, noRoots, rootsSeenByTool)
+}
+
+func TestTracker_Middleware_ClientThatListsNoRoots(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.AnythingOfType("*mcp.ServerSession")).
+		Return(testutils.NewInspectableLogger())
+
+	tracker := roots.New(mockLoggerFactory)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(&mcp.Root{URI: "https://example.com/project"})
+
+	clientSession := connectInMemory(t, tracker, client)
+
+	// Act
+	rootsSeenByTool := callRootsTool(t, clientSession)
+
+	// Assert
+	assert.Empty(t, rootsSeenByTool, "A client that supports roots but lists no folder should allow no folder")
+}
+
+func TestTracker_Middleware_ListsRootsAgainAfterAnError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.AnythingOfType("*mcp.ServerSession")).
+		Return(mockLogger)
+
+	tracker := roots.New(mockLoggerFactory)
+
+	var failListing atomic.Bool
+	failListing.Store(true)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	client.AddRoots(&mcp.Root{URI: "file:///work/project"})
+	client.AddReceivingMiddleware(func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			if method == "roots/list" && failListing.Load() {
+				return nil, assert.AnError
+			}
+			return next(ctx, method, req)
+		}
+	})
+
+	clientSession := connectInMemory(t, tracker, client)
+
+	// Act
+	rootsSeenOnFailure := callRootsTool(t, clientSession)
+	failListing.Store(false)
+	rootsSeenAfterwards := callRootsTool(t, clientSession)
+
+	// Assert
+	assert.Empty(t, rootsSeenOnFailure, "A failed listing should allow no folder")
+	assert.Equal(t, filepath.FromSlash("/work/project"), rootsSeenAfterwards)
+	assert.Contains(t, mockLogger.WarnLogs(), "Failed to list MCP client roots, so only the allowed folders apply to this tool call")
+}
+
+func TestFolderOfRootURI(t *testing.T) {
+	tests := []struct {
+		name           string
+		uri            string
+		expectedFolder string
+		expectedOK     bool
+	}{
+		{
+			name:           "unix path",
+			uri:            "file:///home/user/project",
+			expectedFolder: filepath.FromSlash("/home/user/project"),
+			expectedOK:     true,
+		},
+		{
+			name:           "windows path",
+			uri:            "file:///C:/Users/user/project",
+			expectedFolder: filepath.FromSlash("C:/Users/user/project"),
+			expectedOK:     true,
+		},
+		{
+			name:           "escaped characters",
+			uri:            "file:///home/user/my%20project/",
+			expectedFolder: filepath.FromSlash("/home/user/my project"),
+			expectedOK:     true,
+		},
+		{
+			name:       "not a file URI",
+			uri:        "https://example.com/project",
+			expectedOK: false,
+		},
+		{
+			name:       "no path",
+			uri:        "file://",
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			folder, ok := roots.FolderOfRootURI(tt.uri)
+
+			// Assert
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedFolder, folder)
+		})
+	}
+}
+
+// connectInMemory connects client to a server that has a "roots" tool, which returns the roots found in its context.
+func connectInMemory(t *testing.T, tracker *roots.Tracker, client *mcp.Client) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, &mcp.ServerOptions{
+		RootsListChangedHandler: tracker.HandleRootsListChanged,
+	})
+	server.AddReceivingMiddleware(tracker.Middleware)
+
+	server.AddTool(&mcp.Tool{Name: "roots", InputSchema: map[string]any{"type": "object"}}, func(ctx context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		text := noRoots
+		if clientRoots, ok := entities.ClientRootsFromContext(ctx); ok {
+			text = strings.Join(clientRoots, "\n")
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: text}}}, nil
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return clientSession
+}
+
+func callRootsTool(t *testing.T, clientSession *mcp.ClientSession) string {
+	t.Helper()
+
+	result, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{Name: "roots"})
+	require.NoError(t, err)
+	require.Len(t, result.Content, 1)
+
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+
+	return textContent.Text
+}
@@ -3,13 +3,21 @@
 package configurator
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/configurehubblock"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/createhlsmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugvmcsimulation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockindex"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockpage"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmchubapi"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/attachmatlabsession"
 	evalmatlabcodemultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
@@ -32,6 +40,8 @@ type Configurator struct {
 	startMATLABSessionTool   tools.Tool
 	stopMATLABSessionTool    tools.Tool
 	evalInMATLABSessionTool  tools.Tool
+	listMATLABSessionsTool   tools.Tool
+	attachMATLABSessionTool  tools.Tool
 
 	// Single Session tools
 	evalInGlobalMATLABSessionTool                  tools.Tool
@@ -42,9 +52,16 @@ type Configurator struct {
 	queryVMCBlockHelpTool                          tools.Tool
 
 	// Resources
-	codingGuidelinesResource resources.Resource
-	vmcBlockHelpResource     resources.Resource
-	vmcHubAPIResource        resources.Resource
+	codingGuidelinesResource     resources.Resource
+	vmcBlockHelpResource         resources.Resource
+	vmcBlockIndexResource        resources.Resource
+	vmcBlockPageResourceTemplate resources.Resource
+	vmcHubAPIResource            resources.Resource
+
+	// Prompts
+	createHLSModelPrompt     prompts.Prompt
+	debugVMCSimulationPrompt prompts.Prompt
+	configureHubBlockPrompt  prompts.Prompt
 }
 
 func New(
@@ -54,6 +71,8 @@ func New(
 	startMATLABSessionTool *startmatlabsession.Tool,
 	stopMATLABSessionTool *stopmatlabsession.Tool,
 	evalInMATLABSessionTool *evalmatlabcodemultisession.Tool,
+	listMATLABSessionsTool *listmatlabsessions.Tool,
+	attachMATLABSessionTool *attachmatlabsession.Tool,
 
 	evalInGlobalMATLABSessionTool *evalmatlabcodesinglesession.Tool,
 	checkMATLABCodeInGlobalMATLABSession *checkmatlabcode.Tool,
@@ -64,7 +83,13 @@ func New(
 
 	codingGuidelinesResource *codingguidelines.Resource,
 	vmcBlockHelpResource *vmcblockhelp.Resource,
+	vmcBlockIndexResource *vmcblockindex.Resource,
+	vmcBlockPageResourceTemplate *vmcblockpage.ResourceTemplate,
 	vmcHubAPIResource *vmchubapi.Resource,
+
+	createHLSModelPrompt *createhlsmodel.Prompt,
+	debugVMCSimulationPrompt *debugvmcsimulation.Prompt,
+	configureHubBlockPrompt *configurehubblock.Prompt,
 ) *Configurator {
 	return &Configurator{
 		config: config,
@@ -73,6 +98,8 @@ func New(
 		startMATLABSessionTool:   startMATLABSessionTool,
 		stopMATLABSessionTool:    stopMATLABSessionTool,
 		evalInMATLABSessionTool:  evalInMATLABSessionTool,
+		listMATLABSessionsTool:   listMATLABSessionsTool,
+		attachMATLABSessionTool:  attachMATLABSessionTool,
 
 		evalInGlobalMATLABSessionTool:                  evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSessionTool:       checkMATLABCodeInGlobalMATLABSession,
@@ -81,9 +108,15 @@ func New(
 		runMATLABTestFileInGlobalMATLABSessionTool:     runMATLABTestFileInGlobalMATLABSessionTool,
 		queryVMCBlockHelpTool:                          queryVMCBlockHelpTool,
 
-		codingGuidelinesResource: codingGuidelinesResource,
-		vmcBlockHelpResource:     vmcBlockHelpResource,
-		vmcHubAPIResource:        vmcHubAPIResource,
+		codingGuidelinesResource:     codingGuidelinesResource,
+		vmcBlockHelpResource:         vmcBlockHelpResource,
+		vmcBlockIndexResource:        vmcBlockIndexResource,
+		vmcBlockPageResourceTemplate: vmcBlockPageResourceTemplate,
+		vmcHubAPIResource:            vmcHubAPIResource,
+
+		createHLSModelPrompt:     createHLSModelPrompt,
+		debugVMCSimulationPrompt: debugVMCSimulationPrompt,
+		configureHubBlockPrompt:  configureHubBlockPrompt,
 	}
 }
 
@@ -106,6 +139,8 @@ func (c *Configurator) GetToolsToAdd() []tools.Tool {
 		c.startMATLABSessionTool,
 		c.stopMATLABSessionTool,
 		c.evalInMATLABSessionTool,
+		c.listMATLABSessionsTool,
+		c.attachMATLABSessionTool,
 	}
 }
 
@@ -113,6 +148,16 @@ func (c *Configurator) GetResourcesToAdd() []resources.Resource {
 	return []resources.Resource{
 		c.codingGuidelinesResource,
 		c.vmcBlockHelpResource,
+		c.vmcBlockIndexResource,
+		c.vmcBlockPageResourceTemplate,
 		c.vmcHubAPIResource,
 	}
 }
+
+func (c *Configurator) GetPromptsToAdd() []prompts.Prompt {
+	return []prompts.Prompt{
+		c.createHLSModelPrompt,
+		c.debugVMCSimulationPrompt,
+		c.configureHubBlockPrompt,
+	}
+}
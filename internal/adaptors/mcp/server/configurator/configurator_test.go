@@ -5,18 +5,28 @@ package configurator_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/configurehubblock"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/createhlsmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugvmcsimulation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockindex"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockpage"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmchubapi"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/attachmatlabsession"
 	evalmatlabmultisession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	evalmatlabsinglesession "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/queryvmcblockhelp"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/configurator"
@@ -33,13 +43,22 @@ func TestNew_HappyPath(t *testing.T) {
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	attachMATLABSessionTool := &attachmatlabsession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	queryVMCBlockHelpTool := &queryvmcblockhelp.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	vmcBlockHelpResource := &vmcblockhelp.Resource{}
+	vmcBlockIndexResource := &vmcblockindex.Resource{}
+	vmcBlockPageResourceTemplate := &vmcblockpage.ResourceTemplate{}
+	vmcHubAPIResource := &vmchubapi.Resource{}
+	createHLSModelPrompt := &createhlsmodel.Prompt{}
+	debugVMCSimulationPrompt := &debugvmcsimulation.Prompt{}
+	configureHubBlockPrompt := &configurehubblock.Prompt{}
 
 	// Act
 	result := configurator.New(
@@ -48,13 +67,22 @@ func TestNew_HappyPath(t *testing.T) {
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		listMATLABSessionsTool,
+		attachMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		queryVMCBlockHelpTool,
 		codingGuidelinesResource,
 		vmcBlockHelpResource,
+		vmcBlockIndexResource,
+		vmcBlockPageResourceTemplate,
+		vmcHubAPIResource,
+		createHLSModelPrompt,
+		debugVMCSimulationPrompt,
+		configureHubBlockPrompt,
 	)
 
 	// Assert
@@ -70,13 +98,22 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	attachMATLABSessionTool := &attachmatlabsession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	queryVMCBlockHelpTool := &queryvmcblockhelp.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	vmcBlockHelpResource := &vmcblockhelp.Resource{}
+	vmcBlockIndexResource := &vmcblockindex.Resource{}
+	vmcBlockPageResourceTemplate := &vmcblockpage.ResourceTemplate{}
+	vmcHubAPIResource := &vmchubapi.Resource{}
+	createHLSModelPrompt := &createhlsmodel.Prompt{}
+	debugVMCSimulationPrompt := &debugvmcsimulation.Prompt{}
+	configureHubBlockPrompt := &configurehubblock.Prompt{}
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
@@ -89,13 +126,22 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		listMATLABSessionsTool,
+		attachMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		queryVMCBlockHelpTool,
 		codingGuidelinesResource,
 		vmcBlockHelpResource,
+		vmcBlockIndexResource,
+		vmcBlockPageResourceTemplate,
+		vmcHubAPIResource,
+		createHLSModelPrompt,
+		debugVMCSimulationPrompt,
+		configureHubBlockPrompt,
 	)
 
 	// Act
@@ -107,6 +153,8 @@ func TestConfigurator_GetToolsToAdd_MultipleMATLABSession_HappyPath(t *testing.T
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		listMATLABSessionsTool,
+		attachMATLABSessionTool,
 	}, "GetToolsToAdd should return all the injected tools for multi session")
 }
 
@@ -119,13 +167,22 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	attachMATLABSessionTool := &attachmatlabsession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	queryVMCBlockHelpTool := &queryvmcblockhelp.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	vmcBlockHelpResource := &vmcblockhelp.Resource{}
+	vmcBlockIndexResource := &vmcblockindex.Resource{}
+	vmcBlockPageResourceTemplate := &vmcblockpage.ResourceTemplate{}
+	vmcHubAPIResource := &vmchubapi.Resource{}
+	createHLSModelPrompt := &createhlsmodel.Prompt{}
+	debugVMCSimulationPrompt := &debugvmcsimulation.Prompt{}
+	configureHubBlockPrompt := &configurehubblock.Prompt{}
 
 	mockConfig.EXPECT().
 		UseSingleMATLABSession().
@@ -138,13 +195,22 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		listMATLABSessionsTool,
+		attachMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		queryVMCBlockHelpTool,
 		codingGuidelinesResource,
 		vmcBlockHelpResource,
+		vmcBlockIndexResource,
+		vmcBlockPageResourceTemplate,
+		vmcHubAPIResource,
+		createHLSModelPrompt,
+		debugVMCSimulationPrompt,
+		configureHubBlockPrompt,
 	)
 
 	// Act
@@ -157,6 +223,7 @@ func TestConfigurator_GetToolsToAdd_SingleMATLABSession_HappyPath(t *testing.T)
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
 		detectMATLABToolboxesInSingleSessionTool,
+		queryVMCBlockHelpTool,
 	}, "GetToolsToAdd should all injected tools for single session")
 }
 
@@ -169,13 +236,22 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 	startMATLABSessionTool := &startmatlabsession.Tool{}
 	stopMATLABSessionTool := &stopmatlabsession.Tool{}
 	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	attachMATLABSessionTool := &attachmatlabsession.Tool{}
 	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
 	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
 	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
 	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
 	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	queryVMCBlockHelpTool := &queryvmcblockhelp.Tool{}
 	codingGuidelinesResource := &codingguidelines.Resource{}
 	vmcBlockHelpResource := &vmcblockhelp.Resource{}
+	vmcBlockIndexResource := &vmcblockindex.Resource{}
+	vmcBlockPageResourceTemplate := &vmcblockpage.ResourceTemplate{}
+	vmcHubAPIResource := &vmchubapi.Resource{}
+	createHLSModelPrompt := &createhlsmodel.Prompt{}
+	debugVMCSimulationPrompt := &debugvmcsimulation.Prompt{}
+	configureHubBlockPrompt := &configurehubblock.Prompt{}
 
 	c := configurator.New(
 		mockConfig,
@@ -183,18 +259,84 @@ func TestConfigurator_GetResourcesToAdd_HappyPath(t *testing.T) {
 		startMATLABSessionTool,
 		stopMATLABSessionTool,
 		evalInMATLABSessionTool,
+		listMATLABSessionsTool,
+		attachMATLABSessionTool,
 		evalInGlobalMATLABSessionTool,
 		checkMATLABCodeInGlobalMATLABSession,
 		detectMATLABToolboxesInSingleSessionTool,
 		runMATLABFileInGlobalMATLABSessionTool,
 		runMATLABTestFileInGlobalMATLABSessionTool,
+		queryVMCBlockHelpTool,
 		codingGuidelinesResource,
 		vmcBlockHelpResource,
+		vmcBlockIndexResource,
+		vmcBlockPageResourceTemplate,
+		vmcHubAPIResource,
+		createHLSModelPrompt,
+		debugVMCSimulationPrompt,
+		configureHubBlockPrompt,
 	)
 
 	// Act
 	result := c.GetResourcesToAdd()
 
 	// Assert
-	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, vmcBlockHelpResource}, result)
+	assert.ElementsMatch(t, []resources.Resource{codingGuidelinesResource, vmcBlockHelpResource, vmcBlockIndexResource, vmcBlockPageResourceTemplate, vmcHubAPIResource}, result)
+}
+
+func TestConfigurator_GetPromptsToAdd_HappyPath(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	listAvailableMATLABsTool := &listavailablematlabs.Tool{}
+	startMATLABSessionTool := &startmatlabsession.Tool{}
+	stopMATLABSessionTool := &stopmatlabsession.Tool{}
+	evalInMATLABSessionTool := &evalmatlabmultisession.Tool{}
+	listMATLABSessionsTool := &listmatlabsessions.Tool{}
+	attachMATLABSessionTool := &attachmatlabsession.Tool{}
+	evalInGlobalMATLABSessionTool := &evalmatlabsinglesession.Tool{}
+	checkMATLABCodeInGlobalMATLABSession := &checkmatlabcode.Tool{}
+	detectMATLABToolboxesInSingleSessionTool := &detectmatlabtoolboxes.Tool{}
+	runMATLABFileInGlobalMATLABSessionTool := &runmatlabfile.Tool{}
+	runMATLABTestFileInGlobalMATLABSessionTool := &runmatlabtestfile.Tool{}
+	queryVMCBlockHelpTool := &queryvmcblockhelp.Tool{}
+	codingGuidelinesResource := &codingguidelines.Resource{}
+	vmcBlockHelpResource := &vmcblockhelp.Resource{}
+	vmcBlockIndexResource := &vmcblockindex.Resource{}
+	vmcBlockPageResourceTemplate := &vmcblockpage.ResourceTemplate{}
+	vmcHubAPIResource := &vmchubapi.Resource{}
+	createHLSModelPrompt := &createhlsmodel.Prompt{}
+	debugVMCSimulationPrompt := &debugvmcsimulation.Prompt{}
+	configureHubBlockPrompt := &configurehubblock.Prompt{}
+
+	c := configurator.New(
+		mockConfig,
+		listAvailableMATLABsTool,
+		startMATLABSessionTool,
+		stopMATLABSessionTool,
+		evalInMATLABSessionTool,
+		listMATLABSessionsTool,
+		attachMATLABSessionTool,
+		evalInGlobalMATLABSessionTool,
+		checkMATLABCodeInGlobalMATLABSession,
+		detectMATLABToolboxesInSingleSessionTool,
+		runMATLABFileInGlobalMATLABSessionTool,
+		runMATLABTestFileInGlobalMATLABSessionTool,
+		queryVMCBlockHelpTool,
+		codingGuidelinesResource,
+		vmcBlockHelpResource,
+		vmcBlockIndexResource,
+		vmcBlockPageResourceTemplate,
+		vmcHubAPIResource,
+		createHLSModelPrompt,
+		debugVMCSimulationPrompt,
+		configureHubBlockPrompt,
+	)
+
+	// Act
+	result := c.GetPromptsToAdd()
+
+	// Assert
+	assert.ElementsMatch(t, []prompts.Prompt{createHLSModelPrompt, debugVMCSimulationPrompt, configureHubBlockPrompt}, result)
 }
@@ -0,0 +1,241 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package httpauth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+const (
+	tokenFileName = "auth-token"
+	tokenFileExt  = ".txt"
+	tokenByteSize = 32
+
+	bearerPrefix = "Bearer "
+)
+
+// Hosts that always resolve to the local machine, and can therefore not be used for DNS rebinding.
+var loopbackHosts = []string{"localhost", "127.0.0.1", "::1"}
+
+type Config interface {
+	ListenAddress() string
+	AuthToken() string
+	AllowedOrigins() []string
+}
+
+type Directory interface {
+	BaseDir() string
+	ID() string
+}
+
+type FilenameFactory interface {
+	FilenameWithSuffix(fileName string, ext string, suffix string) string
+}
+
+type OSLayer interface {
+	WriteFile(name string, data []byte, perm os.FileMode) error
+	Stderr() io.Writer
+}
+
+type LoggerFactory interface {
+	GetGlobalLogger() entities.Logger
+}
+
+// Authenticator guards network transports of the MCP server.
+// Every request must carry the server bearer token, and its Host and Origin headers must be trusted,
+// to protect against DNS rebinding:
+//
+// https://modelcontextprotocol.io/specification/2025-06-18/basic/transports#security-warning
+type Authenticator struct {
+	config          Config
+	directory       Directory
+	filenameFactory FilenameFactory
+	osLayer         OSLayer
+	logger          entities.Logger
+
+	setupOnce *sync.Once
+	setupErr  error
+	token     string
+
+	allowAnyHost   bool
+	allowedHosts   map[string]struct{}
+	allowedOrigins map[string]struct{}
+}
+
+func New(
+	config Config,
+	directory Directory,
+	filenameFactory FilenameFactory,
+	osLayer OSLayer,
+	loggerFactory LoggerFactory,
+) *Authenticator {
+	return &Authenticator{
+		config:          config,
+		directory:       directory,
+		filenameFactory: filenameFactory,
+		osLayer:         osLayer,
+		logger:          loggerFactory.GetGlobalLogger(),
+
+		setupOnce: new(sync.Once),
+	}
+}
+
+// Wrap returns a handler that only forwards authenticated and trusted requests to next.
+// The token is only generated, printed and written to disk the first time Wrap is called,
+// so that transports without network exposure never create one.
+func (a *Authenticator) Wrap(next http.Handler) (http.Handler, error) {
+	a.setupOnce.Do(func() {
+		a.setupErr = a.setup()
+	})
+	if a.setupErr != nil {
+		return nil, a.setupErr
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if reason, ok := a.checkHost(r); !ok {
+			a.reject(w, r, http.StatusForbidden, reason)
+			return
+		}
+
+		if reason, ok := a.checkOrigin(r); !ok {
+			a.reject(w, r, http.StatusForbidden, reason)
+			return
+		}
+
+		if reason, ok := a.checkToken(r); !ok {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			a.reject(w, r, http.StatusUnauthorized, reason)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}), nil
+}
+
+func (a *Authenticator) setup() error {
+	listenHost, _, err := net.SplitHostPort(a.config.ListenAddress())
+	if err != nil {
+		return fmt.Errorf("invalid listen address: %w", err)
+	}
+
+	a.allowedHosts = make(map[string]struct{})
+	for _, host := range loopbackHosts {
+		a.allowedHosts[host] = struct{}{}
+	}
+
+	// When listening on all interfaces, the Host header can't be matched against a known name.
+	// The bearer token is then the only protection.
+	listenIP := net.ParseIP(listenHost)
+	a.allowAnyHost = listenHost == "" || (listenIP != nil && listenIP.IsUnspecified())
+	a.allowedHosts[strings.ToLower(listenHost)] = struct{}{}
+
+	a.allowedOrigins = make(map[string]struct{})
+	for _, origin := range a.config.AllowedOrigins() {
+		a.allowedOrigins[strings.ToLower(strings.TrimSuffix(origin, "/"))] = struct{}{}
+	}
+
+	token := a.config.AuthToken()
+	if token == "" {
+		token, err = newToken()
+		if err != nil {
+			return err
+		}
+	}
+	a.token = token
+
+	tokenFilePath := a.filenameFactory.FilenameWithSuffix(filepath.Join(a.directory.BaseDir(), tokenFileName), tokenFileExt, a.directory.ID())
+	if err := a.osLayer.WriteFile(tokenFilePath, []byte(token), 0o600); err != nil {
+		a.logger.WithError(err).With("path", tokenFilePath).Error("Failed to write MCP server auth token file")
+		return err
+	}
+
+	// The token is deliberately kept out of the log file.
+	_, err = fmt.Fprintf(a.osLayer.Stderr(), "MCP server auth token: %s\nClients must send it as \"Authorization: Bearer <token>\". It is also stored in %s\n", token, tokenFilePath)
+	if err != nil {
+		return err
+	}
+
+	a.logger.With("path", tokenFilePath).Info("MCP server auth token written to file")
+
+	return nil
+}
+
+func (a *Authenticator) checkHost(r *http.Request) (string, bool) {
+	if a.allowAnyHost {
+		return "", true
+	}
+
+	host := r.Host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	host = strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"))
+
+	if _, ok := a.allowedHosts[host]; !ok {
+		return "untrusted host header", false
+	}
+
+	return "", true
+}
+
+func (a *Authenticator) checkOrigin(r *http.Request) (string, bool) {
+	origin := r.Header.Get("Origin")
+
+	// Non-browser clients do not send an Origin header.
+	if origin == "" {
+		return "", true
+	}
+
+	if _, ok := a.allowedOrigins[strings.ToLower(strings.TrimSuffix(origin, "/"))]; !ok {
+		return "untrusted origin header", false
+	}
+
+	return "", true
+}
+
+func (a *Authenticator) checkToken(r *http.Request) (string, bool) {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return "missing bearer token", false
+	}
+
+	token := strings.TrimPrefix(authorization, bearerPrefix)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		return "invalid bearer token", false
+	}
+
+	return "", true
+}
+
+func (a *Authenticator) reject(w http.ResponseWriter, r *http.Request, statusCode int, reason string) {
+	a.logger.
+		With("remote-address", r.RemoteAddr).
+		With("method", r.Method).
+		With("host", r.Host).
+		With("origin", r.Header.Get("Origin")).
+		With("reason", reason).
+		Warn("Rejected MCP HTTP request")
+
+	http.Error(w, http.StatusText(statusCode), statusCode)
+}
+
+func newToken() (string, error) {
+	tokenBytes := make([]byte, tokenByteSize)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(tokenBytes), nil
+}
@@ -0,0 +1,8 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package httpauth
+
+const (
+	TokenFileName = tokenFileName
+	TokenFileExt  = tokenFileExt
+)
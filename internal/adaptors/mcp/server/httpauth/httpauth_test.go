@@ -0,0 +1,458 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package httpauth_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/httpauth"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server/httpauth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type authenticatorTestSetup struct {
+	authenticator *httpauth.Authenticator
+	logger        *testutils.InspectableLogger
+	stderr        *bytes.Buffer
+	tokenFilePath string
+	writtenToken  *[]byte
+}
+
+func newAuthenticatorTestSetup(t *testing.T, listenAddress string, configuredToken string, allowedOrigins []string) authenticatorTestSetup {
+	t.Helper()
+
+	mockConfig := &mocks.MockConfig{}
+	mockDirectory := &mocks.MockDirectory{}
+	mockFilenameFactory := &mocks.MockFilenameFactory{}
+	mockOSLayer := &mocks.MockOSLayer{}
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	t.Cleanup(func() {
+		mockConfig.AssertExpectations(t)
+		mockDirectory.AssertExpectations(t)
+		mockFilenameFactory.AssertExpectations(t)
+		mockOSLayer.AssertExpectations(t)
+		mockLoggerFactory.AssertExpectations(t)
+	})
+
+	mockLogger := testutils.NewInspectableLogger()
+	stderr := new(bytes.Buffer)
+
+	baseDir := filepath.Join("tmp", "logs")
+	id := "1337"
+	expectedTokenFileBase := filepath.Join(baseDir, httpauth.TokenFileName)
+	tokenFilePath := filepath.Join(baseDir, "auth-token-1337.txt")
+	writtenToken := new([]byte)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return(listenAddress).
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedOrigins().
+		Return(allowedOrigins).
+		Once()
+
+	mockConfig.EXPECT().
+		AuthToken().
+		Return(configuredToken).
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(baseDir).
+		Once()
+
+	mockDirectory.EXPECT().
+		ID().
+		Return(id).
+		Once()
+
+	mockFilenameFactory.EXPECT().
+		FilenameWithSuffix(expectedTokenFileBase, httpauth.TokenFileExt, id).
+		Return(tokenFilePath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(tokenFilePath, mock.Anything, os.FileMode(0o600)).
+		Run(func(name string, data []byte, perm os.FileMode) {
+			*writtenToken = data
+		}).
+		Return(nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(stderr).
+		Once()
+
+	return authenticatorTestSetup{
+		authenticator: httpauth.New(mockConfig, mockDirectory, mockFilenameFactory, mockOSLayer, mockLoggerFactory),
+		logger:        mockLogger,
+		stderr:        stderr,
+		tokenFilePath: tokenFilePath,
+		writtenToken:  writtenToken,
+	}
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func doRequest(t *testing.T, serverURL string, host string, origin string, token string) *http.Response {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(t.Context(), http.MethodPost, serverURL, strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	if host != "" {
+		request.Host = host
+	}
+	if origin != "" {
+		request.Header.Set("Origin", origin)
+	}
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	require.NoError(t, response.Body.Close())
+
+	return response
+}
+
+func TestAuthenticator_Wrap_GeneratedToken(t *testing.T) {
+	// Arrange
+	setup := newAuthenticatorTestSetup(t, "127.0.0.1:8765", "", nil)
+
+	// Act
+	handler, err := setup.authenticator.Wrap(okHandler())
+
+	// Assert
+	require.NoError(t, err)
+
+	token := string(*setup.writtenToken)
+	assert.Len(t, token, 64, "Generated token should be 32 random bytes, hex encoded")
+	assert.Contains(t, setup.stderr.String(), token, "Token should be printed to stderr")
+	assert.Contains(t, setup.stderr.String(), setup.tokenFilePath, "Token file path should be printed to stderr")
+
+	for _, fields := range setup.logger.InfoLogs() {
+		for _, value := range fields {
+			assert.NotEqual(t, token, value, "Token must not be logged")
+		}
+	}
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	response := doRequest(t, server.URL, "", "", token)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestAuthenticator_Wrap_ConfiguredToken(t *testing.T) {
+	// Arrange
+	configuredToken := "configured-token"
+	setup := newAuthenticatorTestSetup(t, "127.0.0.1:8765", configuredToken, nil)
+
+	handler, err := setup.authenticator.Wrap(okHandler())
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// Act
+	response := doRequest(t, server.URL, "", "", configuredToken)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, configuredToken, string(*setup.writtenToken))
+}
+
+func TestAuthenticator_Wrap_SetupHappensOnce(t *testing.T) {
+	// Arrange
+	setup := newAuthenticatorTestSetup(t, "127.0.0.1:8765", "token", nil)
+
+	_, err := setup.authenticator.Wrap(okHandler())
+	require.NoError(t, err)
+
+	// Act
+	_, err = setup.authenticator.Wrap(okHandler())
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestAuthenticator_Wrap_RejectsMissingOrInvalidToken(t *testing.T) {
+	testConfigs := []struct {
+		name           string
+		token          string
+		expectedReason string
+	}{
+		{
+			name:           "missing token",
+			token:          "",
+			expectedReason: "missing bearer token",
+		},
+		{
+			name:           "invalid token",
+			token:          "not-the-token",
+			expectedReason: "invalid bearer token",
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			setup := newAuthenticatorTestSetup(t, "127.0.0.1:8765", "token", nil)
+
+			handler, err := setup.authenticator.Wrap(okHandler())
+			require.NoError(t, err)
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			// Act
+			response := doRequest(t, server.URL, "", "", testConfig.token)
+
+			// Assert
+			assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+			assert.Equal(t, "Bearer", response.Header.Get("WWW-Authenticate"))
+
+			fields, found := setup.logger.WarnLogs()["Rejected MCP HTTP request"]
+			require.True(t, found, "Rejected request should be logged")
+			assert.Equal(t, testConfig.expectedReason, fields["reason"])
+		})
+	}
+}
+
+func TestAuthenticator_Wrap_HostCheck(t *testing.T) {
+	testConfigs := []struct {
+		name               string
+		listenAddress      string
+		host               string
+		expectedStatusCode int
+	}{
+		{
+			name:               "loopback name",
+			listenAddress:      "127.0.0.1:8765",
+			host:               "localhost:8765",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "IPv6 loopback",
+			listenAddress:      "127.0.0.1:8765",
+			host:               "[::1]:8765",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "listen host",
+			listenAddress:      "myworkstation:8765",
+			host:               "MyWorkstation:8765",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "rebound host",
+			listenAddress:      "127.0.0.1:8765",
+			host:               "attacker.example.com:8765",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "any host when listening on all interfaces",
+			listenAddress:      "0.0.0.0:8765",
+			host:               "myworkstation.example.com:8765",
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			setup := newAuthenticatorTestSetup(t, testConfig.listenAddress, "token", nil)
+
+			handler, err := setup.authenticator.Wrap(okHandler())
+			require.NoError(t, err)
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			// Act
+			response := doRequest(t, server.URL, testConfig.host, "", "token")
+
+			// Assert
+			assert.Equal(t, testConfig.expectedStatusCode, response.StatusCode)
+		})
+	}
+}
+
+func TestAuthenticator_Wrap_OriginCheck(t *testing.T) {
+	testConfigs := []struct {
+		name               string
+		origin             string
+		expectedStatusCode int
+	}{
+		{
+			name:               "no origin",
+			origin:             "",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "allowed origin",
+			origin:             "http://localhost:6274",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "allowed origin with trailing slash",
+			origin:             "http://localhost:6274/",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "untrusted origin",
+			origin:             "http://attacker.example.com",
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, testConfig := range testConfigs {
+		t.Run(testConfig.name, func(t *testing.T) {
+			// Arrange
+			setup := newAuthenticatorTestSetup(t, "127.0.0.1:8765", "token", []string{"http://localhost:6274"})
+
+			handler, err := setup.authenticator.Wrap(okHandler())
+			require.NoError(t, err)
+
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			// Act
+			response := doRequest(t, server.URL, "", testConfig.origin, "token")
+
+			// Assert
+			assert.Equal(t, testConfig.expectedStatusCode, response.StatusCode)
+		})
+	}
+}
+
+func TestAuthenticator_Wrap_WriteFileError(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockFilenameFactory := &mocks.MockFilenameFactory{}
+	defer mockFilenameFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+	tokenFilePath := filepath.Join("tmp", "logs", "auth-token-1337.txt")
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("127.0.0.1:8765").
+		Once()
+
+	mockConfig.EXPECT().
+		AllowedOrigins().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		AuthToken().
+		Return("token").
+		Once()
+
+	mockDirectory.EXPECT().
+		BaseDir().
+		Return(filepath.Join("tmp", "logs")).
+		Once()
+
+	mockDirectory.EXPECT().
+		ID().
+		Return("1337").
+		Once()
+
+	mockFilenameFactory.EXPECT().
+		FilenameWithSuffix(mock.Anything, httpauth.TokenFileExt, "1337").
+		Return(tokenFilePath).
+		Once()
+
+	mockOSLayer.EXPECT().
+		WriteFile(tokenFilePath, []byte("token"), os.FileMode(0o600)).
+		Return(expectedError).
+		Once()
+
+	authenticator := httpauth.New(mockConfig, mockDirectory, mockFilenameFactory, mockOSLayer, mockLoggerFactory)
+
+	// Act
+	handler, err := authenticator.Wrap(okHandler())
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Nil(t, handler)
+}
+
+func TestAuthenticator_Wrap_InvalidListenAddress(t *testing.T) {
+	// Arrange
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockDirectory := &mocks.MockDirectory{}
+	defer mockDirectory.AssertExpectations(t)
+
+	mockFilenameFactory := &mocks.MockFilenameFactory{}
+	defer mockFilenameFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(testutils.NewInspectableLogger()).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("localhost").
+		Once()
+
+	authenticator := httpauth.New(mockConfig, mockDirectory, mockFilenameFactory, mockOSLayer, mockLoggerFactory)
+
+	// Act
+	handler, err := authenticator.Wrap(okHandler())
+
+	// Assert
+	require.ErrorContains(t, err, "invalid listen address")
+	assert.Nil(t, handler)
+}
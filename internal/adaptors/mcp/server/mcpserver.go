@@ -3,6 +3,8 @@
 package server
 
 import (
+	"context"
+
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -10,13 +12,26 @@ type ServerConfig interface {
 	Version() string
 }
 
-func NewMCPSDKServer(config ServerConfig) *mcp.Server {
+type Completer interface {
+	Complete(ctx context.Context, req *mcp.CompleteRequest) (*mcp.CompleteResult, error)
+}
+
+type RootsTracker interface {
+	Middleware(next mcp.MethodHandler) mcp.MethodHandler
+	HandleRootsListChanged(ctx context.Context, req *mcp.RootsListChangedRequest)
+}
+
+func NewMCPSDKServer(config ServerConfig, completer Completer, rootsTracker RootsTracker) *mcp.Server {
 	impl := &mcp.Implementation{
 		Name:    name,
 		Version: config.Version(),
 	}
 	options := &mcp.ServerOptions{
-		Instructions: instructions,
+		Instructions:            instructions,
+		CompletionHandler:       completer.Complete,
+		RootsListChangedHandler: rootsTracker.HandleRootsListChanged,
 	}
-	return mcp.NewServer(impl, options)
+	server := mcp.NewServer(impl, options)
+	server.AddReceivingMiddleware(rootsTracker.Middleware)
+	return server
 }
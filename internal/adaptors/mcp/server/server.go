@@ -4,13 +4,32 @@ package server
 
 import (
 	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+const (
+	httpReadHeaderTimeout = 10 * time.Second
+	httpShutdownTimeout   = 30 * time.Second
+)
+
+type Config interface {
+	Transport() entities.TransportMode
+	ListenAddress() string
+}
+
+type HTTPAuthenticator interface {
+	Wrap(next http.Handler) (http.Handler, error)
+}
+
 type LoggerFactory interface {
 	GetGlobalLogger() entities.Logger
 }
@@ -22,6 +41,7 @@ type LifecycleSignaler interface {
 type MCPServerConfigurator interface {
 	GetToolsToAdd() []tools.Tool
 	GetResourcesToAdd() []resources.Resource
+	GetPromptsToAdd() []prompts.Prompt
 }
 
 type Server struct {
@@ -29,6 +49,11 @@ type Server struct {
 	serverLogger      entities.Logger
 	lifecycleSignaler LifecycleSignaler
 	serverTransport   mcp.Transport
+
+	transportMode     entities.TransportMode
+	listenAddress     string
+	listen            func(network, address string) (net.Listener, error)
+	httpAuthenticator HTTPAuthenticator
 }
 
 func New(
@@ -36,6 +61,8 @@ func New(
 	loggerFactory LoggerFactory,
 	lifecycleSignaler LifecycleSignaler,
 	configurator MCPServerConfigurator,
+	config Config,
+	httpAuthenticator HTTPAuthenticator,
 ) (*Server, error) {
 	logger := loggerFactory.GetGlobalLogger()
 
@@ -53,15 +80,34 @@ func New(
 	}
 	logger.With("count", len(resourcesToAdd)).Info("Added resources to MCP SDK server")
 
+	promptsToAdd := configurator.GetPromptsToAdd()
+	for _, prompt := range promptsToAdd {
+		prompt.AddToServer(mcpserver)
+	}
+	logger.With("count", len(promptsToAdd)).Info("Added prompts to MCP SDK server")
+
 	return &Server{
 		mcpServer:         mcpserver,
 		serverLogger:      logger,
 		lifecycleSignaler: lifecycleSignaler,
 		serverTransport:   &mcp.StdioTransport{},
+
+		transportMode:     config.Transport(),
+		listenAddress:     config.ListenAddress(),
+		listen:            net.Listen,
+		httpAuthenticator: httpAuthenticator,
 	}, nil
 }
 
 func (s *Server) Run() error {
+	if s.transportMode == entities.TransportModeHTTP {
+		return s.runStreamableHTTP()
+	}
+
+	return s.runWithTransport()
+}
+
+func (s *Server) runWithTransport() error {
 	s.serverLogger.Debug("Starting MCP server")
 
 	ctx, stopServer := context.WithCancel(context.Background())
@@ -93,3 +139,66 @@ func (s *Server) Run() error {
 
 	return nil
 }
+
+// runStreamableHTTP serves the MCP streamable HTTP transport, so that several clients can share this server:
+//
+// https://modelcontextprotocol.io/specification/2025-06-18/basic/transports#streamable-http
+func (s *Server) runStreamableHTTP() error {
+	s.serverLogger.With("address", s.listenAddress).Debug("Starting MCP server")
+
+	handler, err := s.httpAuthenticator.Wrap(mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server {
+		return s.mcpServer
+	}, nil))
+	if err != nil {
+		s.serverLogger.WithError(err).Error("Failed to set up MCP server authentication")
+		return err
+	}
+
+	listener, err := s.listen("tcp", s.listenAddress)
+	if err != nil {
+		s.serverLogger.WithError(err).With("address", s.listenAddress).Error("Failed to listen for MCP connections")
+		return err
+	}
+
+	httpServer := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+	}
+
+	// This channel only closes when we exit the Run method
+	// This ensures that the HTTP server has stopped accepting and serving requests
+	serverShutdownC := make(chan struct{})
+	defer close(serverShutdownC)
+
+	serverErrC := make(chan error)
+	go func() {
+		serverErrC <- httpServer.Serve(listener)
+	}()
+	s.serverLogger.With("address", listener.Addr().String()).Info("Started MCP server, listening for streamable HTTP connections")
+
+	s.lifecycleSignaler.AddShutdownFunction(func() error {
+		s.serverLogger.Debug("Stopping MCP server")
+
+		// Open sessions keep long-lived streams alive, which would otherwise block the HTTP server shutdown.
+		for session := range s.mcpServer.Sessions() {
+			if err := session.Close(); err != nil {
+				s.serverLogger.WithError(err).With("session_id", session.ID()).Warn("Failed to close MCP session")
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+		defer cancel()
+
+		err := httpServer.Shutdown(ctx)
+		<-serverShutdownC
+		s.serverLogger.Debug("Stopped MCP server")
+		return err
+	})
+
+	if err := <-serverErrC; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.serverLogger.WithError(err).Error("MCP server run method returned an unexpected error")
+		return err
+	}
+
+	return nil
+}
@@ -3,9 +3,15 @@
 package server
 
 import (
+	"net"
+
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 func (s *Server) SetServerTransport(serverTransport mcp.Transport) {
 	s.serverTransport = serverTransport
 }
+
+func (s *Server) SetListenFunc(listen func(network, address string) (net.Listener, error)) {
+	s.listen = listen
+}
@@ -3,12 +3,17 @@
 package server_test
 
 import (
+	"net"
+	"net/http"
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	promptmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/prompts"
 	resourcemocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/resources"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/server"
 	toolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools"
@@ -32,6 +37,9 @@ func TestNew_HappyPath(t *testing.T) {
 	mockResource := &resourcemocks.MockResource{}
 	defer mockResource.AssertExpectations(t)
 
+	mockPrompt := &promptmocks.MockPrompt{}
+	defer mockPrompt.AssertExpectations(t)
+
 	mockFirstTool := &toolsmocks.MockTool{}
 	defer mockFirstTool.AssertExpectations(t)
 
@@ -41,6 +49,12 @@ func TestNew_HappyPath(t *testing.T) {
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockServerConfig.EXPECT().
@@ -48,7 +62,7 @@ func TestNew_HappyPath(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -65,6 +79,11 @@ func TestNew_HappyPath(t *testing.T) {
 		Return([]resources.Resource{mockResource}).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return([]prompts.Prompt{mockPrompt}).
+		Once()
+
 	mockFirstTool.EXPECT().
 		AddToServer(expectedMCPServer).
 		Return(nil).
@@ -80,8 +99,23 @@ func TestNew_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
+	mockPrompt.EXPECT().
+		AddToServer(expectedMCPServer).
+		Return().
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportModeStdio).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("").
+		Once()
+
 	// Act
-	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
 
 	// Assert
 	require.NoError(t, err, "New should not return an error")
@@ -105,6 +139,12 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 	expectedError := assert.AnError
 
@@ -113,7 +153,7 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -131,7 +171,7 @@ func TestNew_AddToServerReturnsError(t *testing.T) {
 		Once()
 
 	// Act
-	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
 
 	// Assert
 	require.Error(t, err, "New should return an error")
@@ -153,6 +193,12 @@ func TestNew_HandlesNoToolsOrResources(t *testing.T) {
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockServerConfig.EXPECT().
@@ -160,7 +206,7 @@ func TestNew_HandlesNoToolsOrResources(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -177,8 +223,23 @@ func TestNew_HandlesNoToolsOrResources(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportModeStdio).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("").
+		Once()
+
 	// Act
-	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
 
 	// Assert
 	require.NoError(t, err, "New should not return an error")
@@ -199,6 +260,12 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	mockServerConfig := &mocks.MockServerConfig{}
 	defer mockServerConfig.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
 	mockLogger := testutils.NewInspectableLogger()
 
 	mockServerConfig.EXPECT().
@@ -206,7 +273,7 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return("1.0.0").
 		Once()
 
-	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig)
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
 
 	mockLoggerFactory.EXPECT().
 		GetGlobalLogger().
@@ -223,6 +290,11 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
 	capturedShutdownFuncC := make(chan func() error)
 	mockLifecycleSignaler.EXPECT().
 		AddShutdownFunction(mock.AnythingOfType("func() error")).
@@ -232,7 +304,17 @@ func TestServer_Run_HappyPath(t *testing.T) {
 		Return().
 		Once()
 
-	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator)
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportModeStdio).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("").
+		Once()
+
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
 	require.NoError(t, err)
 
 	// The MCP STDIO transport will hijack os.Stdout, which will cause issues with code coverage reporting.
@@ -255,3 +337,296 @@ func TestServer_Run_HappyPath(t *testing.T) {
 	serverErr := <-errC
 	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
 }
+
+func TestServer_Run_StreamableHTTP_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	listenAddress := "127.0.0.1:0"
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportModeHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return(listenAddress).
+		Once()
+
+	capturedShutdownFuncC := make(chan func() error)
+	mockLifecycleSignaler.EXPECT().
+		AddShutdownFunction(mock.AnythingOfType("func() error")).
+		Run(func(shutdownFcn func() error) {
+			capturedShutdownFuncC <- shutdownFcn
+		}).
+		Return().
+		Once()
+
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
+	require.NoError(t, err)
+
+	mockHTTPAuthenticator.EXPECT().
+		Wrap(mock.Anything).
+		RunAndReturn(func(next http.Handler) (http.Handler, error) {
+			return next, nil
+		}).
+		Once()
+
+	listenerC := make(chan net.Listener, 1)
+	server.SetListenFunc(func(network, address string) (net.Listener, error) {
+		assert.Equal(t, "tcp", network)
+		assert.Equal(t, listenAddress, address)
+		listener, err := net.Listen(network, address)
+		listenerC <- listener
+		return listener, err
+	})
+
+	errC := make(chan error)
+	go func() {
+		errC <- server.Run()
+	}()
+
+	listener := <-listenerC
+	require.NotNil(t, listener)
+	capturedShutdownFunc := <-capturedShutdownFuncC
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	clientSession, err := client.Connect(t.Context(), &mcp.StreamableClientTransport{
+		Endpoint: "http://" + listener.Addr().String(),
+	}, nil)
+	require.NoError(t, err, "Client should connect over streamable HTTP")
+
+	err = clientSession.Ping(t.Context(), nil)
+	require.NoError(t, err, "Ping over streamable HTTP should succeed")
+
+	// Act
+	err = capturedShutdownFunc()
+
+	// Assert
+	require.NoError(t, err, "Shutdown function should not return an error")
+	serverErr := <-errC
+	require.NoError(t, serverErr, "Server run should exit without error after shutdown")
+}
+
+func TestServer_Run_StreamableHTTP_ListenError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportModeHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("127.0.0.1:0").
+		Once()
+
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
+	require.NoError(t, err)
+
+	mockHTTPAuthenticator.EXPECT().
+		Wrap(mock.Anything).
+		RunAndReturn(func(next http.Handler) (http.Handler, error) {
+			return next, nil
+		}).
+		Once()
+
+	server.SetListenFunc(func(network, address string) (net.Listener, error) {
+		return nil, expectedError
+	})
+
+	// Act
+	err = server.Run()
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the listen error")
+}
+
+func TestServer_Run_StreamableHTTP_AuthenticatorError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLifecycleSignaler := &mocks.MockLifecycleSignaler{}
+	defer mockLifecycleSignaler.AssertExpectations(t)
+
+	mockConfigurator := &mocks.MockMCPServerConfigurator{}
+	defer mockConfigurator.AssertExpectations(t)
+
+	mockServerConfig := &mocks.MockServerConfig{}
+	defer mockServerConfig.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockHTTPAuthenticator := &mocks.MockHTTPAuthenticator{}
+	defer mockHTTPAuthenticator.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedError := assert.AnError
+
+	mockServerConfig.EXPECT().
+		Version().
+		Return("1.0.0").
+		Once()
+
+	expectedMCPServer := server.NewMCPSDKServer(mockServerConfig, &mocks.MockCompleter{}, newPassThroughRootsTracker(t))
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetToolsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetResourcesToAdd().
+		Return(nil).
+		Once()
+
+	mockConfigurator.EXPECT().
+		GetPromptsToAdd().
+		Return(nil).
+		Once()
+
+	mockConfig.EXPECT().
+		Transport().
+		Return(entities.TransportModeHTTP).
+		Once()
+
+	mockConfig.EXPECT().
+		ListenAddress().
+		Return("127.0.0.1:0").
+		Once()
+
+	mockHTTPAuthenticator.EXPECT().
+		Wrap(mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	server, err := server.New(expectedMCPServer, mockLoggerFactory, mockLifecycleSignaler, mockConfigurator, mockConfig, mockHTTPAuthenticator)
+	require.NoError(t, err)
+
+	server.SetListenFunc(func(network, address string) (net.Listener, error) {
+		require.Fail(t, "Server should not listen when authentication setup fails")
+		return nil, nil
+	})
+
+	// Act
+	err = server.Run()
+
+	// Assert
+	require.ErrorIs(t, err, expectedError, "Run should return the authenticator error")
+}
+
+func newPassThroughRootsTracker(t *testing.T) *mocks.MockRootsTracker {
+	t.Helper()
+
+	mockRootsTracker := &mocks.MockRootsTracker{}
+	mockRootsTracker.EXPECT().
+		Middleware(mock.Anything).
+		RunAndReturn(func(next mcp.MethodHandler) mcp.MethodHandler {
+			return next
+		}).
+		Once()
+
+	t.Cleanup(func() { mockRootsTracker.AssertExpectations(t) })
+
+	return mockRootsTracker
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package basetool
+
+import "github.com/modelcontextprotocol/go-sdk/mcp"
+
+// Annotations describe how a tool behaves, so that MCP clients can decide which tool calls need the user's approval.
+// Clients must treat annotations as hints, so they are no substitute for validating tool inputs.
+type Annotations struct {
+	// ReadOnly is true if the tool does not modify MATLAB, the file system or anything else.
+	ReadOnly bool
+
+	// Destructive is true if the tool can delete or overwrite existing data or state.
+	// It is only meaningful when ReadOnly is false.
+	Destructive bool
+
+	// Idempotent is true if calling the tool again with the same arguments has no additional effect.
+	// It is only meaningful when ReadOnly is false.
+	Idempotent bool
+
+	// OpenWorld is true if the tool can interact with external entities, such as the network, rather than only
+	// the MATLAB sessions, files and documentation it is given.
+	OpenWorld bool
+}
+
+func (a Annotations) toMCPToolAnnotations(title string) *mcp.ToolAnnotations {
+	destructive := a.Destructive
+	openWorld := a.OpenWorld
+
+	return &mcp.ToolAnnotations{
+		Title:           title,
+		ReadOnlyHint:    a.ReadOnly,
+		DestructiveHint: &destructive,
+		IdempotentHint:  a.Idempotent,
+		OpenWorldHint:   &openWorld,
+	}
+}
@@ -3,6 +3,8 @@
 package basetool
 
 import (
+	"context"
+
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -23,6 +25,7 @@ type tool[ToolInput any, ToolOutput any] struct {
 	name          string
 	title         string
 	description   string
+	annotations   Annotations
 	loggerFactory LoggerFactory
 	toolAdder     ToolAdder[ToolInput, ToolOutput]
 }
@@ -39,6 +42,20 @@ func (t tool[_, _]) Description() string {
 	return t.description
 }
 
+func (t tool[_, _]) Annotations() Annotations {
+	return t.annotations
+}
+
 func (_ tool[ToolInput, _]) GetInputSchema() (any, error) {
 	return jsonschema.For[ToolInput](&jsonschema.ForOptions{})
 }
+
+// withMCPSession makes the MCP session of the tool call available through ctx, so that handlers can pass it on.
+func withMCPSession(ctx context.Context, req *mcp.CallToolRequest) context.Context {
+	// Avoid storing a typed nil, so that handlers can tell calls without a session apart.
+	if req.Session == nil {
+		return ctx
+	}
+
+	return entities.WithMCPSession(ctx, req.Session)
+}
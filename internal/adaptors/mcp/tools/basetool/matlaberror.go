@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package basetool
+
+import (
+	"encoding/json"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MATLABErrorContent is the structured content of the result of a tool call that failed with an error thrown by MATLAB code.
+type MATLABErrorContent struct {
+	MATLABError MATLABErrorDetails `json:"matlabError"`
+}
+
+type MATLABErrorDetails struct {
+	Identifier string               `json:"identifier,omitempty"`
+	Message    string               `json:"message"`
+	Stack      []MATLABStackFrame   `json:"stack,omitempty"`
+	Causes     []MATLABErrorDetails `json:"causes,omitempty"`
+}
+
+type MATLABStackFrame struct {
+	File string `json:"file,omitempty"`
+	Name string `json:"name,omitempty"`
+	Line int    `json:"line"`
+}
+
+// matlabErrorResult returns an error result that holds the details of a MATLAB error as structured content,
+// so that an agent can go to the line that threw it. The details are repeated as JSON text for clients that only read the text content.
+// Only tools with unstructured content return it, as the structured content of a tool with an output schema must match that schema.
+func matlabErrorResult(err error, matlabError *entities.MATLABError) *mcp.CallToolResult {
+	content := MATLABErrorContent{
+		MATLABError: newMATLABErrorDetails(*matlabError),
+	}
+
+	result := &mcp.CallToolResult{
+		IsError:           true,
+		Content:           []mcp.Content{&mcp.TextContent{Text: err.Error()}},
+		StructuredContent: content,
+	}
+
+	if contentJSON, marshalErr := json.Marshal(content); marshalErr == nil {
+		result.Content = append(result.Content, &mcp.TextContent{Text: string(contentJSON)})
+	}
+
+	return result
+}
+
+func newMATLABErrorDetails(matlabError entities.MATLABError) MATLABErrorDetails {
+	details := MATLABErrorDetails{
+		Identifier: matlabError.Identifier,
+		Message:    matlabError.Message,
+	}
+
+	for _, frame := range matlabError.Stack {
+		details.Stack = append(details.Stack, MATLABStackFrame{
+			File: frame.File,
+			Name: frame.Name,
+			Line: frame.Line,
+		})
+	}
+
+	for _, cause := range matlabError.Causes {
+		details.Causes = append(details.Causes, newMATLABErrorDetails(cause))
+	}
+
+	return details
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package basetool
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+type progressNotifier interface {
+	NotifyProgress(ctx context.Context, params *mcp.ProgressNotificationParams) error
+}
+
+// progressReporter sends MCP progress notifications for the tool call that supplied progressToken.
+// The progress value is the number of steps reported so far, as the total is never known in advance.
+type progressReporter struct {
+	ctx           context.Context
+	notifier      progressNotifier
+	progressToken any
+	logger        entities.Logger
+
+	lock     *sync.Mutex
+	progress float64
+}
+
+func newProgressReporter(ctx context.Context, notifier progressNotifier, progressToken any, logger entities.Logger) *progressReporter {
+	return &progressReporter{
+		ctx:           ctx,
+		notifier:      notifier,
+		progressToken: progressToken,
+		logger:        logger,
+
+		lock: &sync.Mutex{},
+	}
+}
+
+func (p *progressReporter) Report(message string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.progress++
+
+	err := p.notifier.NotifyProgress(p.ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: p.progressToken,
+		Progress:      p.progress,
+		Message:       message,
+	})
+	if err != nil {
+		p.logger.WithError(err).With("message", message).Debug("Failed to send progress notification")
+	}
+}
+
+// withProgressReporter makes the tool call report progress through ctx when the client supplied a progress token.
+func withProgressReporter(ctx context.Context, req *mcp.CallToolRequest, logger entities.Logger) context.Context {
+	if req.Session == nil || req.Params == nil {
+		return ctx
+	}
+
+	progressToken := req.Params.GetProgressToken()
+	if progressToken == nil {
+		return ctx
+	}
+
+	return entities.WithProgressReporter(ctx, newProgressReporter(ctx, req.Session, progressToken, logger))
+}
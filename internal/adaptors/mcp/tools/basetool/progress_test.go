@@ -0,0 +1,144 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package basetool_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolWithStructuredContentOutput_Handler_ReportsProgressWhenClientSuppliesToken(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
+		entities.ReportProgress(ctx, "Starting MATLAB")
+		entities.ReportProgress(ctx, "MATLAB is ready")
+		return TestOutput{Result: "success"}, nil
+	}
+
+	tool := basetool.NewToolWithStructuredContent("test-tool", "Test Tool", "A test tool", basetool.Annotations{}, mockLoggerFactory, handler)
+
+	var (
+		notificationsLock sync.Mutex
+		notifications     []*mcp.ProgressNotificationParams
+	)
+	clientSession := connectInMemory(t, tool.AddToServer, func(_ context.Context, req *mcp.ProgressNotificationClientRequest) {
+		notificationsLock.Lock()
+		defer notificationsLock.Unlock()
+		notifications = append(notifications, req.Params)
+	})
+
+	params := &mcp.CallToolParams{
+		Meta:      mcp.Meta{"progressToken": "progress-token"},
+		Name:      "test-tool",
+		Arguments: map[string]any{"message": "hello"},
+	}
+
+	// Act
+	result, err := clientSession.CallTool(t.Context(), params)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// Notifications are sent before the tool call result, on the same connection
+	notificationsLock.Lock()
+	defer notificationsLock.Unlock()
+	require.Len(t, notifications, 2)
+	assert.Equal(t, "progress-token", notifications[0].ProgressToken)
+	assert.Equal(t, "Starting MATLAB", notifications[0].Message)
+	assert.InDelta(t, 1, notifications[0].Progress, 0)
+	assert.Equal(t, "MATLAB is ready", notifications[1].Message)
+	assert.InDelta(t, 2, notifications[1].Progress, 0)
+}
+
+func TestToolWithUnstructuredContentOutput_Handler_NoProgressReporterWithoutToken(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(mock.Anything).
+		Return(mockLogger).
+		Once()
+
+	var hasProgressReporter bool
+	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (tools.RichContent, error) {
+		_, hasProgressReporter = entities.ProgressReporterFromContext(ctx)
+		return tools.RichContent{TextContent: []string{"done"}}, nil
+	}
+
+	tool := basetool.NewToolWithUnstructuredContent("test-tool", "Test Tool", "A test tool", basetool.Annotations{}, mockLoggerFactory, handler)
+
+	clientSession := connectInMemory(t, tool.AddToServer, nil)
+
+	// Act
+	result, err := clientSession.CallTool(t.Context(), &mcp.CallToolParams{
+		Name:      "test-tool",
+		Arguments: map[string]any{"message": "hello"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	assert.False(t, hasProgressReporter, "Handler should not get a progress reporter when the client did not supply a progress token")
+}
+
+func connectInMemory(
+	t *testing.T,
+	addToServer func(server *mcp.Server) error,
+	progressHandler func(context.Context, *mcp.ProgressNotificationClientRequest),
+) *mcp.ClientSession {
+	t.Helper()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server"}, nil)
+	require.NoError(t, addToServer(server))
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, &mcp.ClientOptions{
+		ProgressNotificationHandler: progressHandler,
+	})
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+
+	serverSession, err := server.Connect(t.Context(), serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	clientSession, err := client.Connect(t.Context(), clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	return clientSession
+}
@@ -23,6 +23,7 @@ func NewToolWithStructuredContent[ToolInput, ToolOutput any](
 	name string,
 	title string,
 	description string,
+	annotations Annotations,
 	loggerFactory LoggerFactory,
 	handler func(context.Context, entities.Logger, ToolInput) (ToolOutput, error),
 ) ToolWithStructuredContentOutput[ToolInput, ToolOutput] {
@@ -31,6 +32,7 @@ func NewToolWithStructuredContent[ToolInput, ToolOutput any](
 			name:          name,
 			title:         title,
 			description:   description,
+			annotations:   annotations,
 			loggerFactory: loggerFactory,
 			// Manually inject adder as only have type information at compile time
 			toolAdder: mcpfacade.NewToolAdder[ToolInput, ToolOutput](),
@@ -57,6 +59,7 @@ func (t ToolWithStructuredContentOutput[_, _]) AddToServer(server *mcp.Server) e
 			Name:         t.name,
 			Title:        t.title,
 			Description:  t.description,
+			Annotations:  t.annotations.toMCPToolAnnotations(t.title),
 			InputSchema:  inputSchema,
 			OutputSchema: outputSchema,
 		},
@@ -73,6 +76,9 @@ func (t ToolWithStructuredContentOutput[ToolInput, ToolOutput]) Handler() mcp.To
 		logger.Debug("Handling tool call request")
 		defer logger.Debug("Handled tool call request")
 
+		ctx = withMCPSession(ctx, req)
+		ctx = withProgressReporter(ctx, req, logger)
+
 		var toolOutputZeroValue ToolOutput
 
 		if t.structuredContentHandler == nil {
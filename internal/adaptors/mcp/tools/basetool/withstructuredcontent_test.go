@@ -25,6 +25,8 @@ type TestOutput struct {
 	Result string `json:"result"`
 }
 
+type testContextKey struct{}
+
 func TestNewToolWithStructuredContent_HappyPath(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -38,6 +40,11 @@ func TestNewToolWithStructuredContent_HappyPath(t *testing.T) {
 		toolDescription = "A test tool for unit testing"
 	)
 
+	expectedAnnotations := basetool.Annotations{
+		ReadOnly:   true,
+		Idempotent: true,
+	}
+
 	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
 		return TestOutput{Result: "success"}, nil
 	}
@@ -52,6 +59,7 @@ func TestNewToolWithStructuredContent_HappyPath(t *testing.T) {
 		toolName,
 		toolTitle,
 		toolDescription,
+		expectedAnnotations,
 		mockLoggerFactory,
 		handler,
 	)
@@ -60,6 +68,7 @@ func TestNewToolWithStructuredContent_HappyPath(t *testing.T) {
 	assert.Equal(t, toolName, tool.Name(), "Tool name should match")
 	assert.Equal(t, toolTitle, tool.Title(), "Tool title should match")
 	assert.Equal(t, toolDescription, tool.Description(), "Tool description should match")
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool annotations should match")
 
 	expectedInputSchema, err := jsonschema.For[TestInput](&jsonschema.ForOptions{})
 	require.NoError(t, err, "Input schema generation should succeed")
@@ -90,6 +99,12 @@ func TestToolWithStructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 		toolDescription = "A test tool for unit testing"
 	)
 
+	expectedAnnotations := basetool.Annotations{
+		ReadOnly:   true,
+		Idempotent: true,
+	}
+	isFalse := false
+
 	handler := func(ctx context.Context, logger entities.Logger, input TestInput) (TestOutput, error) {
 		return TestOutput{Result: "success"}, nil
 	}
@@ -103,6 +118,7 @@ func TestToolWithStructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 		toolName,
 		toolTitle,
 		toolDescription,
+		expectedAnnotations,
 		mockLoggerFactory,
 		handler,
 	)
@@ -118,9 +134,16 @@ func TestToolWithStructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 	mockAdder.EXPECT().AddTool(
 		expectedServer,
 		&mcp.Tool{
-			Name:         toolName,
-			Title:        toolTitle,
-			Description:  toolDescription,
+			Name:        toolName,
+			Title:       toolTitle,
+			Description: toolDescription,
+			Annotations: &mcp.ToolAnnotations{
+				Title:           toolTitle,
+				ReadOnlyHint:    true,
+				DestructiveHint: &isFalse,
+				IdempotentHint:  true,
+				OpenWorldHint:   &isFalse,
+			},
 			InputSchema:  toolInputSchema,
 			OutputSchema: toolOutputSchema,
 		},
@@ -165,6 +188,7 @@ func TestToolWithStructuredContentOutput_Handler_HappyPath(t *testing.T) {
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -211,6 +235,7 @@ func TestToolWithStructuredContentOutput_Handler_StructuredHandlerError(t *testi
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -259,6 +284,7 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -267,10 +293,13 @@ func TestToolWithStructuredContentOutput_Handler_ContextPropagation(t *testing.T
 		Session: expectedSession,
 	}
 
+	ctx := context.WithValue(t.Context(), testContextKey{}, "test-value")
+
 	// Act
-	_, _, err := tool.Handler()(t.Context(), req, expectedInput)
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), capturedContext, "Context should be propagated to handler")
+	assert.Equal(t, "test-value", capturedContext.Value(testContextKey{}), "Context should be propagated to handler")
+	assert.Same(t, expectedSession, entities.MCPSessionFromContext(capturedContext), "MCP session should be passed to handler")
 }
@@ -4,6 +4,7 @@ package basetool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
@@ -24,6 +25,7 @@ func NewToolWithUnstructuredContent[ToolInput any](
 	name string,
 	title string,
 	description string,
+	annotations Annotations,
 	loggerFactory LoggerFactory,
 	handler func(context.Context, entities.Logger, ToolInput) (tools.RichContent, error),
 ) ToolWithUnstructuredContentOutput[ToolInput] {
@@ -32,6 +34,7 @@ func NewToolWithUnstructuredContent[ToolInput any](
 			name:          name,
 			title:         title,
 			description:   description,
+			annotations:   annotations,
 			loggerFactory: loggerFactory,
 			// Manually inject adder as only have type information at compile time
 			toolAdder: mcpfacade.NewToolAdder[ToolInput, any](),
@@ -53,6 +56,7 @@ func (t ToolWithUnstructuredContentOutput[_]) AddToServer(server *mcp.Server) er
 			Name:         t.name,
 			Title:        t.title,
 			Description:  t.description,
+			Annotations:  t.annotations.toMCPToolAnnotations(t.title),
 			InputSchema:  inputSchema,
 			OutputSchema: nil,
 		},
@@ -69,6 +73,9 @@ func (t ToolWithUnstructuredContentOutput[ToolInput]) Handler() mcp.ToolHandlerF
 		logger.Debug("Handling tool call request")
 		defer logger.Debug("Handled tool call request")
 
+		ctx = withMCPSession(ctx, req)
+		ctx = withProgressReporter(ctx, req, logger)
+
 		if t.unstructuredContentHandler == nil {
 			err := fmt.Errorf(UnexpectedErrorPrefixForLLM + "no unstructured handler available")
 			logger.WithError(err).Warn("Unstructured content handler is nil")
@@ -76,6 +83,11 @@ func (t ToolWithUnstructuredContentOutput[ToolInput]) Handler() mcp.ToolHandlerF
 		}
 
 		richContent, err := t.unstructuredContentHandler(ctx, logger, input)
+		var matlabError *entities.MATLABError
+		if errors.As(err, &matlabError) {
+			logger.WithError(err).Info("MATLAB code threw an error")
+			return matlabErrorResult(err, matlabError), nil, nil
+		}
 		if err != nil {
 			logger.WithError(err).Warn("Unstructured handler returned an error")
 			return nil, nil, err
@@ -4,6 +4,7 @@ package basetool_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/jsonschema-go/jsonschema"
@@ -35,6 +36,11 @@ func TestNewToolWithUnstructuredContentOutput_HappyPath(t *testing.T) {
 		toolDescription = "A test tool for unstructured content"
 	)
 
+	expectedAnnotations := basetool.Annotations{
+		ReadOnly:   true,
+		Idempotent: true,
+	}
+
 	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
 		return tools.RichContent{
 			TextContent: []string{"test response"},
@@ -51,6 +57,7 @@ func TestNewToolWithUnstructuredContentOutput_HappyPath(t *testing.T) {
 		toolName,
 		toolTitle,
 		toolDescription,
+		expectedAnnotations,
 		mockLoggerFactory,
 		handler,
 	)
@@ -59,6 +66,7 @@ func TestNewToolWithUnstructuredContentOutput_HappyPath(t *testing.T) {
 	assert.Equal(t, toolName, tool.Name(), "Tool name should match")
 	assert.Equal(t, toolTitle, tool.Title(), "Tool title should match")
 	assert.Equal(t, toolDescription, tool.Description(), "Tool description should match")
+	assert.Equal(t, expectedAnnotations, tool.Annotations(), "Tool annotations should match")
 
 	expectedInputSchema, err := jsonschema.For[TestUnstructuredInput](&jsonschema.ForOptions{})
 	require.NoError(t, err, "Input schema generation should succeed")
@@ -85,6 +93,12 @@ func TestToolWithUnstructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 		toolDescription = "A test tool for unstructured content"
 	)
 
+	expectedAnnotations := basetool.Annotations{
+		ReadOnly:   true,
+		Idempotent: true,
+	}
+	isFalse := false
+
 	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
 		return tools.RichContent{
 			TextContent: []string{"test response"},
@@ -100,6 +114,7 @@ func TestToolWithUnstructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 		toolName,
 		toolTitle,
 		toolDescription,
+		expectedAnnotations,
 		mockLoggerFactory,
 		handler,
 	)
@@ -110,9 +125,16 @@ func TestToolWithUnstructuredContentOutput_AddToServer_HappyPath(t *testing.T) {
 	mockAdder.EXPECT().AddTool(
 		expectedServer,
 		&mcp.Tool{
-			Name:         toolName,
-			Title:        toolTitle,
-			Description:  toolDescription,
+			Name:        toolName,
+			Title:       toolTitle,
+			Description: toolDescription,
+			Annotations: &mcp.ToolAnnotations{
+				Title:           toolTitle,
+				ReadOnlyHint:    true,
+				DestructiveHint: &isFalse,
+				IdempotentHint:  true,
+				OpenWorldHint:   &isFalse,
+			},
 			InputSchema:  toolInputSchema,
 			OutputSchema: nil,
 		},
@@ -161,6 +183,7 @@ func TestToolWithUnstructuredContentOutput_Handler_HappyPath(t *testing.T) {
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -220,6 +243,7 @@ func TestToolWithUnstructuredContentOutput_Handler_TextContentOnly(t *testing.T)
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -281,6 +305,7 @@ func TestToolWithUnstructuredContentOutput_Handler_ImageContentOnly(t *testing.T
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -342,6 +367,7 @@ func TestToolWithUnstructuredContentOutput_Handler_NoContent(t *testing.T) {
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -389,6 +415,7 @@ func TestToolWithUnstructuredContentOutput_Handler_UnstructuredHandlerError(t *t
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -406,6 +433,95 @@ func TestToolWithUnstructuredContentOutput_Handler_UnstructuredHandlerError(t *t
 	assert.Nil(t, output, "Output should be nil when error occurs")
 }
 
+func TestToolWithUnstructuredContentOutput_Handler_MATLABError(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	expectedSession := &mcp.ServerSession{}
+	expectedInput := TestUnstructuredInput{Query: "test query"}
+	mockSessionLogger := testutils.NewInspectableLogger()
+
+	matlabError := &entities.MATLABError{
+		Identifier: "MATLAB:UndefinedFunction",
+		Message:    "Undefined function 'gain'.",
+		Stack: []entities.MATLABStackFrame{
+			{File: "/work/myScript.m", Name: "myScript", Line: 12},
+		},
+		Causes: []entities.MATLABError{
+			{Message: "Invalid gain."},
+		},
+	}
+	expectedError := fmt.Errorf("failed to run script: %w", matlabError)
+
+	handler := func(ctx context.Context, logger entities.Logger, input TestUnstructuredInput) (tools.RichContent, error) {
+		return tools.RichContent{}, expectedError
+	}
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockLoggerFactory.EXPECT().
+		NewMCPSessionLogger(expectedSession).
+		Return(mockSessionLogger).
+		Once()
+
+	tool := basetool.NewToolWithUnstructuredContent(
+		"test-tool",
+		"Test Tool",
+		"A test tool",
+		basetool.Annotations{},
+		mockLoggerFactory,
+		handler,
+	)
+
+	req := &mcp.CallToolRequest{
+		Session: expectedSession,
+	}
+
+	// Act
+	result, output, err := tool.Handler()(t.Context(), req, expectedInput)
+
+	// Assert
+	require.NoError(t, err, "A MATLAB error should be returned as an error result")
+	assert.Nil(t, output, "Output should be nil for unstructured content")
+	require.NotNil(t, result, "Result should not be nil")
+	assert.True(t, result.IsError, "Result should be an error result")
+
+	expectedContent := basetool.MATLABErrorContent{
+		MATLABError: basetool.MATLABErrorDetails{
+			Identifier: "MATLAB:UndefinedFunction",
+			Message:    "Undefined function 'gain'.",
+			Stack: []basetool.MATLABStackFrame{
+				{File: "/work/myScript.m", Name: "myScript", Line: 12},
+			},
+			Causes: []basetool.MATLABErrorDetails{
+				{Message: "Invalid gain."},
+			},
+		},
+	}
+	assert.Equal(t, expectedContent, result.StructuredContent, "Structured content should hold the details of the MATLAB error")
+
+	require.Len(t, result.Content, 2, "Should have 2 content items")
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok, "First content should be text content")
+	assert.Equal(t, expectedError.Error(), textContent.Text, "First text content should be the error message")
+
+	detailsContent, ok := result.Content[1].(*mcp.TextContent)
+	require.True(t, ok, "Second content should be text content")
+	assert.JSONEq(t, `{
+		"matlabError": {
+			"identifier": "MATLAB:UndefinedFunction",
+			"message": "Undefined function 'gain'.",
+			"stack": [{"file": "/work/myScript.m", "name": "myScript", "line": 12}],
+			"causes": [{"message": "Invalid gain."}]
+		}
+	}`, detailsContent.Text, "Second text content should hold the details of the MATLAB error as JSON")
+}
+
 func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing.T) {
 	// Arrange
 	mockLoggerFactory := &mocks.MockLoggerFactory{}
@@ -439,6 +555,7 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 		"test-tool",
 		"Test Tool",
 		"A test tool",
+		basetool.Annotations{},
 		mockLoggerFactory,
 		handler,
 	)
@@ -447,10 +564,14 @@ func TestToolWithUnstructuredContentOutput_Handler_ContextPropagation(t *testing
 		Session: expectedSession,
 	}
 
+	ctx := context.WithValue(t.Context(), testContextKey{}, "test-value")
+
 	// Act
-	_, _, err := tool.Handler()(t.Context(), req, expectedInput)
+	_, _, err := tool.Handler()(ctx, req, expectedInput)
 
 	// Assert
 	require.NoError(t, err, "Handler should not return an error")
-	assert.Equal(t, t.Context(), <-contextReceived, "Context should be propagated to handler")
+	receivedContext := <-contextReceived
+	assert.Equal(t, "test-value", receivedContext.Value(testContextKey{}), "Context should be propagated to handler")
+	assert.Same(t, expectedSession, entities.MCPSessionFromContext(receivedContext), "MCP session should be passed to handler")
 }
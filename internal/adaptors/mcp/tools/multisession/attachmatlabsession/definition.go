@@ -0,0 +1,30 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachmatlabsession
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
+const (
+	name        = "attach_matlab_session"
+	title       = "Attach to MATLAB Session"
+	description = "Attaches to a MATLAB session that is already running, such as one started from the Vitis Model Composer launcher, and returns a session ID (`session_id`) to use with the other session tools. The MATLAB session must be shared by running `matlab_mcp.shareSession()` in it. If it is not shared yet, the response gives the folder to share it in and explains how. Stopping an attached session only detaches from it, MATLAB keeps running."
+)
+
+// Every call adds a new session, but the attached MATLAB and existing sessions are left untouched.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: false,
+	Idempotent:  false,
+	OpenWorld:   false,
+}
+
+type Args struct{}
+
+type ReturnArgs struct {
+	ResponseText string `json:"response_text" jsonschema:"A message indicating the result of the operation."`
+	SessionID    int    `json:"session_id"    jsonschema:"The ID of the attached MATLAB session."`
+}
+
+const (
+	responseTextIfMATLABSessionAttachedSuccesfully = "Attached to MATLAB session successfully."
+)
@@ -0,0 +1,45 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachmatlabsession
+
+import (
+	"context"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachmatlabsession"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger, request entities.AttachedSessionDetails) (attachmatlabsession.ReturnArgs, error)
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing Attach MATLAB Session tool")
+		defer sessionLogger.Info("Done - Executing Attach MATLAB Session tool")
+
+		response, err := usecase.Execute(ctx, sessionLogger, entities.AttachedSessionDetails{})
+		if err != nil {
+			return ReturnArgs{}, err
+		}
+
+		return ReturnArgs{
+			ResponseText: responseTextIfMATLABSessionAttachedSuccesfully,
+			SessionID:    int(response.SessionID),
+		}, nil
+	}
+}
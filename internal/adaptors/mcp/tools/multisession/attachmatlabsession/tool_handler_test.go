@@ -0,0 +1,84 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachmatlabsession_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/attachmatlabsession"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	attachmatlabsessionusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/attachmatlabsession"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/attachmatlabsession"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := attachmatlabsession.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{}, tool.Annotations())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const expectedSessionID = entities.SessionID(5)
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), entities.AttachedSessionDetails{}).
+		Return(attachmatlabsessionusecase.ReturnArgs{SessionID: expectedSessionID}, nil).
+		Once()
+
+	// Act
+	result, err := attachmatlabsession.Handler(mockUsecase)(ctx, mockLogger, attachmatlabsession.Args{})
+
+	// Assert
+	require.NoError(t, err, "Handler should not return an error")
+	assert.Equal(t, int(expectedSessionID), result.SessionID, "Session ID should match")
+	assert.NotEmpty(t, result.ResponseText)
+}
+
+func TestTool_Handler_UsecaseError(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), entities.AttachedSessionDetails{}).
+		Return(attachmatlabsessionusecase.ReturnArgs{}, entities.ErrMATLABSessionNotShared).
+		Once()
+
+	// Act
+	result, err := attachmatlabsession.Handler(mockUsecase)(ctx, mockLogger, attachmatlabsession.Args{})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABSessionNotShared)
+	assert.Empty(t, result)
+}
@@ -2,12 +2,22 @@
 
 package evalmatlabcode
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "eval_in_matlab_session"
 	title       = "Evaluate MATLAB Code in a MATLAB Session"
 	description = "Evaluate arbitrary MATLAB code (`code`) within a specified project directory (`project_path`) context in an existing MATLAB session, given its session ID (`session_id`). Note: The Vitis Model Composer Hub block requires specialized APIs instead of standard get_param/set_param. Check available resources before using standard MATLAB functions on the Vitis Model Composer Hub block."
 )
 
+// The evaluated code can do anything MATLAB can do, including deleting files and accessing the network.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: true,
+	Idempotent:  false,
+	OpenWorld:   true,
+}
+
 type Args struct {
 	SessionID   int    `json:"session_id"   jsonschema:"The ID of the MATLAB session in which to evaluate the code."`
 	ProjectPath string `json:"project_path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
@@ -26,7 +26,7 @@ func New(
 	matlabManager entities.MATLABManager,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, loggerFactory, Handler(usecase, matlabManager)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase, matlabManager)),
 	}
 }
 
@@ -5,6 +5,7 @@ package evalmatlabcode_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -39,6 +40,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{Destructive: true, OpenWorld: true}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -2,12 +2,22 @@
 
 package listavailablematlabs
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "list_available_matlabs"
 	title       = "List Available MATLABs"
 	description = "List the installed MATLAB versions on the host and their root directories."
 )
 
+// list_available_matlabs only looks for MATLAB installations on this machine.
+var annotations = basetool.Annotations{
+	ReadOnly:    true,
+	Destructive: false,
+	Idempotent:  true,
+	OpenWorld:   false,
+}
+
 type Args struct{}
 
 type ReturnArgs struct {
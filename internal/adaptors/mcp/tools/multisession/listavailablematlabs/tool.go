@@ -23,7 +23,7 @@ func New(
 	usecase Usecase,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase)),
 	}
 }
 
@@ -0,0 +1,47 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions
+
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
+const (
+	name        = "list_matlab_sessions"
+	title       = "List MATLAB Sessions"
+	description = "List the MATLAB sessions started by or attached to this server, with their session IDs and details such as the MATLAB release, process ID, and whether they are busy. Use this to recover session IDs to pass to other tools."
+)
+
+// list_matlab_sessions only reads the state of the sessions already started by this server.
+var annotations = basetool.Annotations{
+	ReadOnly:    true,
+	Destructive: false,
+	Idempotent:  true,
+	OpenWorld:   false,
+}
+
+type Args struct{}
+
+type ReturnArgs struct {
+	Sessions []SessionInfo `json:"sessions" jsonschema:"The MATLAB sessions started by or attached to this server, in ascending order of session ID."`
+}
+
+type SessionInfo struct {
+	SessionID      int              `json:"session_id"               jsonschema:"The ID of the MATLAB session."`
+	MATLABRoot     string           `json:"matlab_root"              jsonschema:"The MATLAB installation root directory of the session."`
+	VMCRoot        string           `json:"vmc_root,omitempty"       jsonschema:"The VMC installation root directory of the session, if any."`
+	Release        string           `json:"release,omitempty"        jsonschema:"The MATLAB release of the session, such as R2024b."`
+	ProcessID      int              `json:"process_id"               jsonschema:"The process ID of MATLAB."`
+	StartTime      string           `json:"start_time"               jsonschema:"When the session started, or when the server attached to it, in RFC 3339 format."`
+	LastUsed       string           `json:"last_used"                jsonschema:"When the session was last used, in RFC 3339 format."`
+	StartingFolder string           `json:"starting_folder,omitempty" jsonschema:"The folder MATLAB started in. This is not the current folder of MATLAB, which code evaluated in the session may have changed since."`
+	Busy           bool             `json:"busy"                     jsonschema:"Whether the session is running a request. A busy session runs new requests in the order they arrive, once it is idle again."`
+	QueuedRequests int              `json:"queued_requests"          jsonschema:"The number of requests waiting for the busy session."`
+	Attached       bool             `json:"attached"                 jsonschema:"Whether the server attached to a MATLAB session started outside of it. Stopping an attached session only detaches from it."`
+	Exited         *ProcessExitInfo `json:"exited,omitempty"         jsonschema:"How the MATLAB process of the session exited, if it exited unexpectedly. Stop an exited session to clean it up, and start a new session to continue."`
+}
+
+type ProcessExitInfo struct {
+	ExitCode   int      `json:"exit_code"             jsonschema:"The exit code of MATLAB, or -1 if MATLAB was killed by a signal."`
+	Signal     string   `json:"signal,omitempty"      jsonschema:"The signal that killed MATLAB, if any."`
+	ExitTime   string   `json:"exit_time"             jsonschema:"When MATLAB exited, in RFC 3339 format."`
+	CrashDumps []string `json:"crash_dumps,omitempty" jsonschema:"The crash dump files MATLAB wrote before exiting."`
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+)
+
+type Usecase interface {
+	Execute(ctx context.Context, sessionLogger entities.Logger) listmatlabsessions.ReturnArgs
+}
+
+type Tool struct {
+	basetool.ToolWithStructuredContentOutput[Args, ReturnArgs]
+}
+
+func New(
+	loggerFactory basetool.LoggerFactory,
+	usecase Usecase,
+) *Tool {
+	return &Tool{
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase)),
+	}
+}
+
+func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args, ReturnArgs] {
+	return func(ctx context.Context, sessionLogger entities.Logger, inputs Args) (ReturnArgs, error) {
+		sessionLogger.Info("Executing list MATLAB sessions tool")
+		defer sessionLogger.Info("Done - Executing list MATLAB sessions tool")
+
+		sessions := usecase.Execute(ctx, sessionLogger)
+
+		return convertToAnnotatedEquivalentType(sessions), nil
+	}
+}
+
+func convertToAnnotatedEquivalentType(sessionInfos listmatlabsessions.ReturnArgs) ReturnArgs {
+	convertedSessionInfos := make([]SessionInfo, len(sessionInfos))
+	for i, session := range sessionInfos {
+		convertedSessionInfos[i] = SessionInfo{
+			SessionID:      int(session.SessionID),
+			MATLABRoot:     session.MATLABRoot,
+			VMCRoot:        session.VMCRoot,
+			Release:        session.Release,
+			ProcessID:      session.ProcessID,
+			StartTime:      session.StartTime.Format(time.RFC3339),
+			LastUsed:       session.LastUsed.Format(time.RFC3339),
+			StartingFolder: session.StartingFolder,
+			Busy:           session.Busy,
+			QueuedRequests: session.QueuedRequests,
+			Attached:       session.Attached,
+		}
+		if session.Exited != nil {
+			convertedSessionInfos[i].Exited = &ProcessExitInfo{
+				ExitCode:   session.Exited.ExitCode,
+				Signal:     session.Exited.Signal,
+				ExitTime:   session.Exited.ExitTime.Format(time.RFC3339),
+				CrashDumps: session.Exited.CrashDumps,
+			}
+		}
+	}
+	return ReturnArgs{
+		Sessions: convertedSessionInfos,
+	}
+}
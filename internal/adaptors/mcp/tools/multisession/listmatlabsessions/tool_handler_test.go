@@ -0,0 +1,162 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	basetoolsmocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/basetool"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/adaptors/mcp/tools/multisession/listmatlabsessions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockLoggerFactory := &basetoolsmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	// Act
+	tool := listmatlabsessions.New(mockLoggerFactory, mockUsecase)
+
+	// Assert
+	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{ReadOnly: true, Idempotent: true}, tool.Annotations())
+}
+
+func TestTool_Handler_HappyPath(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	startTime := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	mockSessions := []entities.MATLABSessionInfo{
+		{
+			SessionID:      1,
+			MATLABRoot:     "/path/to/matlab/R2024b",
+			VMCRoot:        "/path/to/vmc",
+			Release:        "R2024b",
+			ProcessID:      1234,
+			StartTime:      startTime,
+			LastUsed:       startTime.Add(time.Minute),
+			StartingFolder: "/path/to/work",
+			Busy:           true,
+			QueuedRequests: 2,
+		},
+		{
+			SessionID:  3,
+			MATLABRoot: "/path/to/matlab/R2023a",
+			Release:    "R2023a",
+			ProcessID:  5678,
+			StartTime:  startTime,
+			LastUsed:   startTime,
+			Attached:   true,
+		},
+		{
+			SessionID:  4,
+			MATLABRoot: "/path/to/matlab/R2024b",
+			Release:    "R2024b",
+			ProcessID:  9012,
+			StartTime:  startTime,
+			LastUsed:   startTime,
+			Exited: &entities.MATLABProcessExit{
+				ProcessID:  9012,
+				ExitCode:   -1,
+				Signal:     "SIGSEGV",
+				ExitTime:   startTime.Add(2 * time.Minute),
+				CrashDumps: []string{"/path/to/session/matlab_crash_dump.9012-1"},
+			},
+		},
+	}
+	ctx := t.Context()
+	inputs := listmatlabsessions.Args{}
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg()).
+		Return(mockSessions).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(ctx, mockLogger, inputs)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, listmatlabsessions.ReturnArgs{
+		Sessions: []listmatlabsessions.SessionInfo{
+			{
+				SessionID:      1,
+				MATLABRoot:     "/path/to/matlab/R2024b",
+				VMCRoot:        "/path/to/vmc",
+				Release:        "R2024b",
+				ProcessID:      1234,
+				StartTime:      "2025-06-01T09:00:00Z",
+				LastUsed:       "2025-06-01T09:01:00Z",
+				StartingFolder: "/path/to/work",
+				Busy:           true,
+				QueuedRequests: 2,
+			},
+			{
+				SessionID:  3,
+				MATLABRoot: "/path/to/matlab/R2023a",
+				Release:    "R2023a",
+				ProcessID:  5678,
+				StartTime:  "2025-06-01T09:00:00Z",
+				LastUsed:   "2025-06-01T09:00:00Z",
+				Attached:   true,
+			},
+			{
+				SessionID:  4,
+				MATLABRoot: "/path/to/matlab/R2024b",
+				Release:    "R2024b",
+				ProcessID:  9012,
+				StartTime:  "2025-06-01T09:00:00Z",
+				LastUsed:   "2025-06-01T09:00:00Z",
+				Exited: &listmatlabsessions.ProcessExitInfo{
+					ExitCode:   -1,
+					Signal:     "SIGSEGV",
+					ExitTime:   "2025-06-01T09:02:00Z",
+					CrashDumps: []string{"/path/to/session/matlab_crash_dump.9012-1"},
+				},
+			},
+		},
+	}, result)
+	assert.Len(t, mockLogger.InfoLogs(), 2, "Bounding info logs should be created")
+}
+
+func TestTool_Handler_NoSessions(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockUsecase.EXPECT().
+		Execute(mock.Anything, mockLogger.AsMockArg()).
+		Return([]entities.MATLABSessionInfo{}).
+		Once()
+
+	// Act
+	result, err := listmatlabsessions.Handler(mockUsecase)(t.Context(), mockLogger, listmatlabsessions.Args{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result.Sessions, "Sessions should be an empty list rather than null")
+	assert.Empty(t, result.Sessions)
+}
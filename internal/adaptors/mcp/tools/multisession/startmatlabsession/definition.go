@@ -2,14 +2,27 @@
 
 package startmatlabsession
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "start_matlab_session"
 	title       = "Start MATLAB Session"
-	description = "Starts a new MATLAB session for the provided MATLAB root (`matlab_root`) and returns a session ID (`session_id`)."
+	description = "Starts a new MATLAB session for the provided MATLAB root (`matlab_root`) and returns a session ID (`session_id`). Set `use_vmc` to start Vitis Model Composer with that MATLAB instead, `starting_folder` to choose the folder MATLAB starts in, and `show_matlab_desktop` to show the MATLAB desktop."
 )
 
+// Every call starts a new MATLAB session, but existing sessions are left untouched.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: false,
+	Idempotent:  false,
+	OpenWorld:   false,
+}
+
 type Args struct {
-	MATLABRoot string `json:"matlab_root" jsonschema:"MATLAB root directory for session."`
+	MATLABRoot        string `json:"matlab_root"                   jsonschema:"MATLAB root directory for session."`
+	UseVMC            bool   `json:"use_vmc,omitempty"             jsonschema:"Whether to start Vitis Model Composer, from the installation the server was started with, with the MATLAB in matlab_root, which must be a MATLAB release that this Vitis Model Composer release supports. By default, the session runs MATLAB only."`
+	StartingFolder    string `json:"starting_folder,omitempty"     jsonschema:"Absolute path to an allowed folder that MATLAB starts in. By default, MATLAB starts in a new temporary folder."`
+	ShowMATLABDesktop bool   `json:"show_matlab_desktop,omitempty" jsonschema:"Whether to show the MATLAB desktop. By default, MATLAB runs headless, without a desktop."`
 }
 
 type ReturnArgs struct {
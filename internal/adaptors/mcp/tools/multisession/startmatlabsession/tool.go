@@ -11,7 +11,7 @@ import (
 )
 
 type Usecase interface {
-	Execute(ctx context.Context, sessionLogger entities.Logger, request entities.SessionDetails) (startmatlabsession.ReturnArgs, error)
+	Execute(ctx context.Context, sessionLogger entities.Logger, args startmatlabsession.Args) (startmatlabsession.ReturnArgs, error)
 }
 
 type Tool struct {
@@ -23,7 +23,7 @@ func New(
 	usecase Usecase,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase)),
 	}
 }
 
@@ -32,9 +32,14 @@ func Handler(usecase Usecase) basetool.HandlerWithStructuredContentOutput[Args,
 		sessionLogger.Info("Executing Start MATLAB Session tool")
 		defer sessionLogger.Info("Done - Executing Start MATLAB Session tool")
 
-		startSessionRequest := entities.LocalSessionDetails{
-			MATLABRoot:             inputs.MATLABRoot,
-			IsStartingDirectorySet: false,
+		startSessionRequest := startmatlabsession.Args{
+			Session: entities.LocalSessionDetails{
+				MATLABRoot:             inputs.MATLABRoot,
+				IsStartingDirectorySet: inputs.StartingFolder != "",
+				StartingDirectory:      inputs.StartingFolder,
+				ShowMATLABDesktop:      inputs.ShowMATLABDesktop,
+			},
+			UseVMC: inputs.UseVMC,
 		}
 
 		response, err := usecase.Execute(ctx, sessionLogger, startSessionRequest)
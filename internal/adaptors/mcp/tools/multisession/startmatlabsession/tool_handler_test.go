@@ -5,6 +5,7 @@ package startmatlabsession_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -35,6 +36,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -63,7 +65,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	}
 
 	mockUsecase.EXPECT().
-		Execute(ctx, mockLogger.AsMockArg(), localSessionDetails).
+		Execute(ctx, mockLogger.AsMockArg(), startmatlabsessionusecase.Args{Session: localSessionDetails}).
 		Return(expectedResponse, nil).
 		Once()
 
@@ -77,6 +79,46 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedAddOnsOutput, result.AddOnsOutput, "AddOns output should match")
 }
 
+func TestTool_Handler_SessionOptions(t *testing.T) {
+	// Arrange
+	mockUsecase := &mocks.MockUsecase{}
+	defer mockUsecase.AssertExpectations(t)
+
+	mockLogger := testutils.NewInspectableLogger()
+	ctx := t.Context()
+	const matlabRoot = "/path/to/matlab"
+	const startingFolder = "/home/user/project"
+	const expectedSessionID = entities.SessionID(123)
+
+	usecaseArgs := startmatlabsessionusecase.Args{
+		Session: entities.LocalSessionDetails{
+			MATLABRoot:             matlabRoot,
+			IsStartingDirectorySet: true,
+			StartingDirectory:      startingFolder,
+			ShowMATLABDesktop:      true,
+		},
+		UseVMC: true,
+	}
+	args := startmatlabsession.Args{
+		MATLABRoot:        matlabRoot,
+		UseVMC:            true,
+		StartingFolder:    startingFolder,
+		ShowMATLABDesktop: true,
+	}
+
+	mockUsecase.EXPECT().
+		Execute(ctx, mockLogger.AsMockArg(), usecaseArgs).
+		Return(startmatlabsessionusecase.ReturnArgs{SessionID: expectedSessionID}, nil).
+		Once()
+
+	// Act
+	result, err := startmatlabsession.Handler(mockUsecase)(ctx, mockLogger, args)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int(expectedSessionID), result.SessionID)
+}
+
 func TestTool_Handler_UsecaseError(t *testing.T) {
 	// Arrange
 	mockUsecase := &mocks.MockUsecase{}
@@ -96,7 +138,7 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	}
 
 	mockUsecase.EXPECT().
-		Execute(ctx, mockLogger.AsMockArg(), localSessionDetails).
+		Execute(ctx, mockLogger.AsMockArg(), startmatlabsessionusecase.Args{Session: localSessionDetails}).
 		Return(startmatlabsessionusecase.ReturnArgs{}, expectedError).
 		Once()
 
@@ -2,12 +2,22 @@
 
 package stopmatlabsession
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "stop_matlab_session"
 	title       = "Stop MATLAB Session"
 	description = "Stops an existing MATLAB session, given its session ID (`session_id`)."
 )
 
+// Stopping a session discards its workspace and any unsaved work, and stopping it again has no further effect.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: true,
+	Idempotent:  true,
+	OpenWorld:   false,
+}
+
 type Args struct {
 	SessionID int `json:"session_id" jsonschema:"The ID of the MATLAB session to stop."`
 }
@@ -5,6 +5,7 @@ package stopmatlabsession_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -34,6 +35,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{Destructive: true, Idempotent: true}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -5,6 +5,7 @@ package checkmatlabcode_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	checkmatlabcodeusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
@@ -38,6 +39,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{ReadOnly: false, Idempotent: true}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -63,7 +65,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -104,7 +106,7 @@ func TestTool_Handler_EmptyOutput(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -142,7 +144,7 @@ func TestTool_Handler_ClientError(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, expectedError).
 		Once()
 
@@ -175,7 +177,7 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
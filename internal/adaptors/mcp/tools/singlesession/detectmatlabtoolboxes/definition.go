@@ -2,12 +2,23 @@
 
 package detectmatlabtoolboxes
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "detect_matlab_toolboxes"
 	title       = "Detect MATLAB Toolboxes"
 	description = "List installed MATLAB toolboxes with their versions and installation status."
 )
 
+// detect_matlab_toolboxes only queries the MATLAB installation.
+// It is not read-only, as it starts the global MATLAB session when none is running yet.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: false,
+	Idempotent:  true,
+	OpenWorld:   false,
+}
+
 type Args struct {
 }
 
@@ -24,7 +24,7 @@ func New(
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase, globalMATLAB)),
 	}
 }
 
@@ -41,7 +41,7 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 		sessionLogger.Info("Executing detect MATLAB toolboxes tool")
 		defer sessionLogger.Info("Done - Executing detect MATLAB toolboxes tool")
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		client, err := globalMATLAB.Client(ctx, sessionLogger, entities.MCPSessionFromContext(ctx))
 		if err != nil {
 			return ReturnArgs{}, err
 		}
@@ -5,6 +5,7 @@ package detectmatlabtoolboxes_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	detectmatlabtoolboxesusecase "github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
@@ -38,6 +39,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{ReadOnly: false, Idempotent: true}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -59,7 +61,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	args := detectmatlabtoolboxes.Args{}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -93,7 +95,7 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	args := detectmatlabtoolboxes.Args{}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, expectedError).
 		Once()
 
@@ -122,7 +124,7 @@ func TestTool_Handler_UsecaseError(t *testing.T) {
 	args := detectmatlabtoolboxes.Args{}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -2,12 +2,22 @@
 
 package evalmatlabcode
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "evaluate_matlab_code"
 	title       = "Evaluate MATLAB Code"
 	description = "Evaluate arbitrary MATLAB code (`code`) within a specified project directory (`project_path`) context in an existing MATLAB session. Returns the command window output from code execution. Note: The Vitis Model Composer Hub block requires specialized APIs instead of standard get_param/set_param. Check available resources before using standard MATLAB functions on the Vitis Model Composer Hub block.\n\nADDITIONAL DOCUMENTATION: When working with Vitis Model Composer models, refer to UG1483 (Vitis Model Composer User Guide) via the vivado-doc-search tool for detailed usage guidance, architectural patterns, or features not covered in block help."
 )
 
+// The evaluated code can do anything MATLAB can do, including deleting files and accessing the network.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: true,
+	Idempotent:  false,
+	OpenWorld:   true,
+}
+
 type Args struct {
 	ProjectPath string `json:"project_path" jsonschema:"The full path to the project directory - Becomes MATLAB's working directory during execution - Folder must exist - Example: C:\\Users\\username\\matlab-project or /home/user/research."`
 	Code        string `json:"code"         jsonschema:"The MATLAB code to evaluate."`
@@ -5,6 +5,7 @@ package evalmatlabcode_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -39,6 +40,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{Destructive: true, OpenWorld: true}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -66,7 +68,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -119,7 +121,7 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, expectedError).
 		Once()
 
@@ -153,7 +155,7 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -204,7 +206,7 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -17,6 +17,14 @@ const (
 	description = "Search and retrieve help documentation for specific Vitis Model Composer blocks. Returns detailed documentation including parameters, description, and WORKING EXAMPLE MODELS with COMPLETE MATLAB CREATION SCRIPTS.\n\nCRITICAL: When creating models with VMC blocks, ALWAYS extract and adapt the embedded example scripts (marked with 'MATLAB CREATION SCRIPT:') rather than creating implementations from scratch. These scripts contain:\n- Verified block library paths (e.g., 'aieDSP/FFT', 'aieUtilities/To Fixed Size')\n- Correct parameter configurations\n- Proven working subsystem structures\n- Proper signal sources and connections\n\nBlock library paths vary and guessing them will cause errors. Use the paths shown in the examples.\n\nPOST-CREATION VALIDATION CHECKLIST:\nAfter creating a model with VMC blocks, always validate with these steps:\n\nREQUIRED:\n1. Set discrete sample time on all source blocks (Constant, Random Source, etc.)\n   - AIE blocks require discrete sample times with offset = 0\n   - Example: set_param([modelName '/SourceBlock'], 'SampleTime', '1')\n2. Update model diagram to check for errors\n   - Command: set_param(modelName, 'SimulationCommand', 'update')\n   - Fix any errors before proceeding\n\nOPTIONAL VERIFICATION:\n- Set appropriate stop time for frame size (for N samples: StopTime = N-1 when SampleTime=1)\n- If comparing with reference, verify error signals are minimal\n- Check signal dimensions match expected sizes\n- Verify Hub block target device matches your hardware\n\nCOMMON ISSUES:\n- Missing sample time → 'AIEImportedIpBlock supports only discrete sample times' error\n- Wrong frame size → Check SSR and input vector size relationship\n- Connection errors → Clear all lines before reconnecting when modifying models"
 )
 
+// query_vmc_block_help only reads the block documentation embedded in the server.
+var annotations = basetool.Annotations{
+	ReadOnly:    true,
+	Destructive: false,
+	Idempotent:  true,
+	OpenWorld:   false,
+}
+
 type Usecase interface {
 	Execute(ctx context.Context, blockName string) (*queryvmcblockhelp.Result, error)
 }
@@ -30,7 +38,7 @@ func New(
 	usecase Usecase,
 ) *Tool {
 	return &Tool{
-		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, loggerFactory, Handler(usecase)),
+		ToolWithStructuredContentOutput: basetool.NewToolWithStructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase)),
 	}
 }
 
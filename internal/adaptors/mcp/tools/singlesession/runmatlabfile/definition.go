@@ -2,12 +2,22 @@
 
 package runmatlabfile
 
+import "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+
 const (
 	name        = "run_matlab_file"
 	title       = "Run MATLAB File"
 	description = "Execute a MATLAB script file (`script_path`) in an existing MATLAB session and capture its command window output. The script runs with the working directory automatically set to the script's location. The script must exist and be a valid .m file. Returns the command window output or a success message if no output is generated. Note: The Vitis Model Composer Hub block requires specialized APIs instead of standard get_param/set_param. Check available resources before using standard MATLAB functions on the Vitis Model Composer Hub block."
 )
 
+// The script can do anything MATLAB can do, including deleting files and accessing the network.
+var annotations = basetool.Annotations{
+	ReadOnly:    false,
+	Destructive: true,
+	Idempotent:  false,
+	OpenWorld:   true,
+}
+
 type Args struct {
 	ScriptPath string `json:"script_path" jsonschema:"The full absolute path to the MATLAB script file to execute - Must be a .m file that exists - Example: C:\\Users\\username\\projects\\analysis.m or /home/user/matlab/simulation.m."`
 }
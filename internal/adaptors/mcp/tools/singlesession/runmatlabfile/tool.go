@@ -26,7 +26,7 @@ func New(
 	globalMATLAB entities.GlobalMATLAB,
 ) *Tool {
 	return &Tool{
-		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, loggerFactory, Handler(usecase, globalMATLAB)),
+		ToolWithUnstructuredContentOutput: basetool.NewToolWithUnstructuredContent(name, title, description, annotations, loggerFactory, Handler(usecase, globalMATLAB)),
 	}
 }
 
@@ -35,7 +35,7 @@ func Handler(usecase Usecase, globalMATLAB entities.GlobalMATLAB) basetool.Handl
 		sessionLogger.Info("Executing Run MATLAB File tool")
 		defer sessionLogger.Info("Done - Executing Run MATLAB File tool")
 
-		client, err := globalMATLAB.Client(ctx, sessionLogger)
+		client, err := globalMATLAB.Client(ctx, sessionLogger, entities.MCPSessionFromContext(ctx))
 		if err != nil {
 			return tools.RichContent{}, err
 		}
@@ -5,6 +5,7 @@ package runmatlabfile_test
 import (
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
@@ -39,6 +40,7 @@ func TestNew_HappyPath(t *testing.T) {
 
 	// Assert
 	assert.NotNil(t, tool)
+	assert.Equal(t, basetool.Annotations{Destructive: true, OpenWorld: true}, tool.Annotations())
 }
 
 func TestTool_Handler_HappyPath(t *testing.T) {
@@ -62,7 +64,7 @@ func TestTool_Handler_HappyPath(t *testing.T) {
 	args := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -108,7 +110,7 @@ func TestTool_Handler_ClientReturnsError(t *testing.T) {
 	args := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(nil, expectedError).
 		Once()
 
@@ -138,7 +140,7 @@ func TestTool_Handler_UsecaseReturnsError(t *testing.T) {
 	args := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -183,7 +185,7 @@ func TestTool_Handler_UsecaseReturnsEmptyResponse(t *testing.T) {
 	args := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockGlobalMATLAB.EXPECT().
-		Client(ctx, mockLogger.AsMockArg()).
+		Client(ctx, mockLogger.AsMockArg(), nil).
 		Return(mockMATLABSessionClient, nil).
 		Once()
 
@@ -76,6 +76,15 @@ func (w *Watchdog) RegisterProcessPIDWithWatchdog(processPID int) error {
 	return w.client.SendProcessPID(processPID)
 }
 
+// RegisterPersistentProcessPIDWithWatchdog registers a process that the watchdog leaves running when the server shuts down gracefully.
+// The watchdog still kills it if the server exits unexpectedly.
+func (w *Watchdog) RegisterPersistentProcessPIDWithWatchdog(processPID int) error {
+	<-w.startedC
+
+	w.logger.With("pid", processPID).Debug("Adding persistent child process to watchdog")
+	return w.client.SendPersistentProcessPID(processPID)
+}
+
 func (w *Watchdog) Stop() error {
 	<-w.startedC
 
@@ -263,6 +263,73 @@ func TestWatchdog_RegisterProcessPIDWithWatchdog_HappyPath(t *testing.T) {
 	require.NoError(t, err, "RegisterProcessPIDWithWatchdog should not return an error")
 }
 
+func TestWatchdog_RegisterPersistentProcessPIDWithWatchdog_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockWatchdogProcess := &watchdogmocks.MockWatchdogProcess{}
+	defer mockWatchdogProcess.AssertExpectations(t)
+
+	mockTransportFactory := &watchdogmocks.MockTransportFactory{}
+	defer mockTransportFactory.AssertExpectations(t)
+
+	mockLoggerFactory := &watchdogmocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockSubProcessStdio := &entitiesmocks.MockSubProcessStdio{}
+	defer mockSubProcessStdio.AssertExpectations(t)
+
+	mockTransportClient := &transportmocks.MockClient{}
+	defer mockTransportClient.AssertExpectations(t)
+
+	debugMessageC := make(chan string)
+	defer close(debugMessageC)
+	errorMessageC := make(chan string)
+	defer close(errorMessageC)
+	expectedPID := 12345
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockWatchdogProcess.EXPECT().
+		Stdio().
+		Return(mockSubProcessStdio).
+		Once()
+
+	mockTransportFactory.EXPECT().
+		NewClient(mockSubProcessStdio).
+		Return(mockTransportClient, nil).
+		Once()
+
+	mockWatchdogProcess.EXPECT().
+		Start().
+		Return(nil).
+		Once()
+
+	mockTransportClient.EXPECT().
+		SendPersistentProcessPID(expectedPID).
+		Return(nil).
+		Once()
+
+	watchdogInstance := watchdog.New(
+		mockWatchdogProcess,
+		mockTransportFactory,
+		mockLoggerFactory,
+	)
+
+	// Start the watchdog first
+	err := watchdogInstance.Start()
+	require.NoError(t, err, "Start should not return an error")
+
+	// Act
+	err = watchdogInstance.RegisterPersistentProcessPIDWithWatchdog(expectedPID)
+
+	// Assert
+	require.NoError(t, err, "RegisterPersistentProcessPIDWithWatchdog should not return an error")
+}
+
 func TestWatchdog_RegisterProcessPIDWithWatchdog_WaitsIfNotStarted(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
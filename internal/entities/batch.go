@@ -0,0 +1,32 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// BatchRequest is a request sent to MATLAB together with other requests by MATLABSessionClient.Batch.
+// It is an EvalRequest or an FEvalRequest.
+type BatchRequest interface {
+	isBatchRequest()
+}
+
+func (r EvalRequest) isBatchRequest() {}
+
+func (r FEvalRequest) isBatchRequest() {}
+
+// BatchResponse is the response to the request at the same position in a batch.
+// Eval is set for an EvalRequest and FEval for an FEvalRequest. Err is set when MATLAB failed to run the request,
+// and is ErrBatchRequestNotRun when the request was not sent, as an earlier request failed.
+type BatchResponse struct {
+	Eval  EvalResponse
+	FEval FEvalResponse
+	Err   error
+}
+
+// FirstBatchError returns the error of the first request of a batch that MATLAB failed to run, or nil when MATLAB ran them all.
+func FirstBatchError(responses []BatchResponse) error {
+	for _, response := range responses {
+		if response.Err != nil {
+			return response.Err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// DisplayMode is how MATLAB sessions that the server starts are displayed.
+type DisplayMode string
+
+const (
+	// DisplayModeDesktop shows the MATLAB desktop on the display of the server.
+	DisplayModeDesktop DisplayMode = "desktop"
+	// DisplayModeNoDesktop runs MATLAB without a desktop.
+	DisplayModeNoDesktop DisplayMode = "nodesktop"
+	// DisplayModeVirtual runs MATLAB without a desktop, on a virtual X display started for the session,
+	// so that Simulink and Vitis Model Composer graphics work on machines without a display.
+	DisplayModeVirtual DisplayMode = "virtual"
+)
@@ -0,0 +1,36 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "errors"
+
+// ErrMATLABRequestCancelled is returned when the request that started an evaluation was cancelled or timed out before MATLAB responded.
+// MATLAB may still be running the evaluation.
+var ErrMATLABRequestCancelled = errors.New("MATLAB request was cancelled")
+
+// ErrBatchRequestNotRun is set for the requests of a batch that were not sent to MATLAB, as an earlier request of the batch failed.
+var ErrBatchRequestNotRun = errors.New("request was not run, as an earlier request failed")
+
+// ErrMATLABSessionExpired is returned for a MATLAB session that was stopped because it stayed idle for longer than the idle timeout.
+var ErrMATLABSessionExpired = errors.New("MATLAB session expired")
+
+// ErrIncompatibleMATLABRelease is returned when the requested Vitis Model Composer installation does not support the release of the requested MATLAB.
+var ErrIncompatibleMATLABRelease = errors.New("MATLAB release is not supported by Vitis Model Composer")
+
+// ErrMATLABSessionNotShared is returned when attaching to a MATLAB session that has not shared its connection details.
+var ErrMATLABSessionNotShared = errors.New("MATLAB session is not shared")
+
+// ErrMATLABSessionQueueFull is returned when too many requests are already waiting for a busy MATLAB session.
+var ErrMATLABSessionQueueFull = errors.New("MATLAB session is busy")
+
+// ErrNoDisplay is returned when MATLAB needs a display, but there is no X server to show it on.
+var ErrNoDisplay = errors.New("no display available for MATLAB")
+
+// ErrMATLABProcessExited is returned for a MATLAB session whose process exited without being asked to, such as when MATLAB crashed.
+var ErrMATLABProcessExited = errors.New("MATLAB process exited")
+
+// ErrMATLABStartupFailed is returned when a MATLAB session fails to start, such as when MATLAB exits before it is ready.
+var ErrMATLABStartupFailed = errors.New("MATLAB failed to start")
+
+// ErrMATLABLicense is returned when MATLAB fails to start because it cannot check out a license.
+var ErrMATLABLicense = errors.New("MATLAB could not check out a license")
@@ -5,5 +5,6 @@ package entities
 import "context"
 
 type GlobalMATLAB interface {
-	Client(ctx context.Context, logger Logger) (MATLABSessionClient, error)
+	// Client returns the client of the MATLAB session used by mcpSession, which is nil for requests not made by an MCP client.
+	Client(ctx context.Context, logger Logger, mcpSession MCPSession) (MATLABSessionClient, error)
 }
@@ -0,0 +1,23 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// MATLABError is an error thrown by MATLAB code, with the details of the MException when MATLAB reported them.
+// Stack lists the frames from where the error was thrown outwards, and Causes holds the errors that caused it.
+type MATLABError struct {
+	Identifier string
+	Message    string
+	Stack      []MATLABStackFrame
+	Causes     []MATLABError
+}
+
+// MATLABStackFrame locates a line of MATLAB code. File is empty when MATLAB reported only the name of the function.
+type MATLABStackFrame struct {
+	File string
+	Name string
+	Line int
+}
+
+func (e *MATLABError) Error() string {
+	return "matlab error: " + e.Message
+}
@@ -2,12 +2,19 @@
 
 package entities
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type MATLABSessionClient interface {
 	Eval(ctx context.Context, sessionLogger Logger, request EvalRequest) (EvalResponse, error)
 	EvalWithCapture(ctx context.Context, logger Logger, input EvalRequest) (EvalResponse, error)
 	FEval(ctx context.Context, sessionLogger Logger, request FEvalRequest) (FEvalResponse, error)
+	// Batch sends requests to MATLAB in as few round trips as it can, and returns their responses in the same order.
+	// MATLAB runs the requests in order, and runs each of them even when an earlier one failed.
+	// The error is set when the batch as a whole failed, such as when MATLAB could not be reached.
+	Batch(ctx context.Context, sessionLogger Logger, requests []BatchRequest) ([]BatchResponse, error)
 	Ping(ctx context.Context, sessionLogger Logger) PingResponse
 }
 
@@ -16,6 +23,7 @@ type MATLABManager interface {
 	StartMATLABSession(ctx context.Context, sessionLogger Logger, startRequest SessionDetails) (SessionID, error)
 	StopMATLABSession(ctx context.Context, sessionLogger Logger, sessionID SessionID) error
 	GetMATLABSessionClient(ctx context.Context, sessionLogger Logger, sessionID SessionID) (MATLABSessionClient, error)
+	ListMATLABSessions(ctx context.Context, sessionLogger Logger) []MATLABSessionInfo
 }
 
 type EnvironmentInfo struct {
@@ -25,6 +33,26 @@ type EnvironmentInfo struct {
 
 type SessionID int
 
+// MATLABSessionInfo describes a running MATLAB session.
+// StartingFolder is the folder MATLAB started in, not its current folder, which code evaluated in the session can change.
+// For an attached session, StartTime is when the server attached to it.
+// QueuedRequests counts the requests waiting for the session while it is busy.
+// Exited is set once the MATLAB process of the session exited without being asked to.
+type MATLABSessionInfo struct {
+	SessionID      SessionID
+	MATLABRoot     string
+	VMCRoot        string
+	Release        string
+	ProcessID      int
+	StartTime      time.Time
+	LastUsed       time.Time
+	StartingFolder string
+	Busy           bool
+	QueuedRequests int
+	Attached       bool
+	Exited         *MATLABProcessExit
+}
+
 // SessionDetails is an interface to disambiguate which type of MATLAB session to start.
 type SessionDetails interface {
 	interfacelock()
@@ -40,6 +68,12 @@ type LocalSessionDetails struct {
 
 func (l LocalSessionDetails) interfacelock() {}
 
+// AttachedSessionDetails requests to attach to a MATLAB session that is already running,
+// and that shared its connection details by calling matlab_mcp.shareSession.
+type AttachedSessionDetails struct{}
+
+func (a AttachedSessionDetails) interfacelock() {}
+
 type EvalRequest struct {
 	Code string
 }
@@ -51,12 +85,12 @@ type EvalResponse struct {
 
 type FEvalRequest struct {
 	Function   string
-	Arguments  []string
+	Arguments  []MATLABValue
 	NumOutputs int
 }
 
 type FEvalResponse struct {
-	Outputs []any
+	Outputs []MATLABValue
 }
 
 type PingResponse struct {
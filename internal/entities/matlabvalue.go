@@ -0,0 +1,138 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// MATLABClass names the class of a MATLAB value.
+type MATLABClass string
+
+const (
+	MATLABClassDouble  MATLABClass = "double"
+	MATLABClassSingle  MATLABClass = "single"
+	MATLABClassInt8    MATLABClass = "int8"
+	MATLABClassInt16   MATLABClass = "int16"
+	MATLABClassInt32   MATLABClass = "int32"
+	MATLABClassInt64   MATLABClass = "int64"
+	MATLABClassUint8   MATLABClass = "uint8"
+	MATLABClassUint16  MATLABClass = "uint16"
+	MATLABClassUint32  MATLABClass = "uint32"
+	MATLABClassUint64  MATLABClass = "uint64"
+	MATLABClassLogical MATLABClass = "logical"
+	MATLABClassChar    MATLABClass = "char"
+	MATLABClassString  MATLABClass = "string"
+	MATLABClassCell    MATLABClass = "cell"
+	MATLABClassStruct  MATLABClass = "struct"
+	MATLABClassMissing MATLABClass = "missing"
+)
+
+// IsNumeric reports whether the class is one of the real numeric classes.
+func (c MATLABClass) IsNumeric() bool {
+	switch c {
+	case MATLABClassDouble, MATLABClassSingle,
+		MATLABClassInt8, MATLABClassInt16, MATLABClassInt32, MATLABClassInt64,
+		MATLABClassUint8, MATLABClassUint16, MATLABClassUint32, MATLABClassUint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// MATLABValue is a value passed to or returned by a MATLAB function.
+// Arrays hold their elements in column-major order, as MATLAB does, and Dimensions holds their size, such as [2 3].
+type MATLABValue interface {
+	Class() MATLABClass
+	isMATLABValue()
+}
+
+// MATLABNumeric is a real numeric array. Values of the integer classes must be whole numbers;
+// int64 and uint64 values beyond 2^53 cannot be represented exactly.
+type MATLABNumeric struct {
+	NumericClass MATLABClass
+	Dimensions   []int
+	Values       []float64
+}
+
+func (v MATLABNumeric) Class() MATLABClass { return v.NumericClass }
+
+func (v MATLABNumeric) isMATLABValue() {}
+
+// MATLABLogical is a logical array.
+type MATLABLogical struct {
+	Dimensions []int
+	Values     []bool
+}
+
+func (v MATLABLogical) Class() MATLABClass { return MATLABClassLogical }
+
+func (v MATLABLogical) isMATLABValue() {}
+
+// MATLABChar is a character row vector, such as 'hello'.
+type MATLABChar struct {
+	Value string
+}
+
+func (v MATLABChar) Class() MATLABClass { return MATLABClassChar }
+
+func (v MATLABChar) isMATLABValue() {}
+
+// MATLABString is a string array, such as "hello". A nil element is a missing string.
+type MATLABString struct {
+	Dimensions []int
+	Values     []*string
+}
+
+func (v MATLABString) Class() MATLABClass { return MATLABClassString }
+
+func (v MATLABString) isMATLABValue() {}
+
+// MATLABCell is a cell array.
+type MATLABCell struct {
+	Dimensions []int
+	Values     []MATLABValue
+}
+
+func (v MATLABCell) Class() MATLABClass { return MATLABClassCell }
+
+func (v MATLABCell) isMATLABValue() {}
+
+// MATLABStruct is a struct array. Fields holds the field names in order, and each element maps every field name to its value.
+type MATLABStruct struct {
+	Dimensions []int
+	Fields     []string
+	Values     []map[string]MATLABValue
+}
+
+func (v MATLABStruct) Class() MATLABClass { return MATLABClassStruct }
+
+func (v MATLABStruct) isMATLABValue() {}
+
+// MATLABMissing is the missing value.
+type MATLABMissing struct{}
+
+func (v MATLABMissing) Class() MATLABClass { return MATLABClassMissing }
+
+func (v MATLABMissing) isMATLABValue() {}
+
+// NewMATLABDouble returns a double scalar.
+func NewMATLABDouble(value float64) MATLABNumeric {
+	return MATLABNumeric{
+		NumericClass: MATLABClassDouble,
+		Dimensions:   []int{1, 1},
+		Values:       []float64{value},
+	}
+}
+
+// NewMATLABLogical returns a logical scalar.
+func NewMATLABLogical(value bool) MATLABLogical {
+	return MATLABLogical{
+		Dimensions: []int{1, 1},
+		Values:     []bool{value},
+	}
+}
+
+// NewMATLABString returns a string scalar.
+func NewMATLABString(value string) MATLABString {
+	return MATLABString{
+		Dimensions: []int{1, 1},
+		Values:     []*string{&value},
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "context"
+
+// MCPSession is the connection of one MCP client to the server.
+type MCPSession interface {
+	ID() string
+	// Wait blocks until the client disconnects.
+	Wait() error
+}
+
+type mcpSessionContextKey struct{}
+
+// WithMCPSession returns a copy of ctx that carries the MCP session a request was made in.
+func WithMCPSession(ctx context.Context, session MCPSession) context.Context {
+	return context.WithValue(ctx, mcpSessionContextKey{}, session)
+}
+
+// MCPSessionFromContext returns the MCP session carried by ctx, or nil for requests not made by an MCP client.
+func MCPSessionFromContext(ctx context.Context) MCPSession {
+	session, _ := ctx.Value(mcpSessionContextKey{}).(MCPSession)
+	return session
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MATLABProcessExit describes how a supervised MATLAB process exited.
+// ExitCode is -1 when the process was killed by a signal, which Signal names.
+// StdoutTail and StderrTail hold the last lines MATLAB wrote, and CrashDumps the crash dump files MATLAB wrote to the session directory.
+type MATLABProcessExit struct {
+	ProcessID  int
+	ExitCode   int
+	Signal     string
+	ExitTime   time.Time
+	StdoutTail string
+	StderrTail string
+	CrashDumps []string
+}
+
+func (e MATLABProcessExit) String() string {
+	if e.Signal != "" {
+		return fmt.Sprintf("MATLAB process %d was killed by signal %s", e.ProcessID, e.Signal)
+	}
+	return fmt.Sprintf("MATLAB process %d exited with code %d", e.ProcessID, e.ExitCode)
+}
+
+// MATLABProcessMonitor reports the exit of a supervised MATLAB process: Done is closed once the process has exited.
+type MATLABProcessMonitor struct {
+	once *sync.Once
+	done chan struct{}
+	exit MATLABProcessExit
+}
+
+func NewMATLABProcessMonitor() *MATLABProcessMonitor {
+	return &MATLABProcessMonitor{
+		once: new(sync.Once),
+		done: make(chan struct{}),
+	}
+}
+
+// Exited records how the process exited. Only the first call has an effect.
+func (m *MATLABProcessMonitor) Exited(exit MATLABProcessExit) {
+	m.once.Do(func() {
+		m.exit = exit
+		close(m.done)
+	})
+}
+
+func (m *MATLABProcessMonitor) Done() <-chan struct{} {
+	return m.done
+}
+
+// Exit returns how the process exited, and false while it is still running.
+func (m *MATLABProcessMonitor) Exit() (MATLABProcessExit, bool) {
+	select {
+	case <-m.done:
+		return m.exit, true
+	default:
+		return MATLABProcessExit{}, false
+	}
+}
+
+// MATLABProcessExitedError is returned for the calls to a MATLAB session after its process exited unexpectedly.
+// Its message is a crash report for the agent: how MATLAB exited, its crash dumps, and the last lines it wrote.
+type MATLABProcessExitedError struct {
+	SessionID SessionID
+	Exit      MATLABProcessExit
+}
+
+func (e *MATLABProcessExitedError) Error() string {
+	var report strings.Builder
+
+	fmt.Fprintf(&report, "%v: MATLAB session %v is no longer running, %s at %s", ErrMATLABProcessExited, e.SessionID, e.Exit, e.Exit.ExitTime.Format(time.RFC3339))
+
+	if len(e.Exit.CrashDumps) > 0 {
+		report.WriteString("\n\nCrash dumps:")
+		for _, crashDump := range e.Exit.CrashDumps {
+			report.WriteString("\n  " + crashDump)
+		}
+	}
+
+	for _, output := range []struct {
+		name string
+		tail string
+	}{
+		{name: "standard error", tail: e.Exit.StderrTail},
+		{name: "standard output", tail: e.Exit.StdoutTail},
+	} {
+		if output.tail != "" {
+			fmt.Fprintf(&report, "\n\nLast lines of MATLAB %s:\n%s", output.name, output.tail)
+		}
+	}
+
+	report.WriteString("\n\nStop the session to clean it up, and start a new session to continue.")
+
+	return report.String()
+}
+
+func (e *MATLABProcessExitedError) Unwrap() error {
+	return ErrMATLABProcessExited
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "context"
+
+// ProgressReporter reports the progress of a long running request to the MCP client that made it.
+type ProgressReporter interface {
+	Report(message string)
+}
+
+type progressReporterContextKey struct{}
+
+// WithProgressReporter returns a copy of ctx that carries reporter.
+func WithProgressReporter(ctx context.Context, reporter ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterContextKey{}, reporter)
+}
+
+// ProgressReporterFromContext returns the progress reporter carried by ctx, if the client asked for progress notifications.
+func ProgressReporterFromContext(ctx context.Context) (ProgressReporter, bool) {
+	reporter, ok := ctx.Value(progressReporterContextKey{}).(ProgressReporter)
+	return reporter, ok
+}
+
+// ReportProgress reports message to the progress reporter carried by ctx. It does nothing if there is none.
+func ReportProgress(ctx context.Context, message string) {
+	if reporter, ok := ProgressReporterFromContext(ctx); ok {
+		reporter.Report(message)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import "context"
+
+type clientRootsContextKey struct{}
+
+// WithClientRoots returns a copy of ctx that carries the folders the MCP client declared as its roots.
+func WithClientRoots(ctx context.Context, roots []string) context.Context {
+	return context.WithValue(ctx, clientRootsContextKey{}, roots)
+}
+
+// ClientRootsFromContext returns the folders the MCP client declared as its roots, if the client supports roots.
+func ClientRootsFromContext(ctx context.Context) ([]string, bool) {
+	roots, ok := ctx.Value(clientRootsContextKey{}).([]string)
+	return roots, ok
+}
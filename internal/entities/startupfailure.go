@@ -0,0 +1,61 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MATLABStartupError describes why a MATLAB session failed to start.
+// Reason is ErrMATLABLicense, ErrNoDisplay or ErrIncompatibleMATLABRelease when the failure was recognised in the MATLAB logs, and nil otherwise.
+// Exit is set when MATLAB exited before it was ready, and Excerpt holds the lines of LogFile that show the failure.
+type MATLABStartupError struct {
+	Reason  error
+	Exit    *MATLABProcessExit
+	LogFile string
+	Excerpt string
+}
+
+func (e *MATLABStartupError) Error() string {
+	var message strings.Builder
+
+	message.WriteString(ErrMATLABStartupFailed.Error())
+	if e.Reason != nil {
+		message.WriteString(": " + e.Reason.Error())
+	}
+	if e.Exit != nil {
+		message.WriteString(", " + e.Exit.String())
+	}
+
+	if e.Excerpt != "" {
+		fmt.Fprintf(&message, "\n\nFrom %s:\n%s", e.LogFile, e.Excerpt)
+	}
+
+	if hint := e.hint(); hint != "" {
+		message.WriteString("\n\n" + hint)
+	}
+
+	return message.String()
+}
+
+func (e *MATLABStartupError) Unwrap() []error {
+	if e.Reason == nil {
+		return []error{ErrMATLABStartupFailed}
+	}
+	return []error{ErrMATLABStartupFailed, e.Reason}
+}
+
+func (e *MATLABStartupError) hint() string {
+	switch {
+	case errors.Is(e.Reason, ErrMATLABLicense):
+		return "Check that MATLAB can check out a license for the user running the server, for example by pointing it to a license server with --matlab-env=MLM_LICENSE_FILE=<port>@<host>."
+	case errors.Is(e.Reason, ErrNoDisplay):
+		return "Run MATLAB without a desktop with --display-mode=nodesktop, or on a virtual display with --display-mode=virtual."
+	case errors.Is(e.Reason, ErrIncompatibleMATLABRelease):
+		return "Start the session with a MATLAB release that this Vitis Model Composer release supports."
+	default:
+		return ""
+	}
+}
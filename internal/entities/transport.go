@@ -0,0 +1,10 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+type TransportMode string
+
+const (
+	TransportModeStdio TransportMode = "stdio"
+	TransportModeHTTP  TransportMode = "http"
+)
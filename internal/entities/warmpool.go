@@ -0,0 +1,11 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package entities
+
+// WarmPoolSize is how many spare MATLAB sessions to keep started for a MATLAB root.
+// The pool starts with Min spare sessions and grows towards Max when requests find it empty.
+type WarmPoolSize struct {
+	MATLABRoot string
+	Min        int
+	Max        int
+}
@@ -3,6 +3,7 @@
 package osfacade
 
 import (
+	"context"
 	"io"
 	"os"
 	"os/exec"
@@ -24,7 +25,11 @@ type Cmd interface {
 	StdoutPipe() (io.Reader, error)
 	StderrPipe() (io.Reader, error)
 	SetSysProcAttr(attr *syscall.SysProcAttr)
+	SetEnv(env []string)
+	SetStdout(stdout io.Writer)
+	SetStderr(stderr io.Writer)
 	Start() error
+	Run() error
 }
 
 // Command wraps the exec.Command
@@ -34,6 +39,13 @@ func (osw *OsFacade) Command(name string, arg ...string) Cmd {
 	}
 }
 
+// CommandContext wraps the exec.CommandContext
+func (osw *OsFacade) CommandContext(ctx context.Context, name string, arg ...string) Cmd {
+	return &CmdWrapper{
+		Cmd: exec.CommandContext(ctx, name, arg...),
+	}
+}
+
 type CmdWrapper struct {
 	*exec.Cmd
 }
@@ -53,3 +65,15 @@ func (c *CmdWrapper) StderrPipe() (io.Reader, error) {
 func (c *CmdWrapper) SetSysProcAttr(attr *syscall.SysProcAttr) {
 	c.SysProcAttr = attr
 }
+
+func (c *CmdWrapper) SetEnv(env []string) {
+	c.Env = env
+}
+
+func (c *CmdWrapper) SetStdout(stdout io.Writer) {
+	c.Stdout = stdout
+}
+
+func (c *CmdWrapper) SetStderr(stderr io.Writer) {
+	c.Stderr = stderr
+}
@@ -4,6 +4,7 @@ package osfacade
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 )
 
@@ -104,11 +105,21 @@ func (osw *OsFacade) WriteFile(name string, data []byte, perm os.FileMode) error
 	return os.WriteFile(name, data, perm)
 }
 
+// Glob wraps the filepath.Glob function to list the files matching a pattern.
+func (osw *OsFacade) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
 // UserHomeDir wraps the os.UserHomeDir function to get the user's home directory
 func (osw *OsFacade) UserHomeDir() (string, error) {
 	return os.UserHomeDir()
 }
 
+// EvalSymlinks wraps the filepath.EvalSymlinks function to resolve the symbolic links in a path.
+func (osw *OsFacade) EvalSymlinks(path string) (string, error) {
+	return filepath.EvalSymlinks(path)
+}
+
 // Create wraps the os.Create function to create a file.
 func (osw *OsFacade) Create(name string) (File, error) {
 	file, err := os.Create(name) //nolint:gosec // Intentional os.Create usage in facade
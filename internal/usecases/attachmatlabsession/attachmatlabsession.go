@@ -0,0 +1,56 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachmatlabsession
+
+import (
+	"context"
+	"errors"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+)
+
+type Usecase struct {
+	matlabManager entities.MATLABManager
+}
+
+type ReturnArgs struct {
+	SessionID entities.SessionID
+}
+
+func New(
+	matlabManager entities.MATLABManager,
+) *Usecase {
+	return &Usecase{
+		matlabManager: matlabManager,
+	}
+}
+
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request entities.AttachedSessionDetails) (ReturnArgs, error) {
+	sessionLogger.Debug("Entering AttachMATLABSession Usecase")
+	defer sessionLogger.Debug("Exiting AttachMATLABSession Usecase")
+
+	sessionID, err := u.matlabManager.StartMATLABSession(ctx, sessionLogger, request)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	sessionLogger = sessionLogger.With("session_id", sessionID)
+
+	sessionLogger.Debug("Getting the session client")
+	client, err := u.matlabManager.GetMATLABSessionClient(ctx, sessionLogger, sessionID)
+	if err != nil {
+		return ReturnArgs{}, err
+	}
+
+	// The shared details outlive the MATLAB session that wrote them, so check that it still answers.
+	if !client.Ping(ctx, sessionLogger).IsAlive {
+		if err := u.matlabManager.StopMATLABSession(ctx, sessionLogger, sessionID); err != nil {
+			sessionLogger.WithError(err).Warn("Failed to detach from MATLAB session")
+		}
+		return ReturnArgs{}, errors.New("shared MATLAB session does not respond, run matlab_mcp.shareSession() in a running MATLAB session, then attach again")
+	}
+
+	return ReturnArgs{
+		SessionID: sessionID,
+	}, nil
+}
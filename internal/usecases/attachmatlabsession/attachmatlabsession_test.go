@@ -0,0 +1,132 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package attachmatlabsession_test
+
+import (
+	"testing"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachmatlabsession"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	usecase := attachmatlabsession.New(mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const expectedSessionID = entities.SessionID(5)
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), entities.AttachedSessionDetails{}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Ping(ctx, mockLogger.AsMockArg()).
+		Return(entities.PingResponse{IsAlive: true}).
+		Once()
+
+	usecase := attachmatlabsession.New(mockMATLABManager)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, entities.AttachedSessionDetails{})
+
+	// Assert
+	require.NoError(t, err, "Execute should not return an error")
+	assert.Equal(t, expectedSessionID, response.SessionID, "Session ID should match expected value")
+}
+
+func TestUsecase_Execute_StartMATLABSessionError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	ctx := t.Context()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), entities.AttachedSessionDetails{}).
+		Return(entities.SessionID(0), entities.ErrMATLABSessionNotShared).
+		Once()
+
+	usecase := attachmatlabsession.New(mockMATLABManager)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, entities.AttachedSessionDetails{})
+
+	// Assert
+	require.ErrorIs(t, err, entities.ErrMATLABSessionNotShared)
+	assert.Empty(t, response)
+}
+
+func TestUsecase_Execute_SessionNotResponding(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const expectedSessionID = entities.SessionID(5)
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), entities.AttachedSessionDetails{}).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Ping(ctx, mockLogger.AsMockArg()).
+		Return(entities.PingResponse{IsAlive: false}).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StopMATLABSession(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(nil).
+		Once()
+
+	usecase := attachmatlabsession.New(mockMATLABManager)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, entities.AttachedSessionDetails{})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "matlab_mcp.shareSession()")
+	assert.Empty(t, response)
+}
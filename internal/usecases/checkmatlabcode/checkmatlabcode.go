@@ -19,7 +19,7 @@ type ReturnArgs struct {
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
 }
 
 type Usecase struct {
@@ -38,7 +38,7 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering CheckMATLABCode Usecase")
 	defer sessionLogger.Debug("Exiting CheckMATLABCode Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(checkcodeRequest.ScriptPath)
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath)
 	if err != nil {
 		return ReturnArgs{}, fmt.Errorf("path validation failed: %w", err)
 	}
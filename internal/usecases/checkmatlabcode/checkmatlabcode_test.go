@@ -50,7 +50,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	const expectedCheckCodeOutput = "L 5 (C 1-10): Variable 'x' might be unused."
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
@@ -93,7 +93,7 @@ func TestUsecase_Execute_HappyPath_OutputWithWhitespaceAndEmptyLines(t *testing.
 	expectedCleanedOutput := []string{"Line 1: Warning", "Line 3: Error"}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
@@ -135,7 +135,7 @@ func TestUsecase_Execute_HappyPath_EmptyOutput(t *testing.T) {
 	const expectedCheckCodeOutput = "No issues found by checkcode"
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
@@ -177,7 +177,7 @@ func TestUsecase_Execute_HappyPath_PathWithSingleQuotes(t *testing.T) {
 	const expectedCheckCodeOutput = "L 5 (C 1-10): Variable 'x' might be unused."
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
@@ -218,7 +218,7 @@ func TestUsecase_Execute_PathValidationError(t *testing.T) {
 	expectedError := assert.AnError
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return("", expectedError).
 		Once()
 
@@ -251,7 +251,7 @@ func TestUsecase_Execute_EvalWithCaptureError(t *testing.T) {
 	expectedError := assert.AnError
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(checkcodeRequest.ScriptPath).
+		ValidateMATLABScript(ctx, checkcodeRequest.ScriptPath).
 		Return(validatedPath, nil).
 		Once()
 
@@ -16,7 +16,7 @@ type Args struct {
 }
 
 type PathValidator interface {
-	ValidateFolderPath(filePath string) (string, error)
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
 }
 
 type Usecase struct {
@@ -35,22 +35,16 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering EvalInlMATLAB Usecase")
 	defer sessionLogger.Debug("Exiting EvalInMATLAB Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateFolderPath(request.ProjectPath)
+	validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, request.ProjectPath)
 	if err != nil {
 		sessionLogger.WithError(err).With("path", request.ProjectPath).Warn("Path validation failed")
 		return entities.EvalResponse{}, fmt.Errorf("path validation failed: %w", err)
 	}
 
-	cdRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("cd('%s')", strings.ReplaceAll(validatedPath, "'", "''")), // Escape single quotes
-	}
-	_, err = client.Eval(ctx, sessionLogger, cdRequest)
-	if err != nil {
-		return entities.EvalResponse{}, err
-	}
-
+	// Changing folder and evaluating the code are sent as one piece of code, so that MATLAB does not run the code
+	// when changing folder fails, and no other tool call to the session runs in between.
 	response, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{
-		Code: request.Code,
+		Code: fmt.Sprintf("cd('%s');\n%s", strings.ReplaceAll(validatedPath, "'", "''"), request.Code), // Escape single quotes
 	})
 	if err != nil {
 		return entities.EvalResponse{}, err
@@ -53,19 +53,14 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return(validatedProjectPath, nil).
 		Once()
 
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
-			Code: "cd('" + validatedProjectPath + "')",
+			Code: "cd('" + validatedProjectPath + "');\n" + evalRequest.Code,
 		}).
-		Return(entities.EvalResponse{}, nil).
-		Once()
-
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: evalRequest.Code}).
 		Return(expectedResponse, nil).
 		Once()
 
@@ -99,7 +94,7 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return("", expectedError).
 		Once()
 
@@ -113,7 +108,7 @@ func TestUsecase_Execute_ValidatePathError(t *testing.T) {
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
 
-func TestUsecase_Execute_CDEvalError(t *testing.T) {
+func TestUsecase_Execute_EvalError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -136,15 +131,15 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
+		ValidateFolderPath(ctx, projectPath).
 		Return(validatedProjectPath, nil).
 		Once()
 
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
-			Code: "cd('" + validatedProjectPath + "')",
+			Code: "cd('" + validatedProjectPath + "');\n" + evalRequest.Code,
 		}).
-		Return(entities.EvalResponse{}, expectedError).
+		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
 		Once()
 
 	usecase := evalmatlabcode.New(mockPathValidator)
@@ -157,7 +152,7 @@ func TestUsecase_Execute_CDEvalError(t *testing.T) {
 	assert.Empty(t, response, "Response should be empty when there's an error")
 }
 
-func TestUsecase_Execute_EvalError(t *testing.T) {
+func TestUsecase_Execute_EscapesQuotesInFolder(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
 
@@ -167,41 +162,28 @@ func TestUsecase_Execute_EvalError(t *testing.T) {
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
-	projectPath := filepath.Join("some", "path")
-	validatedProjectPath := filepath.Join("some", "path")
-
-	evalRequest := evalmatlabcode.Args{
-		Code:        "disp('Hello, World!')",
-		ProjectPath: projectPath,
-	}
-
-	expectedError := assert.AnError
-
 	ctx := t.Context()
+	projectPath := filepath.Join("some", "user's path")
+	code := "x = 1"
 
 	mockPathValidator.EXPECT().
-		ValidateFolderPath(projectPath).
-		Return(validatedProjectPath, nil).
+		ValidateFolderPath(ctx, projectPath).
+		Return(projectPath, nil).
 		Once()
 
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{
-			Code: "cd('" + validatedProjectPath + "')",
+			Code: "cd('" + filepath.Join("some", "user''s path") + "');\n" + code,
 		}).
-		Return(entities.EvalResponse{}, nil).
-		Once()
-
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: evalRequest.Code}).
-		Return(entities.EvalResponse{ConsoleOutput: "some output that shouldn't be because there's an error"}, expectedError).
+		Return(entities.EvalResponse{ConsoleOutput: "x = 1"}, nil).
 		Once()
 
 	usecase := evalmatlabcode.New(mockPathValidator)
 
 	// Act
-	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalRequest)
+	response, err := usecase.Execute(ctx, mockLogger, mockClient, evalmatlabcode.Args{Code: code, ProjectPath: projectPath})
 
 	// Assert
-	require.ErrorIs(t, err, expectedError, "Error should be the original error")
-	assert.Empty(t, response, "Response should be empty when there's an error")
+	require.NoError(t, err)
+	assert.Equal(t, "x = 1", response.ConsoleOutput)
 }
@@ -0,0 +1,63 @@
+// Copyright 2025 The MathWorks, Inc.
+
+package listmatlabsessions_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
+	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
+	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNew_HappyPath(t *testing.T) {
+	// Arrange
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	// Act
+	usecase := listmatlabsessions.New(mockMATLABManager)
+
+	// Assert
+	assert.NotNil(t, usecase, "Usecase should not be nil")
+}
+
+func TestUsecase_Execute_HappyPath(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	startTime := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	mockSessions := []entities.MATLABSessionInfo{
+		{
+			SessionID:      1,
+			MATLABRoot:     filepath.Join("path", "to", "matlab", "R2024b"),
+			Release:        "R2024b",
+			ProcessID:      1234,
+			StartTime:      startTime,
+			LastUsed:       startTime.Add(time.Minute),
+			StartingFolder: filepath.Join("path", "to", "work"),
+			Busy:           true,
+		},
+	}
+
+	mockMATLABManager.EXPECT().
+		ListMATLABSessions(mock.Anything, mockLogger.AsMockArg()).
+		Return(mockSessions).
+		Once()
+
+	usecase := listmatlabsessions.New(mockMATLABManager)
+
+	// Act
+	result := usecase.Execute(t.Context(), mockLogger)
+
+	// Assert
+	assert.Equal(t, listmatlabsessions.ReturnArgs(mockSessions), result)
+}
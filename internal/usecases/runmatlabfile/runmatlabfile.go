@@ -15,7 +15,7 @@ type Args struct {
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
 }
 
 type Usecase struct {
@@ -34,22 +34,16 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering RunMATLABFile Usecase")
 	defer sessionLogger.Debug("Exiting RunMATLABFile Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, request.ScriptPath)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
 
 	scriptDir, scriptName := pathextractor.ExtractPathComponents(validatedPath)
 
-	_, err = client.Eval(ctx, sessionLogger, entities.EvalRequest{
-		Code: fmt.Sprintf("cd('%s')", scriptDir),
+	// Changing folder and running the script are sent as one piece of code, so that MATLAB does not run the script
+	// when changing folder fails, and no other tool call to the session runs in between.
+	return client.Eval(ctx, sessionLogger, entities.EvalRequest{
+		Code: fmt.Sprintf("cd('%s');\n%s", scriptDir, scriptName),
 	})
-	if err != nil {
-		return entities.EvalResponse{}, err
-	}
-
-	runCodeRequest := entities.EvalRequest{
-		Code: scriptName,
-	}
-	return client.Eval(ctx, sessionLogger, runCodeRequest)
 }
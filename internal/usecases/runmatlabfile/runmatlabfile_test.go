@@ -45,12 +45,8 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 
 	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
 
-	expectedCdRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("cd('%s')", scriptDir),
-	}
-
 	expectedEvalRequest := entities.EvalRequest{
-		Code: fileName,
+		Code: fmt.Sprintf("cd('%s');\n%s", scriptDir, fileName),
 	}
 
 	expectedResponse := entities.EvalResponse{
@@ -59,15 +55,10 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
-		Return(entities.EvalResponse{}, nil).
-		Once()
-
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(expectedResponse, nil).
@@ -102,7 +93,7 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return("", expectedError).
 		Once()
 
@@ -116,48 +107,6 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	assert.Empty(t, response, "Response should be empty")
 }
 
-func TestUsecase_Execute_CdEvalError(t *testing.T) {
-	// Arrange
-	mockLogger := testutils.NewInspectableLogger()
-
-	mockPathValidator := &mocks.MockPathValidator{}
-	defer mockPathValidator.AssertExpectations(t)
-
-	mockClient := &entitiesmocks.MockMATLABSessionClient{}
-	defer mockClient.AssertExpectations(t)
-
-	ctx := t.Context()
-	fileName := "file"
-	scriptDir := filepath.Join("some", "path", "to")
-	scriptPath := filepath.Join(scriptDir, fileName+".m")
-	expectedError := assert.AnError
-
-	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
-
-	expectedCdRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("cd('%s')", scriptDir),
-	}
-
-	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
-		Return(scriptPath, nil).
-		Once()
-
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
-		Return(entities.EvalResponse{}, expectedError).
-		Once()
-
-	usecase := runmatlabfile.New(mockPathValidator)
-
-	// Act
-	response, err := usecase.Execute(ctx, mockLogger, mockClient, usecaseRequest)
-
-	// Assert
-	require.ErrorIs(t, err, expectedError)
-	assert.Empty(t, response, "Response should be empty")
-}
-
 func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -176,24 +125,15 @@ func TestUsecase_Execute_RunMATLABFileEvalError(t *testing.T) {
 
 	usecaseRequest := runmatlabfile.Args{ScriptPath: scriptPath}
 
-	expectedCdRequest := entities.EvalRequest{
-		Code: fmt.Sprintf("cd('%s')", scriptDir),
-	}
-
 	expectedEvalRequest := entities.EvalRequest{
-		Code: fileName,
+		Code: fmt.Sprintf("cd('%s');\n%s", scriptDir, fileName),
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), expectedCdRequest).
-		Return(entities.EvalResponse{}, nil).
-		Once()
-
 	mockClient.EXPECT().
 		Eval(ctx, mockLogger.AsMockArg(), expectedEvalRequest).
 		Return(entities.EvalResponse{}, expectedError).
@@ -15,7 +15,7 @@ type Args struct {
 }
 
 type PathValidator interface {
-	ValidateMATLABScript(filePath string) (string, error)
+	ValidateMATLABScript(ctx context.Context, filePath string) (string, error)
 }
 
 type Usecase struct {
@@ -34,7 +34,7 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, cl
 	sessionLogger.Debug("Entering RunMATLABTestFile Usecase")
 	defer sessionLogger.Debug("Exiting RunMATLABTestFile Usecase")
 
-	validatedPath, err := u.pathValidator.ValidateMATLABScript(request.ScriptPath)
+	validatedPath, err := u.pathValidator.ValidateMATLABScript(ctx, request.ScriptPath)
 	if err != nil {
 		return entities.EvalResponse{}, err
 	}
@@ -58,7 +58,7 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	ctx := t.Context()
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
@@ -94,7 +94,7 @@ func TestUsecase_Execute_ValidateMATLABScriptError(t *testing.T) {
 	usecaseRequest := runmatlabtestfile.Args{ScriptPath: scriptPath}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return("", expectedError).
 		Once()
 
@@ -129,7 +129,7 @@ func TestUsecase_Execute_RunMATLABTestFileEvalError(t *testing.T) {
 	}
 
 	mockPathValidator.EXPECT().
-		ValidateMATLABScript(scriptPath).
+		ValidateMATLABScript(ctx, scriptPath).
 		Return(scriptPath, nil).
 		Once()
 
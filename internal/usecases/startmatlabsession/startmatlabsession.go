@@ -4,12 +4,30 @@ package startmatlabsession
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 )
 
+type PathValidator interface {
+	ValidateFolderPath(ctx context.Context, filePath string) (string, error)
+}
+
+type Config interface {
+	PreferredVMCRoot() string
+}
+
 type Usecase struct {
 	matlabManager entities.MATLABManager
+	pathValidator PathValidator
+	config        Config
+}
+
+type Args struct {
+	Session entities.LocalSessionDetails
+	// UseVMC starts Vitis Model Composer, from the installation set with --vmc-root, instead of MATLAB.
+	UseVMC bool
 }
 
 type ReturnArgs struct {
@@ -20,16 +38,43 @@ type ReturnArgs struct {
 
 func New(
 	matlabManager entities.MATLABManager,
+	pathValidator PathValidator,
+	config Config,
 ) *Usecase {
 	return &Usecase{
 		matlabManager: matlabManager,
+		pathValidator: pathValidator,
+		config:        config,
 	}
 }
 
-func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, request entities.SessionDetails) (ReturnArgs, error) {
+func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, args Args) (ReturnArgs, error) {
 	sessionLogger.Debug("Entering StartMATLABSession Usecase")
 	defer sessionLogger.Debug("Exiting StartMATLABSession Usecase")
 
+	request := args.Session
+
+	if args.UseVMC {
+		// Starting a session runs executables and scripts from the installation, so only the one set with --vmc-root is used.
+		request.VMCRoot = u.config.PreferredVMCRoot()
+		if request.VMCRoot == "" {
+			err := errors.New("cannot use Vitis Model Composer: the server was started without --vmc-root")
+			sessionLogger.WithError(err).Warn("No VMC root to start the session with")
+			return ReturnArgs{}, err
+		}
+	}
+
+	if request.IsStartingDirectorySet {
+		validatedPath, err := u.pathValidator.ValidateFolderPath(ctx, request.StartingDirectory)
+		if err != nil {
+			sessionLogger.WithError(err).With("path", request.StartingDirectory).Warn("Starting folder validation failed")
+			return ReturnArgs{}, fmt.Errorf("starting folder validation failed: %w", err)
+		}
+		request.StartingDirectory = validatedPath
+	}
+
+	entities.ReportProgress(ctx, "Starting MATLAB, this can take a few minutes")
+
 	sessionID, err := u.matlabManager.StartMATLABSession(ctx, sessionLogger, request)
 	if err != nil {
 		return ReturnArgs{}, err
@@ -43,21 +88,24 @@ func (u *Usecase) Execute(ctx context.Context, sessionLogger entities.Logger, re
 		return ReturnArgs{}, err
 	}
 
-	sessionLogger.Debug("Evaluating ver")
-	verResponse, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "ver"})
+	sessionLogger.Debug("Evaluating ver and Add-Ons")
+	responses, err := client.Batch(ctx, sessionLogger, []entities.BatchRequest{
+		entities.EvalRequest{Code: "ver"},
+		entities.EvalRequest{Code: "matlab.addons.installedAddons()"},
+	})
 	if err != nil {
 		return ReturnArgs{}, err
 	}
 
-	sessionLogger.Debug("Evaluating Add-Ons")
-	AddOnsResponse, err := client.Eval(ctx, sessionLogger, entities.EvalRequest{Code: "matlab.addons.installedAddons()"})
-	if err != nil {
+	if err := entities.FirstBatchError(responses); err != nil {
 		return ReturnArgs{}, err
 	}
 
+	entities.ReportProgress(ctx, "Enumerated installed toolboxes and add-ons")
+
 	return ReturnArgs{
 		SessionID:    sessionID,
-		VerOutput:    verResponse.ConsoleOutput,
-		AddOnsOutput: AddOnsResponse.ConsoleOutput,
+		VerOutput:    responses[0].Eval.ConsoleOutput,
+		AddOnsOutput: responses[1].Eval.ConsoleOutput,
 	}, nil
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/testutils"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/startmatlabsession"
 	entitiesmocks "github.com/matlab/matlab-mcp-core-server/mocks/entities"
+	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/startmatlabsession"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,8 +26,14 @@ func TestNew_HappyPath(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	// Act
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
 
 	// Assert
 	assert.NotNil(t, usecase, "Usecase should not be nil")
@@ -38,6 +46,12 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -60,21 +74,22 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 		Return(mockClient, nil).
 		Once()
 
-	// Mock the EvalInMATLABSession calls
+	// Mock the batch of EvalInMATLABSession calls
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
-		Return(entities.EvalResponse{ConsoleOutput: expectedVerOutput}, nil).
+		Batch(ctx, mockLogger.AsMockArg(), []entities.BatchRequest{
+			entities.EvalRequest{Code: verCode},
+			entities.EvalRequest{Code: addOnsCode},
+		}).
+		Return([]entities.BatchResponse{
+			{Eval: entities.EvalResponse{ConsoleOutput: expectedVerOutput}},
+			{Eval: entities.EvalResponse{ConsoleOutput: expectedAddOnsOutput}},
+		}, nil).
 		Once()
 
-	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: addOnsCode}).
-		Return(entities.EvalResponse{ConsoleOutput: expectedAddOnsOutput}, nil).
-		Once()
-
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
 
 	// Act
-	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
 
 	// Assert
 	require.NoError(t, err, "Execute should not return an error")
@@ -83,6 +98,66 @@ func TestUsecase_Execute_HappyPath(t *testing.T) {
 	assert.Equal(t, expectedAddOnsOutput, response.AddOnsOutput, "AddOns output should match expected value")
 }
 
+func TestUsecase_Execute_ReportsProgress(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	mockProgressReporter := &entitiesmocks.MockProgressReporter{}
+	defer mockProgressReporter.AssertExpectations(t)
+
+	startSessionRequest := entities.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
+	}
+
+	ctx := entities.WithProgressReporter(t.Context(), mockProgressReporter)
+	const expectedSessionID = entities.SessionID(123)
+
+	mockProgressReporter.EXPECT().
+		Report("Starting MATLAB, this can take a few minutes").
+		Return().
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), startSessionRequest).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockClient, nil).
+		Once()
+
+	mockClient.EXPECT().
+		Batch(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return([]entities.BatchResponse{{}, {}}, nil).
+		Once()
+
+	mockProgressReporter.EXPECT().
+		Report("Enumerated installed toolboxes and add-ons").
+		Return().
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
+
+	// Act
+	_, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
+
+	// Assert
+	require.NoError(t, err)
+}
+
 func TestUsecase_Execute_StartSessionError(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -90,6 +165,12 @@ func TestUsecase_Execute_StartSessionError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	startSessionRequest := entities.LocalSessionDetails{
 		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
 	}
@@ -103,10 +184,10 @@ func TestUsecase_Execute_StartSessionError(t *testing.T) {
 		Return(sessionIDThatShouldBeUnused, expectedError).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
 
 	// Act
-	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
 
 	// Assert
 	require.Error(t, err, "Execute should return an error")
@@ -121,6 +202,12 @@ func TestUsecase_Execute_GetMATLABSessionClientError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	startSessionRequest := entities.LocalSessionDetails{
 		MATLABRoot: filepath.Join("path", "to", "matlab", "R2023a"),
 	}
@@ -139,10 +226,10 @@ func TestUsecase_Execute_GetMATLABSessionClientError(t *testing.T) {
 		Return(nil, expectedError).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
 
 	// Act
-	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
 
 	// Assert
 	require.Error(t, err, "Execute should return an error")
@@ -157,6 +244,12 @@ func TestUsecase_Execute_VerEvalError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -179,14 +272,17 @@ func TestUsecase_Execute_VerEvalError(t *testing.T) {
 		Once()
 
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
-		Return(entities.EvalResponse{}, expectedError).
+		Batch(ctx, mockLogger.AsMockArg(), []entities.BatchRequest{
+			entities.EvalRequest{Code: verCode},
+			entities.EvalRequest{Code: addOnsCode},
+		}).
+		Return([]entities.BatchResponse{{Err: expectedError}, {}}, nil).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
 
 	// Act
-	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
 
 	// Assert
 	require.Error(t, err, "Execute should return an error")
@@ -201,6 +297,12 @@ func TestUsecase_Execute_AddOnsEvalError(t *testing.T) {
 	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
 	defer mockMATLABManager.AssertExpectations(t)
 
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
 	mockClient := &entitiesmocks.MockMATLABSessionClient{}
 	defer mockClient.AssertExpectations(t)
 
@@ -223,24 +325,165 @@ func TestUsecase_Execute_AddOnsEvalError(t *testing.T) {
 		Return(mockClient, nil).
 		Once()
 
+	// Mock the second EvalInMATLABSession call of the batch to fail
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: verCode}).
-		Return(entities.EvalResponse{ConsoleOutput: expectedVerOutput}, nil).
+		Batch(ctx, mockLogger.AsMockArg(), []entities.BatchRequest{
+			entities.EvalRequest{Code: verCode},
+			entities.EvalRequest{Code: addOnsCode},
+		}).
+		Return([]entities.BatchResponse{
+			{Eval: entities.EvalResponse{ConsoleOutput: expectedVerOutput}},
+			{Err: expectedError},
+		}, nil).
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
+
+	// Assert
+	require.Error(t, err, "Execute should return an error")
+	assert.Empty(t, response, "Response should be empty when there's an error")
+	assert.ErrorIs(t, err, expectedError, "Error should be the original error")
+}
+
+func TestUsecase_Execute_ValidatesStartingFolder(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockClient := &entitiesmocks.MockMATLABSessionClient{}
+	defer mockClient.AssertExpectations(t)
+
+	ctx := t.Context()
+	const expectedSessionID = entities.SessionID(123)
+	requestedFolder := filepath.Join("home", "user", "project", "..", "project")
+	validatedFolder := filepath.Join("home", "user", "project")
+
+	vmcRoot := filepath.Join("tools", "Xilinx", "2025.1", "Model_Composer")
+
+	startSessionRequest := entities.LocalSessionDetails{
+		MATLABRoot:             filepath.Join("path", "to", "matlab", "R2024b"),
+		IsStartingDirectorySet: true,
+		StartingDirectory:      requestedFolder,
+		ShowMATLABDesktop:      true,
+	}
+
+	expectedStartSessionRequest := startSessionRequest
+	expectedStartSessionRequest.VMCRoot = vmcRoot
+	expectedStartSessionRequest.StartingDirectory = validatedFolder
+
+	mockConfig.EXPECT().
+		PreferredVMCRoot().
+		Return(vmcRoot).
+		Once()
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, requestedFolder).
+		Return(validatedFolder, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		StartMATLABSession(ctx, mockLogger.AsMockArg(), expectedStartSessionRequest).
+		Return(expectedSessionID, nil).
+		Once()
+
+	mockMATLABManager.EXPECT().
+		GetMATLABSessionClient(ctx, mockLogger.AsMockArg(), expectedSessionID).
+		Return(mockClient, nil).
 		Once()
 
-	// Mock the second EvalInMATLABSession call to fail
 	mockClient.EXPECT().
-		Eval(ctx, mockLogger.AsMockArg(), entities.EvalRequest{Code: addOnsCode}).
-		Return(entities.EvalResponse{}, expectedError).
+		Batch(ctx, mockLogger.AsMockArg(), mock.Anything).
+		Return([]entities.BatchResponse{{}, {}}, nil).
 		Once()
 
-	usecase := startmatlabsession.New(mockMATLABManager)
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
 
 	// Act
-	response, err := usecase.Execute(ctx, mockLogger, startSessionRequest)
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest, UseVMC: true})
 
 	// Assert
-	require.Error(t, err, "Execute should return an error")
+	require.NoError(t, err)
+	assert.Equal(t, expectedSessionID, response.SessionID)
+}
+
+func TestUsecase_Execute_StartingFolderValidationError(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	ctx := t.Context()
+	requestedFolder := filepath.Join("outside", "allowed", "folders")
+	expectedError := assert.AnError
+
+	startSessionRequest := entities.LocalSessionDetails{
+		MATLABRoot:             filepath.Join("path", "to", "matlab", "R2024b"),
+		IsStartingDirectorySet: true,
+		StartingDirectory:      requestedFolder,
+	}
+
+	mockPathValidator.EXPECT().
+		ValidateFolderPath(ctx, requestedFolder).
+		Return("", expectedError).
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
+
+	// Act
+	response, err := usecase.Execute(ctx, mockLogger, startmatlabsession.Args{Session: startSessionRequest})
+
+	// Assert
+	require.ErrorIs(t, err, expectedError)
+	assert.Empty(t, response)
+	assert.Contains(t, err.Error(), "starting folder validation failed")
+}
+
+func TestUsecase_Execute_UseVMCWithoutVMCRoot(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockMATLABManager := &entitiesmocks.MockMATLABManager{}
+	defer mockMATLABManager.AssertExpectations(t)
+
+	mockPathValidator := &mocks.MockPathValidator{}
+	defer mockPathValidator.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	startSessionRequest := entities.LocalSessionDetails{
+		MATLABRoot: filepath.Join("path", "to", "matlab", "R2024b"),
+	}
+
+	mockConfig.EXPECT().
+		PreferredVMCRoot().
+		Return("").
+		Once()
+
+	usecase := startmatlabsession.New(mockMATLABManager, mockPathValidator, mockConfig)
+
+	// Act
+	response, err := usecase.Execute(t.Context(), mockLogger, startmatlabsession.Args{Session: startSessionRequest, UseVMC: true})
+
+	// Assert
+	require.ErrorContains(t, err, "the server was started without --vmc-root")
 	assert.Empty(t, response, "Response should be empty when there's an error")
-	assert.ErrorIs(t, err, expectedError, "Error should be the original error")
 }
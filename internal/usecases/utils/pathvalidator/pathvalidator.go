@@ -3,31 +3,42 @@
 package pathvalidator
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
 )
 
 type OSLayer interface {
 	Stat(filePath string) (osfacade.FileInfo, error)
+	EvalSymlinks(path string) (string, error)
+}
+
+type Config interface {
+	AllowedFolders() []string
 }
 
 type PathValidator struct {
 	osLayer OSLayer
+	config  Config
 }
 
 func New(
 	osLayer OSLayer,
+	config Config,
 ) *PathValidator {
 	return &PathValidator{
 		osLayer: osLayer,
+		config:  config,
 	}
 }
 
-func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
+func (v *PathValidator) ValidateMATLABScript(ctx context.Context, filePath string) (string, error) {
 	absPath, err := resolveAbsolutePath(filePath)
 	if err != nil {
 		return "", err
@@ -38,6 +49,10 @@ func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
 		return "", fmt.Errorf("file must be a MATLAB .m file: %s", absPath)
 	}
 
+	if err := v.checkPathIsAllowed(ctx, absPath); err != nil {
+		return "", err
+	}
+
 	fileInfo, err := v.getResourceInfo(absPath)
 	if err != nil {
 		return "", err
@@ -50,12 +65,16 @@ func (v *PathValidator) ValidateMATLABScript(filePath string) (string, error) {
 	return absPath, nil
 }
 
-func (v *PathValidator) ValidateFolderPath(filePath string) (string, error) {
+func (v *PathValidator) ValidateFolderPath(ctx context.Context, filePath string) (string, error) {
 	absPath, err := resolveAbsolutePath(filePath)
 	if err != nil {
 		return "", err
 	}
 
+	if err := v.checkPathIsAllowed(ctx, absPath); err != nil {
+		return "", err
+	}
+
 	folderInfo, err := v.getResourceInfo(absPath)
 	if err != nil {
 		return "", err
@@ -68,6 +87,45 @@ func (v *PathValidator) ValidateFolderPath(filePath string) (string, error) {
 	return absPath, nil
 }
 
+// checkPathIsAllowed returns an error if filePath is not inside one of the allowed folders, which are the roots of
+// the MCP client and the folders set with --allowed-folder. Every path is only allowed when the MCP client does not
+// support roots and no folder is set with --allowed-folder; a client that supports roots but lists none allows nothing.
+// Symbolic links are resolved first, so that a link inside an allowed folder cannot point outside of it.
+func (v *PathValidator) checkPathIsAllowed(ctx context.Context, filePath string) error {
+	allowedFolders := slices.Clone(v.config.AllowedFolders())
+	clientRoots, hasClientRoots := entities.ClientRootsFromContext(ctx)
+	allowedFolders = append(allowedFolders, clientRoots...)
+
+	if len(allowedFolders) == 0 {
+		if !hasClientRoots {
+			return nil
+		}
+		return fmt.Errorf("%s is not allowed, as the MCP client lists no roots and no folder is set with --allowed-folder", filePath)
+	}
+
+	resolvedPath, err := v.osLayer.EvalSymlinks(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("resource not found: %s", filePath)
+		}
+		return fmt.Errorf("error accessing resource: %w", err)
+	}
+
+	for _, allowedFolder := range allowedFolders {
+		resolvedFolder, err := v.osLayer.EvalSymlinks(filepath.Clean(allowedFolder))
+		if err != nil {
+			// An allowed folder that does not exist cannot contain anything.
+			continue
+		}
+
+		if isInsideFolder(resolvedPath, resolvedFolder) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is outside of the allowed folders: %s", filePath, strings.Join(allowedFolders, ", "))
+}
+
 func (v *PathValidator) getResourceInfo(filePath string) (osfacade.FileInfo, error) {
 	resourceInfo, err := v.osLayer.Stat(filePath)
 	if err != nil {
@@ -89,3 +147,12 @@ func resolveAbsolutePath(filePath string) (string, error) {
 
 	return cleanPath, nil
 }
+
+func isInsideFolder(filePath string, folder string) bool {
+	relativePath, err := filepath.Rel(folder, filePath)
+	if err != nil {
+		return false
+	}
+
+	return relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
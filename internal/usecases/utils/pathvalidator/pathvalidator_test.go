@@ -5,9 +5,11 @@ package pathvalidator_test
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/utils/pathvalidator"
 	osfacademocks "github.com/matlab/matlab-mcp-core-server/mocks/facades/osfacade"
 	mocks "github.com/matlab/matlab-mcp-core-server/mocks/usecases/utils/pathvalidator"
@@ -18,9 +20,10 @@ import (
 func TestNew_HappyPath(t *testing.T) {
 	// Arrange
 	mockOsLayer := &mocks.MockOSLayer{}
+	mockConfig := &mocks.MockConfig{}
 
 	// Act
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// Assert
 	assert.NotNil(t, validator, "New() should return a non-nil Validator")
@@ -31,10 +34,18 @@ func TestValidator_ValidateMATLABScript_HappyPath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
@@ -50,7 +61,7 @@ func TestValidator_ValidateMATLABScript_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := validator.ValidateMATLABScript(testPath)
+	result, err := validator.ValidateMATLABScript(t.Context(), testPath)
 
 	// Assert
 	require.NoError(t, err)
@@ -85,10 +96,13 @@ func TestValidator_ValidateMATLABScript_InvalidPath(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 			// Act
-			_, err := validator.ValidateMATLABScript(tt.filePath)
+			_, err := validator.ValidateMATLABScript(t.Context(), tt.filePath)
 
 			// Assert
 			require.Error(t, err)
@@ -121,13 +135,16 @@ func TestValidator_ValidateMATLABScript_NotMATLABScript(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 			filePath, absErr := filepath.Abs(tt.fileName)
 			require.NoError(t, absErr)
 
 			// Act
-			_, err := validator.ValidateMATLABScript(filePath)
+			_, err := validator.ValidateMATLABScript(t.Context(), filePath)
 
 			// Assert
 			require.Error(t, err)
@@ -140,10 +157,18 @@ func TestValidator_ValidateMATLABScript_MPathIsAFolder(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// path has .m extension to pass suffix check but is registered as a folder
 	testPath, absErr := filepath.Abs("folder.m")
@@ -160,7 +185,7 @@ func TestValidator_ValidateMATLABScript_MPathIsAFolder(t *testing.T) {
 		Once()
 
 	// Act
-	_, err := validator.ValidateMATLABScript(testPath)
+	_, err := validator.ValidateMATLABScript(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -171,6 +196,14 @@ func TestValidator_ValidateMATLABScript_StatFails(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
 
@@ -178,10 +211,10 @@ func TestValidator_ValidateMATLABScript_StatFails(t *testing.T) {
 		Stat(testPath).
 		Return(nil, os.ErrNotExist)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// Act
-	_, err := validator.ValidateMATLABScript(testPath)
+	_, err := validator.ValidateMATLABScript(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -219,10 +252,18 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 			mockOsLayer := &mocks.MockOSLayer{}
 			defer mockOsLayer.AssertExpectations(t)
 
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				AllowedFolders().
+				Return(nil).
+				Once()
+
 			mockFileInfo := &osfacademocks.MockFileInfo{}
 			defer mockFileInfo.AssertExpectations(t)
 
-			validator := pathvalidator.New(mockOsLayer)
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 			mockOsLayer.EXPECT().
 				Stat(tt.expected).
@@ -235,7 +276,7 @@ func TestValidator_ValidateMATLABScript_PathCleaning(t *testing.T) {
 				Once()
 
 			// Act
-			result, err := validator.ValidateMATLABScript(tt.filePath)
+			result, err := validator.ValidateMATLABScript(t.Context(), tt.filePath)
 
 			// Assert
 			require.NoError(t, err)
@@ -249,10 +290,18 @@ func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath, absErr := filepath.Abs("./")
 	require.NoError(t, absErr)
@@ -268,7 +317,7 @@ func TestValidator_ValidateFolderPath_HappyPath(t *testing.T) {
 		Once()
 
 	// Act
-	result, err := validator.ValidateFolderPath(testPath)
+	result, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.NoError(t, err)
@@ -280,12 +329,15 @@ func TestValidator_ValidateFolderPath_FailsForRelativePath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath := filepath.Join(".", "relative", "folder")
 
 	// Act
-	_, err := validator.ValidateFolderPath(testPath)
+	_, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -296,10 +348,18 @@ func TestValidator_ValidateFolderPath_FailsForFilePath(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
 	mockFileInfo := &osfacademocks.MockFileInfo{}
 	defer mockFileInfo.AssertExpectations(t)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	testPath, absErr := filepath.Abs("test.m")
 	require.NoError(t, absErr)
@@ -315,7 +375,7 @@ func TestValidator_ValidateFolderPath_FailsForFilePath(t *testing.T) {
 		Once()
 
 	// Act
-	_, err := validator.ValidateFolderPath(testPath)
+	_, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
@@ -326,6 +386,14 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 	mockOsLayer := &mocks.MockOSLayer{}
 	defer mockOsLayer.AssertExpectations(t)
 
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
 	testPath, absErr := filepath.Abs("./")
 	require.NoError(t, absErr)
 
@@ -333,11 +401,218 @@ func TestValidator_ValidateFolderPath_StatFails(t *testing.T) {
 		Stat(testPath).
 		Return(nil, os.ErrNotExist)
 
-	validator := pathvalidator.New(mockOsLayer)
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
 
 	// Act
-	_, err := validator.ValidateFolderPath(testPath)
+	_, err := validator.ValidateFolderPath(t.Context(), testPath)
 
 	// Assert
 	require.Error(t, err)
 }
+
+func TestValidator_ValidateFolderPath_AllowedFolders(t *testing.T) {
+	workspace, absErr := filepath.Abs("workspace")
+	require.NoError(t, absErr)
+
+	project := filepath.Join(workspace, "project")
+	outside, absErr := filepath.Abs("outside")
+	require.NoError(t, absErr)
+	missing, absErr := filepath.Abs("missing")
+	require.NoError(t, absErr)
+
+	tests := []struct {
+		name           string
+		configFolders  []string
+		clientRoots    []string
+		hasClientRoots bool
+		path           string
+		resolvedPath   string
+		expectAllowed  bool
+	}{
+		{
+			name:          "inside a configured folder",
+			configFolders: []string{workspace},
+			path:          project,
+			resolvedPath:  project,
+			expectAllowed: true,
+		},
+		{
+			name:           "inside a client root",
+			clientRoots:    []string{workspace},
+			hasClientRoots: true,
+			path:           project,
+			resolvedPath:   project,
+			expectAllowed:  true,
+		},
+		{
+			name:           "the allowed folder itself",
+			clientRoots:    []string{workspace},
+			hasClientRoots: true,
+			path:           workspace,
+			resolvedPath:   workspace,
+			expectAllowed:  true,
+		},
+		{
+			name:          "outside of every allowed folder",
+			configFolders: []string{workspace},
+			path:          outside,
+			resolvedPath:  outside,
+			expectAllowed: false,
+		},
+		{
+			name:          "sibling folder sharing a name prefix",
+			configFolders: []string{project},
+			path:          project + "-other",
+			resolvedPath:  project + "-other",
+			expectAllowed: false,
+		},
+		{
+			name:           "symbolic link pointing outside of a client root",
+			clientRoots:    []string{workspace},
+			hasClientRoots: true,
+			path:           project,
+			resolvedPath:   outside,
+			expectAllowed:  false,
+		},
+		{
+			name:          "missing configured folder is ignored",
+			configFolders: []string{missing, workspace},
+			path:          project,
+			resolvedPath:  project,
+			expectAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockOsLayer := &mocks.MockOSLayer{}
+			defer mockOsLayer.AssertExpectations(t)
+
+			mockConfig := &mocks.MockConfig{}
+			defer mockConfig.AssertExpectations(t)
+
+			mockFileInfo := &osfacademocks.MockFileInfo{}
+			defer mockFileInfo.AssertExpectations(t)
+
+			mockConfig.EXPECT().
+				AllowedFolders().
+				Return(tt.configFolders).
+				Once()
+
+			mockOsLayer.EXPECT().
+				EvalSymlinks(tt.path).
+				Return(tt.resolvedPath, nil).
+				Once()
+
+			for _, folder := range append(slices.Clone(tt.configFolders), tt.clientRoots...) {
+				if folder == missing {
+					mockOsLayer.EXPECT().
+						EvalSymlinks(folder).
+						Return("", os.ErrNotExist).
+						Maybe()
+					continue
+				}
+				mockOsLayer.EXPECT().
+					EvalSymlinks(folder).
+					Return(folder, nil).
+					Maybe()
+			}
+
+			if tt.expectAllowed {
+				mockOsLayer.EXPECT().
+					Stat(tt.path).
+					Return(mockFileInfo, nil).
+					Once()
+
+				mockFileInfo.EXPECT().
+					IsDir().
+					Return(true).
+					Once()
+			}
+
+			ctx := t.Context()
+			if tt.hasClientRoots {
+				ctx = entities.WithClientRoots(ctx, tt.clientRoots)
+			}
+
+			validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+			// Act
+			result, err := validator.ValidateFolderPath(ctx, tt.path)
+
+			// Assert
+			if tt.expectAllowed {
+				require.NoError(t, err)
+				assert.Equal(t, tt.path, result)
+			} else {
+				require.ErrorContains(t, err, "outside of the allowed folders")
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateMATLABScript_OutsideClientRoots(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	workspace, absErr := filepath.Abs("workspace")
+	require.NoError(t, absErr)
+	scriptPath, absErr := filepath.Abs(filepath.Join("elsewhere", "script.m"))
+	require.NoError(t, absErr)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		EvalSymlinks(scriptPath).
+		Return(scriptPath, nil).
+		Once()
+
+	mockOsLayer.EXPECT().
+		EvalSymlinks(workspace).
+		Return(workspace, nil).
+		Once()
+
+	ctx := entities.WithClientRoots(t.Context(), []string{workspace})
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	// Act
+	_, err := validator.ValidateMATLABScript(ctx, scriptPath)
+
+	// Assert
+	require.ErrorContains(t, err, "outside of the allowed folders")
+}
+
+func TestValidator_ValidateFolderPath_EmptyClientRootsAllowNothing(t *testing.T) {
+	// Arrange
+	mockOsLayer := &mocks.MockOSLayer{}
+	defer mockOsLayer.AssertExpectations(t)
+
+	mockConfig := &mocks.MockConfig{}
+	defer mockConfig.AssertExpectations(t)
+
+	testPath, absErr := filepath.Abs("./")
+	require.NoError(t, absErr)
+
+	mockConfig.EXPECT().
+		AllowedFolders().
+		Return(nil).
+		Once()
+
+	ctx := entities.WithClientRoots(t.Context(), []string{})
+	validator := pathvalidator.New(mockOsLayer, mockConfig)
+
+	// Act
+	result, err := validator.ValidateFolderPath(ctx, testPath)
+
+	// Assert
+	require.ErrorContains(t, err, "the MCP client lists no roots")
+	assert.Empty(t, result)
+}
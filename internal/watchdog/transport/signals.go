@@ -5,4 +5,7 @@ package transport
 const (
 	gracefulShutdownSignal          = "KILL"
 	gracefulShutdownCompletedSignal = "KILL_COMPLETED"
+
+	// persistentProcessPrefix marks a PID that survives a graceful shutdown, such as "PERSIST:12345".
+	persistentProcessPrefix = "PERSIST:"
 )
@@ -57,6 +57,11 @@ func (c *stdioClient) SendProcessPID(processPID int) error {
 	return err
 }
 
+func (c *stdioClient) SendPersistentProcessPID(processPID int) error {
+	_, err := fmt.Fprintf(c.stdin, "%s%d\n", persistentProcessPrefix, processPID)
+	return err
+}
+
 func (c *stdioClient) SendStop() error {
 	if _, err := fmt.Fprintf(c.stdin, "%s\n", gracefulShutdownSignal); err != nil {
 		return err
@@ -90,6 +90,81 @@ func TestClient_SendProcessPID_HappyPath(t *testing.T) {
 	require.NoError(t, err, "SendProcessPID should not return an error")
 }
 
+func TestClient_SendPersistentProcessPID_HappyPath(t *testing.T) {
+	// Arrange
+	mockSubProcessStdio := &entitiesmocks.MockSubProcessStdio{}
+	defer mockSubProcessStdio.AssertExpectations(t)
+
+	mockStdin := &entitiesmocks.MockWriter{}
+	defer mockStdin.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockReader{}
+	defer mockStdout.AssertExpectations(t)
+
+	mockStderr := &entitiesmocks.MockReader{}
+	defer mockStderr.AssertExpectations(t)
+
+	mockSubProcessStdio.EXPECT().
+		Stdin().
+		Return(mockStdin).
+		Once()
+
+	mockSubProcessStdio.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockSubProcessStdio.EXPECT().
+		Stderr().
+		Return(mockStderr).
+		Once()
+
+	expectedPID := 12345
+	expectedMessageBytes := []byte(fmt.Sprintf("PERSIST:%d\n", expectedPID))
+
+	mockStdin.EXPECT().
+		Write(expectedMessageBytes).
+		Return(len(expectedMessageBytes), nil).
+		Once()
+
+	blockUntilStdoutIsClosed := make(chan struct{})
+	defer func() {
+		<-blockUntilStdoutIsClosed
+	}()
+
+	blockUntilStderrIsClosed := make(chan struct{})
+	defer func() {
+		<-blockUntilStderrIsClosed
+	}()
+
+	mockStdout.EXPECT().
+		Read(mock.Anything).
+		Return(0, io.EOF).
+		Run(func(p []byte) {
+			close(blockUntilStdoutIsClosed)
+		}).
+		Once()
+
+	mockStderr.EXPECT().
+		Read(mock.Anything).
+		Return(0, io.EOF).
+		Run(func(p []byte) {
+			close(blockUntilStderrIsClosed)
+		}).
+		Once()
+
+	client, err := transport.NewStdioClient(mockSubProcessStdio)
+	require.NoError(t, err)
+
+	client.SetShutdownTimeout(10 * time.Millisecond)
+
+	// Act
+	err = client.SendPersistentProcessPID(expectedPID)
+
+	// Assert
+	require.NoError(t, err, "SendPersistentProcessPID should not return an error")
+}
+
 func TestClient_SendProcessPID_WriteError(t *testing.T) {
 	// Arrange
 	mockSubProcessStdio := &entitiesmocks.MockSubProcessStdio{}
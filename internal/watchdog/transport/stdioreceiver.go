@@ -69,11 +69,19 @@ func (r *stdioReceiver) readMessagesFromStdin() {
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
 
-		switch line {
-		case gracefulShutdownSignal:
+		switch {
+		case line == gracefulShutdownSignal:
 			// A shutdown was requested, signal and exit
 			r.messagesC <- Shutdown{}
 			return
+		case strings.HasPrefix(line, persistentProcessPrefix):
+			processPid, err := strconv.Atoi(strings.TrimPrefix(line, persistentProcessPrefix))
+			if err != nil {
+				r.SendErrorMessage(fmt.Errorf("failed to cast message \"%s\" to int. %w", line, err).Error())
+				continue
+			}
+
+			r.messagesC <- PersistentProcessToKill{PID: processPid}
 		default:
 			// Expect process PIDs
 			processPid, err := strconv.Atoi(line)
@@ -331,6 +331,78 @@ func TestReceiver_C_ProcessToKill_HappyPath(t *testing.T) {
 	}
 }
 
+func TestReceiver_C_PersistentProcessToKill_HappyPath(t *testing.T) {
+	// Arrange
+	mockOSStdio := &entitiesmocks.MockOSStdio{}
+	defer mockOSStdio.AssertExpectations(t)
+
+	expectedPIDs := []int{12345, 67890}
+
+	mockStdin := &entitiesmocks.MockReader{}
+	defer mockStdin.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdout.AssertExpectations(t)
+
+	mockStderr := &entitiesmocks.MockWriter{}
+	defer mockStderr.AssertExpectations(t)
+
+	mockOSStdio.EXPECT().
+		Stdin().
+		Return(mockStdin).
+		Once()
+
+	mockOSStdio.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockOSStdio.EXPECT().
+		Stderr().
+		Return(mockStderr).
+		Once()
+
+	for _, expectedPID := range expectedPIDs {
+		expectedMessageBytes := []byte(fmt.Sprintf("PERSIST:%d\n", expectedPID))
+
+		mockStdin.EXPECT().
+			Read(mock.Anything).
+			RunAndReturn(func(p []byte) (int, error) {
+				copy(p, expectedMessageBytes)
+				return len(expectedMessageBytes), nil
+			}).
+			Once()
+	}
+
+	blockUntilStdinIsClosed := make(chan struct{})
+	defer func() {
+		<-blockUntilStdinIsClosed
+	}()
+
+	mockStdin.EXPECT().
+		Read(mock.Anything).
+		Return(0, io.EOF).
+		Run(func(p []byte) {
+			close(blockUntilStdinIsClosed)
+		}).
+		Once()
+
+	receiver, err := transport.NewStdioReceiver(mockOSStdio)
+	require.NoError(t, err)
+
+	// Act & Assert
+	for _, expectedPID := range expectedPIDs {
+		select {
+		case message := <-receiver.C():
+			processToKill, ok := message.(transport.PersistentProcessToKill)
+			require.True(t, ok)
+			assert.Equal(t, expectedPID, processToKill.PID, "Should receive expected PID")
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("Should have received PID within timeout")
+		}
+	}
+}
+
 func TestReceiver_C_ProcessToKill_InvalidPID(t *testing.T) {
 	// Arrange
 	mockOSStdio := &entitiesmocks.MockOSStdio{}
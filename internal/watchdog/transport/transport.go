@@ -12,12 +12,20 @@ type ProcessToKill struct {
 
 func (p ProcessToKill) seal() {}
 
+// PersistentProcessToKill is a process to kill only if the parent process is lost, but not on a graceful shutdown.
+type PersistentProcessToKill struct {
+	PID int
+}
+
+func (p PersistentProcessToKill) seal() {}
+
 type Shutdown struct{}
 
 func (p Shutdown) seal() {}
 
 type Client interface {
 	SendProcessPID(processPID int) error
+	SendPersistentProcessPID(processPID int) error
 	SendStop() error
 }
 
@@ -49,6 +49,7 @@ type Watchdog struct {
 
 	parentPID         int
 	processPIDsToKill map[int]struct{}
+	persistentPIDs    map[int]struct{}
 	lock              *sync.Mutex
 }
 
@@ -67,6 +68,7 @@ func New(
 		transportFactory: transportFactory,
 
 		processPIDsToKill: make(map[int]struct{}),
+		persistentPIDs:    make(map[int]struct{}),
 		lock:              new(sync.Mutex),
 	}
 }
@@ -109,6 +111,8 @@ func (w *Watchdog) StartAndWaitForCompletion(_ context.Context) error {
 		}
 	}()
 
+	gracefulShutdown := false
+
 	select {
 	case <-shutdownC:
 		defer func() {
@@ -119,6 +123,7 @@ func (w *Watchdog) StartAndWaitForCompletion(_ context.Context) error {
 			}
 		}()
 		w.logger.Debug("Graceful shutdown signal received")
+		gracefulShutdown = true
 
 	case <-w.processHandler.WatchProcessAndGetTerminationChan(w.parentPID):
 		w.logger.Debug("Lost connection to parent, shutting down")
@@ -127,7 +132,7 @@ func (w *Watchdog) StartAndWaitForCompletion(_ context.Context) error {
 		w.logger.Debug("Received unexpected graceful shutdown OS signal")
 	}
 
-	w.terminateAllProcesses()
+	w.terminateAllProcesses(gracefulShutdown)
 
 	return nil
 }
@@ -144,6 +149,12 @@ func (w *Watchdog) processIncomingMessage(rawMessage transport.Message) (abort b
 			With("pid", message.PID).
 			Info("Adding process to kill")
 		w.processPIDsToKill[message.PID] = struct{}{}
+	case transport.PersistentProcessToKill:
+		w.logger.
+			With("pid", message.PID).
+			Info("Adding persistent process to kill")
+		w.processPIDsToKill[message.PID] = struct{}{}
+		w.persistentPIDs[message.PID] = struct{}{}
 	case transport.Shutdown:
 		abort = true
 	}
@@ -151,7 +162,9 @@ func (w *Watchdog) processIncomingMessage(rawMessage transport.Message) (abort b
 	return
 }
 
-func (w *Watchdog) terminateAllProcesses() {
+// terminateAllProcesses kills the registered processes.
+// On a graceful shutdown, persistent processes are left running, so that the next server can reattach to them.
+func (w *Watchdog) terminateAllProcesses(gracefulShutdown bool) {
 	w.lock.Lock()
 	defer w.lock.Unlock()
 
@@ -160,6 +173,13 @@ func (w *Watchdog) terminateAllProcesses() {
 		Info("Trying to terminate children")
 
 	for pid := range w.processPIDsToKill {
+		if _, persistent := w.persistentPIDs[pid]; persistent && gracefulShutdown {
+			w.logger.
+				With("pid", pid).
+				Info("Leaving persistent process running")
+			continue
+		}
+
 		w.logger.
 			With("pid", pid).
 			Debug("Killing process")
@@ -404,6 +404,235 @@ func TestWatchdog_StartAndWatch_ParentProcessTermination(t *testing.T) {
 	require.NoError(t, <-errC, "StartAndWatch should not return an error when parent is terminated")
 }
 
+func TestWatchdog_StartAndWatch_PersistentPIDLeftRunningOnGracefulShutdown(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessHandler := &mocks.MockProcessHandler{}
+	defer mockProcessHandler.AssertExpectations(t)
+
+	mockOSSignaler := &mocks.MockOSSignaler{}
+	defer mockOSSignaler.AssertExpectations(t)
+
+	mockTransportFactory := &mocks.MockTransportFactory{}
+	defer mockTransportFactory.AssertExpectations(t)
+
+	mockReceiver := &transportmocks.MockReceiver{}
+	defer mockReceiver.AssertExpectations(t)
+
+	mockStdin := &entitiesmocks.MockReader{}
+	defer mockStdin.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdin.AssertExpectations(t)
+
+	mockStderr := &entitiesmocks.MockWriter{}
+	defer mockStdin.AssertExpectations(t)
+
+	expectedParentPID := 1234
+
+	parentTerminationC := make(chan struct{})
+	interruptSignalC := make(chan os.Signal, 1)
+
+	expectedPIDToKill := 123654
+	persistentPID := 6587987
+	messageC := make(chan transport.Message)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdin().
+		Return(mockStdin).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(mockStderr).
+		Once()
+
+	mockTransportFactory.EXPECT().
+		NewReceiver(stdio.NewOSStdio(mockStdin, mockStdout, mockStderr)).
+		Return(mockReceiver, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getppid().
+		Return(expectedParentPID).
+		Once()
+
+	mockReceiver.EXPECT().
+		C().
+		Return(messageC).
+		Once()
+
+	mockReceiver.EXPECT().
+		SendGracefulShutdownCompleted().
+		Return(nil).
+		Once()
+
+	mockProcessHandler.EXPECT().
+		WatchProcessAndGetTerminationChan(expectedParentPID).
+		Return(parentTerminationC).
+		Once()
+
+	mockOSSignaler.EXPECT().
+		InterruptSignalChan().
+		Return(interruptSignalC).
+		Once()
+
+	mockProcessHandler.EXPECT().
+		KillProcess(expectedPIDToKill).
+		Return(nil).
+		Once()
+
+	watchdogInstance := watchdog.New(
+		mockLoggerFactory,
+		mockOSLayer,
+		mockProcessHandler,
+		mockOSSignaler,
+		mockTransportFactory,
+	)
+
+	// Act
+	errC := make(chan error)
+	go func() {
+		errC <- watchdogInstance.StartAndWaitForCompletion(t.Context())
+	}()
+
+	messageC <- transport.ProcessToKill{PID: expectedPIDToKill}
+	messageC <- transport.PersistentProcessToKill{PID: persistentPID}
+
+	messageC <- transport.Shutdown{}
+
+	// Assert
+	require.NoError(t, <-errC, "StartAndWatch should not return an error on graceful shutdown")
+}
+
+func TestWatchdog_StartAndWatch_PersistentPIDKilledOnParentProcessTermination(t *testing.T) {
+	// Arrange
+	mockLogger := testutils.NewInspectableLogger()
+
+	mockLoggerFactory := &mocks.MockLoggerFactory{}
+	defer mockLoggerFactory.AssertExpectations(t)
+
+	mockOSLayer := &mocks.MockOSLayer{}
+	defer mockOSLayer.AssertExpectations(t)
+
+	mockProcessHandler := &mocks.MockProcessHandler{}
+	defer mockProcessHandler.AssertExpectations(t)
+
+	mockOSSignaler := &mocks.MockOSSignaler{}
+	defer mockOSSignaler.AssertExpectations(t)
+
+	mockTransportFactory := &mocks.MockTransportFactory{}
+	defer mockTransportFactory.AssertExpectations(t)
+
+	mockReceiver := &transportmocks.MockReceiver{}
+	defer mockReceiver.AssertExpectations(t)
+
+	mockStdin := &entitiesmocks.MockReader{}
+	defer mockStdin.AssertExpectations(t)
+
+	mockStdout := &entitiesmocks.MockWriter{}
+	defer mockStdin.AssertExpectations(t)
+
+	mockStderr := &entitiesmocks.MockWriter{}
+	defer mockStdin.AssertExpectations(t)
+
+	expectedParentPID := 1234
+
+	parentTerminationC := make(chan struct{})
+	interruptSignalC := make(chan os.Signal, 1)
+
+	expectedPersistentPIDToKill := 123654
+	messageC := make(chan transport.Message)
+
+	mockLoggerFactory.EXPECT().
+		GetGlobalLogger().
+		Return(mockLogger).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdin().
+		Return(mockStdin).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stdout().
+		Return(mockStdout).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Stderr().
+		Return(mockStderr).
+		Once()
+
+	mockTransportFactory.EXPECT().
+		NewReceiver(stdio.NewOSStdio(mockStdin, mockStdout, mockStderr)).
+		Return(mockReceiver, nil).
+		Once()
+
+	mockOSLayer.EXPECT().
+		Getppid().
+		Return(expectedParentPID).
+		Once()
+
+	mockReceiver.EXPECT().
+		C().
+		Return(messageC).
+		Once()
+
+	mockProcessHandler.EXPECT().
+		WatchProcessAndGetTerminationChan(expectedParentPID).
+		Return(parentTerminationC).
+		Once()
+
+	mockOSSignaler.EXPECT().
+		InterruptSignalChan().
+		Return(interruptSignalC).
+		Once()
+
+	mockProcessHandler.EXPECT().
+		KillProcess(expectedPersistentPIDToKill).
+		Return(nil).
+		Once()
+
+	watchdogInstance := watchdog.New(
+		mockLoggerFactory,
+		mockOSLayer,
+		mockProcessHandler,
+		mockOSSignaler,
+		mockTransportFactory,
+	)
+
+	// Act
+	errC := make(chan error)
+	go func() {
+		errC <- watchdogInstance.StartAndWaitForCompletion(t.Context())
+	}()
+
+	messageC <- transport.PersistentProcessToKill{PID: expectedPersistentPIDToKill}
+
+	close(parentTerminationC)
+
+	// Assert
+	require.NoError(t, <-errC, "StartAndWatch should not return an error when parent is terminated")
+}
+
 func TestWatchdog_StartAndWatch_OSSignalInterrupt(t *testing.T) {
 	// Arrange
 	mockLogger := testutils.NewInspectableLogger()
@@ -19,21 +19,29 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/attachedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager/matlabfiles"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processdetails"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher/utils/vmcsettings"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/virtualdisplay"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/roots"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/httpauth"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/basetool"
+	attachmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/attachmatlabsession"
 	evalmatlabcodemultisessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	listmatlabsessionstool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	startmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
 	checkmatlabcodesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
@@ -42,9 +50,15 @@ import (
 	queryvmcblockhelpsinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/queryvmcblockhelp"
 	runmatlabfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabfile"
 	runmatlabtestfilesinglesessiontool "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/runmatlabtestfile"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/baseprompt"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/configurehubblock"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/createhlsmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugvmcsimulation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/baseresource"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockindex"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockpage"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmchubapi"
 	watchdogclient "github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/watchdog/process"
@@ -52,10 +66,12 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/iofacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
@@ -124,6 +140,8 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(orchestrator.OSSignaler), new(*ossignaler.OSSignaler)),
 		wire.Bind(new(orchestrator.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
 		wire.Bind(new(orchestrator.Directory), new(*directory.Directory)),
+		wire.Bind(new(orchestrator.WarmPool), new(*matlabmanager.WarmPool)),
+		wire.Bind(new(orchestrator.SessionRestorer), new(*matlabmanager.MATLABManager)),
 
 		// Watchdog Client
 		watchdogclient.New,
@@ -143,10 +161,32 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		// MCP Server
 		server.NewMCPSDKServer,
 		wire.Bind(new(server.ServerConfig), new(*config.Config)),
+		wire.Bind(new(server.Completer), new(*completion.Completer)),
 		server.New,
 		wire.Bind(new(server.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(server.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 		wire.Bind(new(server.MCPServerConfigurator), new(*configurator.Configurator)),
+		wire.Bind(new(server.Config), new(*config.Config)),
+		wire.Bind(new(server.HTTPAuthenticator), new(*httpauth.Authenticator)),
+
+		// MCP Roots
+		roots.New,
+		wire.Bind(new(roots.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(server.RootsTracker), new(*roots.Tracker)),
+
+		// MCP Completion
+		completion.New,
+		wire.Bind(new(completion.LoggerFactory), new(*logger.Factory)),
+		wire.Bind(new(completion.SessionStore), new(*matlabsessionstore.Store)),
+		wire.Bind(new(completion.MATLABLocator), new(*matlablocator.MATLABLocator)),
+
+		// MCP Server HTTP Authentication
+		httpauth.New,
+		wire.Bind(new(httpauth.Config), new(*config.Config)),
+		wire.Bind(new(httpauth.Directory), new(*directory.Directory)),
+		wire.Bind(new(httpauth.FilenameFactory), new(*files.Factory)),
+		wire.Bind(new(httpauth.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(httpauth.LoggerFactory), new(*logger.Factory)),
 
 		// MCP Server Configurator
 		configurator.New,
@@ -167,6 +207,12 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		evalmatlabcodemultisessiontool.New,
 		wire.Bind(new(evalmatlabcodemultisessiontool.Usecase), new(*evalmatlabcode.Usecase)),
 
+		listmatlabsessionstool.New,
+		wire.Bind(new(listmatlabsessionstool.Usecase), new(*listmatlabsessions.Usecase)),
+
+		attachmatlabsessiontool.New,
+		wire.Bind(new(attachmatlabsessiontool.Usecase), new(*attachmatlabsession.Usecase)),
+
 		evalmatlabcodesinglesessiontool.New,
 		wire.Bind(new(evalmatlabcodesinglesessiontool.Usecase), new(*evalmatlabcode.Usecase)),
 
@@ -189,13 +235,30 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(baseresource.LoggerFactory), new(*logger.Factory)),
 		codingguidelines.New,
 		vmcblockhelp.New,
+		vmcblockindex.New,
+		vmcblockpage.New,
 		vmchubapi.New,
 
+		// Prompts
+		wire.Bind(new(baseprompt.LoggerFactory), new(*logger.Factory)),
+
+		createhlsmodel.New,
+		wire.Bind(new(createhlsmodel.BlockHelpUsecase), new(*queryvmcblockhelp.Usecase)),
+
+		debugvmcsimulation.New,
+		wire.Bind(new(debugvmcsimulation.BlockHelpUsecase), new(*queryvmcblockhelp.Usecase)),
+
+		configurehubblock.New,
+
 		// Use Cases
 		listavailablematlabs.New,
 		startmatlabsession.New,
+		wire.Bind(new(startmatlabsession.PathValidator), new(*pathvalidator.PathValidator)),
+		wire.Bind(new(startmatlabsession.Config), new(*config.Config)),
 		stopmatlabsession.New,
 		evalmatlabcode.New,
+		listmatlabsessions.New,
+		attachmatlabsession.New,
 		wire.Bind(new(evalmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
 		checkmatlabcode.New,
 		wire.Bind(new(checkmatlabcode.PathValidator), new(*pathvalidator.PathValidator)),
@@ -209,6 +272,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		// Use Cases Utilities
 		pathvalidator.New,
 		wire.Bind(new(pathvalidator.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(pathvalidator.Config), new(*config.Config)),
 
 		// Entities
 		wire.Bind(new(entities.GlobalMATLAB), new(*globalmatlab.GlobalMATLAB)),
@@ -219,9 +283,16 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		wire.Bind(new(matlabmanager.MATLABServices), new(*matlabservices.MATLABServices)),
 		wire.Bind(new(matlabmanager.MATLABSessionStore), new(*matlabsessionstore.Store)),
 		wire.Bind(new(matlabmanager.MATLABSessionClientFactory), new(*matlabsessionclient.Factory)),
+		wire.Bind(new(matlabmanager.WarmSessionPool), new(*matlabmanager.WarmPool)),
+
+		// MATLAB Warm Pool
+		matlabmanager.NewWarmPool,
+		wire.Bind(new(matlabmanager.WarmPoolConfig), new(*config.Config)),
+		wire.Bind(new(matlabmanager.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
 		// MATLAB Session Store
 		matlabsessionstore.New,
+		wire.Bind(new(matlabsessionstore.Config), new(*config.Config)),
 		wire.Bind(new(matlabsessionstore.LoggerFactory), new(*logger.Factory)),
 		wire.Bind(new(matlabsessionstore.LifecycleSignaler), new(*lifecyclesignaler.LifecycleSignaler)),
 
@@ -231,6 +302,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 
 		// Global MATLAB Session
 		globalmatlab.New,
+		wire.Bind(new(globalmatlab.Config), new(*config.Config)),
 		wire.Bind(new(globalmatlab.MATLABManager), new(*matlabmanager.MATLABManager)),
 		wire.Bind(new(globalmatlab.MATLABRootSelector), new(*matlabrootselector.MATLABRootSelector)),
 		wire.Bind(new(globalmatlab.VMCRootSelector), new(*vmcrootselector.VMCRootSelector)),
@@ -254,6 +326,7 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 		matlabservices.New,
 		wire.Bind(new(matlabservices.MATLABLocator), new(*matlablocator.MATLABLocator)),
 		wire.Bind(new(matlabservices.LocalMATLABSessionLauncher), new(*localmatlabsession.Starter)),
+		wire.Bind(new(matlabservices.MATLABSessionAttacher), new(*attachedmatlabsession.Attacher)),
 
 		// MATLAB Locator
 		matlablocator.New,
@@ -262,10 +335,20 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 
 		// Local MATLAB Session
 		localmatlabsession.NewStarter,
+		wire.Bind(new(localmatlabsession.Config), new(*config.Config)),
 		wire.Bind(new(localmatlabsession.SessionDirectoryFactory), new(*directorymanager.DirectoryFactory)),
 		wire.Bind(new(localmatlabsession.ProcessDetails), new(*processdetails.ProcessDetails)),
 		wire.Bind(new(localmatlabsession.MATLABProcessLauncher), new(*processlauncher.MATLABProcessLauncher)),
 		wire.Bind(new(localmatlabsession.Watchdog), new(*watchdogclient.Watchdog)),
+		wire.Bind(new(localmatlabsession.MATLABVersionGetter), new(*matlabversion.Getter)),
+		wire.Bind(new(localmatlabsession.VirtualDisplay), new(*virtualdisplay.VirtualDisplay)),
+
+		// Attached MATLAB Session
+		attachedmatlabsession.NewAttacher,
+		wire.Bind(new(attachedmatlabsession.ApplicationDirectory), new(*directory.Directory)),
+		wire.Bind(new(attachedmatlabsession.OSLayer), new(*osfacade.OsFacade)),
+		wire.Bind(new(attachedmatlabsession.MATLABFiles), new(matlabfiles.MATLABFiles)),
+		wire.Bind(new(attachedmatlabsession.MATLABVersionGetter), new(*matlabversion.Getter)),
 
 		// Local MATLAB Session Directory Manager
 		directorymanager.NewFactory,
@@ -275,10 +358,21 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 
 		// Local MATLAB Session Process Details
 		processdetails.New,
+		wire.Bind(new(processdetails.Config), new(*config.Config)),
 		wire.Bind(new(processdetails.OSLayer), new(*osfacade.OsFacade)),
 
 		// Local MATLAB Process Launcher
 		processlauncher.New,
+		wire.Bind(new(processlauncher.Config), new(*config.Config)),
+		wire.Bind(new(processlauncher.VMCSettings), new(*vmcsettings.Sourcer)),
+
+		// Vitis settings of Model Composer sessions
+		vmcsettings.New,
+		wire.Bind(new(vmcsettings.OSLayer), new(*osfacade.OsFacade)),
+
+		// Virtual Display for MATLAB sessions without a desktop
+		virtualdisplay.New,
+		wire.Bind(new(virtualdisplay.OSLayer), new(*osfacade.OsFacade)),
 
 		wire.NewSet(
 			// MATLAB Root Getter
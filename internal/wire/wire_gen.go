@@ -20,23 +20,36 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/logger"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/attachedmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager/matlabfiles"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processdetails"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/processlauncher/utils/vmcsettings"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/virtualdisplay"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabroot"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/matlablocator/matlabversion"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionstore"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/completion"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/configurehubblock"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/createhlsmodel"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts/debugvmcsimulation"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/codingguidelines"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockhelp"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockindex"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmcblockpage"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources/vmchubapi"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/roots"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/configurator"
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/server/httpauth"
+	attachmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/attachmatlabsession"
 	evalmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/evalmatlabcode"
 	listavailablematlabs2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listavailablematlabs"
+	listmatlabsessions2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/listmatlabsessions"
 	startmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/startmatlabsession"
 	stopmatlabsession2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/multisession/stopmatlabsession"
 	checkmatlabcode2 "github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools/singlesession/checkmatlabcode"
@@ -51,10 +64,12 @@ import (
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/filefacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/iofacade"
 	"github.com/matlab/matlab-mcp-core-server/internal/facades/osfacade"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/attachmatlabsession"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/checkmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/detectmatlabtoolboxes"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/evalmatlabcode"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listavailablematlabs"
+	"github.com/matlab/matlab-mcp-core-server/internal/usecases/listmatlabsessions"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/queryvmcblockhelp"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabfile"
 	"github.com/matlab/matlab-mcp-core-server/internal/usecases/runmatlabtestfile"
@@ -90,7 +105,6 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	if err != nil {
 		return nil, err
 	}
-	mcpServer := server.NewMCPSDKServer(configConfig)
 	factory := files.NewFactory(osFacade)
 	directoryDirectory, err := directory.New(configConfig, factory, osFacade)
 	if err != nil {
@@ -107,33 +121,44 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	matlabLocator := matlablocator.New(getter, matlabversionGetter)
 	matlabFiles := matlabfiles.New()
 	directoryFactory := directorymanager.NewFactory(osFacade, directoryDirectory, matlabFiles)
-	processDetails := processdetails.New(osFacade)
-	matlabProcessLauncher := processlauncher.New()
+	processDetails := processdetails.New(configConfig, osFacade)
+	sourcer := vmcsettings.New(osFacade)
+	matlabProcessLauncher := processlauncher.New(configConfig, sourcer)
+	virtualDisplay := virtualdisplay.New(osFacade)
 	processProcess, err := process.New(osFacade, loggerFactory, directoryDirectory)
 	if err != nil {
 		return nil, err
 	}
 	transportFactory := transport.NewFactory()
 	watchdogWatchdog := watchdog.New(processProcess, transportFactory, loggerFactory)
-	starter := localmatlabsession.NewStarter(directoryFactory, processDetails, matlabProcessLauncher, watchdogWatchdog)
-	matlabServices := matlabservices.New(matlabLocator, starter)
-	store := matlabsessionstore.New(loggerFactory, lifecycleSignaler)
+	starter := localmatlabsession.NewStarter(configConfig, directoryFactory, processDetails, matlabProcessLauncher, watchdogWatchdog, matlabversionGetter, virtualDisplay)
+	attacher := attachedmatlabsession.NewAttacher(directoryDirectory, osFacade, matlabFiles, matlabversionGetter)
+	matlabServices := matlabservices.New(matlabLocator, starter, attacher)
+	store := matlabsessionstore.New(configConfig, loggerFactory, lifecycleSignaler)
+	completer := completion.New(loggerFactory, store, matlabLocator)
+	tracker := roots.New(loggerFactory)
+	mcpServer := server.NewMCPSDKServer(configConfig, completer, tracker)
 	httpClientFactory := httpclientfactory.New()
 	matlabsessionclientFactory := matlabsessionclient.NewFactory(httpClientFactory)
-	matlabManager := matlabmanager.New(matlabServices, store, matlabsessionclientFactory)
+	warmPool := matlabmanager.NewWarmPool(configConfig, lifecycleSignaler, matlabServices, matlabsessionclientFactory)
+	matlabManager := matlabmanager.New(matlabServices, store, matlabsessionclientFactory, warmPool, lifecycleSignaler)
 	usecase := listavailablematlabs.New(matlabManager)
 	tool := listavailablematlabs2.New(loggerFactory, usecase)
-	startmatlabsessionUsecase := startmatlabsession.New(matlabManager)
+	pathValidator := pathvalidator.New(osFacade, configConfig)
+	startmatlabsessionUsecase := startmatlabsession.New(matlabManager, pathValidator, configConfig)
 	startmatlabsessionTool := startmatlabsession2.New(loggerFactory, startmatlabsessionUsecase)
 	stopmatlabsessionUsecase := stopmatlabsession.New(matlabManager)
 	stopmatlabsessionTool := stopmatlabsession2.New(loggerFactory, stopmatlabsessionUsecase)
-	pathValidator := pathvalidator.New(osFacade)
 	evalmatlabcodeUsecase := evalmatlabcode.New(pathValidator)
 	evalmatlabcodeTool := evalmatlabcode2.New(loggerFactory, evalmatlabcodeUsecase, matlabManager)
+	listmatlabsessionsUsecase := listmatlabsessions.New(matlabManager)
+	listmatlabsessionsTool := listmatlabsessions2.New(loggerFactory, listmatlabsessionsUsecase)
+	attachmatlabsessionUsecase := attachmatlabsession.New(matlabManager)
+	attachmatlabsessionTool := attachmatlabsession2.New(loggerFactory, attachmatlabsessionUsecase)
 	matlabRootSelector := matlabrootselector.New(configConfig, matlabManager)
 	vmcRootSelector := vmcrootselector.New(configConfig)
 	matlabStartingDirSelector := matlabstartingdirselector.New(configConfig, osFacade)
-	globalMATLAB := globalmatlab.New(matlabManager, matlabRootSelector, vmcRootSelector, matlabStartingDirSelector)
+	globalMATLAB := globalmatlab.New(configConfig, matlabManager, matlabRootSelector, vmcRootSelector, matlabStartingDirSelector)
 	tool2 := evalmatlabcode3.New(loggerFactory, evalmatlabcodeUsecase, globalMATLAB)
 	checkmatlabcodeUsecase := checkmatlabcode.New(pathValidator)
 	checkmatlabcodeTool := checkmatlabcode2.New(loggerFactory, checkmatlabcodeUsecase, globalMATLAB)
@@ -153,17 +178,38 @@ func initializeOrchestrator() (*orchestrator.Orchestrator, error) {
 	if err != nil {
 		return nil, err
 	}
+	vmcblockindexResource, err := vmcblockindex.New(loggerFactory)
+	if err != nil {
+		return nil, err
+	}
+	resourceTemplate, err := vmcblockpage.New(loggerFactory)
+	if err != nil {
+		return nil, err
+	}
 	vmchubapiResource, err := vmchubapi.New(loggerFactory)
 	if err != nil {
 		return nil, err
 	}
-	configuratorConfigurator := configurator.New(configConfig, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, queryvmcblockhelpTool, resource, vmcblockhelpResource, vmchubapiResource)
-	serverServer, err := server.New(mcpServer, loggerFactory, lifecycleSignaler, configuratorConfigurator)
+	prompt, err := createhlsmodel.New(loggerFactory, queryvmcblockhelpUsecase)
+	if err != nil {
+		return nil, err
+	}
+	debugvmcsimulationPrompt, err := debugvmcsimulation.New(loggerFactory, queryvmcblockhelpUsecase)
+	if err != nil {
+		return nil, err
+	}
+	configurehubblockPrompt, err := configurehubblock.New(loggerFactory)
+	if err != nil {
+		return nil, err
+	}
+	configuratorConfigurator := configurator.New(configConfig, tool, startmatlabsessionTool, stopmatlabsessionTool, evalmatlabcodeTool, listmatlabsessionsTool, attachmatlabsessionTool, tool2, checkmatlabcodeTool, detectmatlabtoolboxesTool, runmatlabfileTool, runmatlabtestfileTool, queryvmcblockhelpTool, resource, vmcblockhelpResource, vmcblockindexResource, resourceTemplate, vmchubapiResource, prompt, debugvmcsimulationPrompt, configurehubblockPrompt)
+	authenticator := httpauth.New(configConfig, directoryDirectory, factory, osFacade, loggerFactory)
+	serverServer, err := server.New(mcpServer, loggerFactory, lifecycleSignaler, configuratorConfigurator, configConfig, authenticator)
 	if err != nil {
 		return nil, err
 	}
 	osSignaler := ossignaler.New()
-	orchestratorOrchestrator := orchestrator.New(lifecycleSignaler, configConfig, serverServer, watchdogWatchdog, loggerFactory, osSignaler, globalMATLAB, directoryDirectory)
+	orchestratorOrchestrator := orchestrator.New(lifecycleSignaler, configConfig, serverServer, watchdogWatchdog, loggerFactory, osSignaler, globalMATLAB, directoryDirectory, warmPool, matlabManager)
 	return orchestratorOrchestrator, nil
 }
 
@@ -80,6 +80,50 @@ func (_c *MockConfig_InitializeMATLABOnStartup_Call) RunAndReturn(run func() boo
 	return _c
 }
 
+// PersistentSessions provides a mock function for the type MockConfig
+func (_mock *MockConfig) PersistentSessions() bool {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersistentSessions")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func() bool); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockConfig_PersistentSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersistentSessions'
+type MockConfig_PersistentSessions_Call struct {
+	*mock.Call
+}
+
+// PersistentSessions is a helper method to define mock.On call
+func (_e *MockConfig_Expecter) PersistentSessions() *MockConfig_PersistentSessions_Call {
+	return &MockConfig_PersistentSessions_Call{Call: _e.mock.On("PersistentSessions")}
+}
+
+func (_c *MockConfig_PersistentSessions_Call) Run(run func()) *MockConfig_PersistentSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockConfig_PersistentSessions_Call) Return(b bool) *MockConfig_PersistentSessions_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockConfig_PersistentSessions_Call) RunAndReturn(run func() bool) *MockConfig_PersistentSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordToLogger provides a mock function for the type MockConfig
 func (_mock *MockConfig) RecordToLogger(logger entities.Logger) {
 	_mock.Called(logger)
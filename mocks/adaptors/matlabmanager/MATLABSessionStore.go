@@ -38,16 +38,16 @@ func (_m *MockMATLABSessionStore) EXPECT() *MockMATLABSessionStore_Expecter {
 }
 
 // Add provides a mock function for the type MockMATLABSessionStore
-func (_mock *MockMATLABSessionStore) Add(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID {
-	ret := _mock.Called(client)
+func (_mock *MockMATLABSessionStore) Add(client matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) entities.SessionID {
+	ret := _mock.Called(client, info)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Add")
 	}
 
 	var r0 entities.SessionID
-	if returnFunc, ok := ret.Get(0).(func(matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID); ok {
-		r0 = returnFunc(client)
+	if returnFunc, ok := ret.Get(0).(func(matlabsessionstore.MATLABSessionClientWithCleanup, entities.MATLABSessionInfo) entities.SessionID); ok {
+		r0 = returnFunc(client, info)
 	} else {
 		r0 = ret.Get(0).(entities.SessionID)
 	}
@@ -61,18 +61,24 @@ type MockMATLABSessionStore_Add_Call struct {
 
 // Add is a helper method to define mock.On call
 //   - client matlabsessionstore.MATLABSessionClientWithCleanup
-func (_e *MockMATLABSessionStore_Expecter) Add(client interface{}) *MockMATLABSessionStore_Add_Call {
-	return &MockMATLABSessionStore_Add_Call{Call: _e.mock.On("Add", client)}
+//   - info entities.MATLABSessionInfo
+func (_e *MockMATLABSessionStore_Expecter) Add(client interface{}, info interface{}) *MockMATLABSessionStore_Add_Call {
+	return &MockMATLABSessionStore_Add_Call{Call: _e.mock.On("Add", client, info)}
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) Run(run func(client matlabsessionstore.MATLABSessionClientWithCleanup)) *MockMATLABSessionStore_Add_Call {
+func (_c *MockMATLABSessionStore_Add_Call) Run(run func(client matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo)) *MockMATLABSessionStore_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 matlabsessionstore.MATLABSessionClientWithCleanup
 		if args[0] != nil {
 			arg0 = args[0].(matlabsessionstore.MATLABSessionClientWithCleanup)
 		}
+		var arg1 entities.MATLABSessionInfo
+		if args[1] != nil {
+			arg1 = args[1].(entities.MATLABSessionInfo)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -83,7 +89,7 @@ func (_c *MockMATLABSessionStore_Add_Call) Return(sessionID entities.SessionID)
 	return _c
 }
 
-func (_c *MockMATLABSessionStore_Add_Call) RunAndReturn(run func(client matlabsessionstore.MATLABSessionClientWithCleanup) entities.SessionID) *MockMATLABSessionStore_Add_Call {
+func (_c *MockMATLABSessionStore_Add_Call) RunAndReturn(run func(client matlabsessionstore.MATLABSessionClientWithCleanup, info entities.MATLABSessionInfo) entities.SessionID) *MockMATLABSessionStore_Add_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -189,3 +195,49 @@ func (_c *MockMATLABSessionStore_Remove_Call) RunAndReturn(run func(sessionID en
 	_c.Run(run)
 	return _c
 }
+
+// Sessions provides a mock function for the type MockMATLABSessionStore
+func (_mock *MockMATLABSessionStore) Sessions() []entities.MATLABSessionInfo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sessions")
+	}
+
+	var r0 []entities.MATLABSessionInfo
+	if returnFunc, ok := ret.Get(0).(func() []entities.MATLABSessionInfo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.MATLABSessionInfo)
+		}
+	}
+	return r0
+}
+
+// MockMATLABSessionStore_Sessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sessions'
+type MockMATLABSessionStore_Sessions_Call struct {
+	*mock.Call
+}
+
+// Sessions is a helper method to define mock.On call
+func (_e *MockMATLABSessionStore_Expecter) Sessions() *MockMATLABSessionStore_Sessions_Call {
+	return &MockMATLABSessionStore_Sessions_Call{Call: _e.mock.On("Sessions")}
+}
+
+func (_c *MockMATLABSessionStore_Sessions_Call) Run(run func()) *MockMATLABSessionStore_Sessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_Sessions_Call) Return(mATLABSessionInfos []entities.MATLABSessionInfo) *MockMATLABSessionStore_Sessions_Call {
+	_c.Call.Return(mATLABSessionInfos)
+	return _c
+}
+
+func (_c *MockMATLABSessionStore_Sessions_Call) RunAndReturn(run func() []entities.MATLABSessionInfo) *MockMATLABSessionStore_Sessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
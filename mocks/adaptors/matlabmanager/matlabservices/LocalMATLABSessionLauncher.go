@@ -5,8 +5,9 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/datatypes"
-	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabsessionclient/embeddedconnector"
 	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,34 +39,212 @@ func (_m *MockLocalMATLABSessionLauncher) EXPECT() *MockLocalMATLABSessionLaunch
 	return &MockLocalMATLABSessionLauncher_Expecter{mock: &_m.Mock}
 }
 
+// DiscardLocalMATLABSession provides a mock function for the type MockLocalMATLABSessionLauncher
+func (_mock *MockLocalMATLABSessionLauncher) DiscardLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) error {
+	ret := _mock.Called(logger, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscardLocalMATLABSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.PersistedLocalSession) error); ok {
+		r0 = returnFunc(logger, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardLocalMATLABSession'
+type MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call struct {
+	*mock.Call
+}
+
+// DiscardLocalMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - session datatypes.PersistedLocalSession
+func (_e *MockLocalMATLABSessionLauncher_Expecter) DiscardLocalMATLABSession(logger interface{}, session interface{}) *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call {
+	return &MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call{Call: _e.mock.On("DiscardLocalMATLABSession", logger, session)}
+}
+
+func (_c *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call) Run(run func(logger entities.Logger, session datatypes.PersistedLocalSession)) *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.PersistedLocalSession
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.PersistedLocalSession)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call) Return(err error) *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, session datatypes.PersistedLocalSession) error) *MockLocalMATLABSessionLauncher_DiscardLocalMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPersistedLocalMATLABSessions provides a mock function for the type MockLocalMATLABSessionLauncher
+func (_mock *MockLocalMATLABSessionLauncher) FindPersistedLocalMATLABSessions(logger entities.Logger) ([]datatypes.PersistedLocalSession, error) {
+	ret := _mock.Called(logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPersistedLocalMATLABSessions")
+	}
+
+	var r0 []datatypes.PersistedLocalSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) ([]datatypes.PersistedLocalSession, error)); ok {
+		return returnFunc(logger)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) []datatypes.PersistedLocalSession); ok {
+		r0 = returnFunc(logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datatypes.PersistedLocalSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger) error); ok {
+		r1 = returnFunc(logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPersistedLocalMATLABSessions'
+type MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call struct {
+	*mock.Call
+}
+
+// FindPersistedLocalMATLABSessions is a helper method to define mock.On call
+//   - logger entities.Logger
+func (_e *MockLocalMATLABSessionLauncher_Expecter) FindPersistedLocalMATLABSessions(logger interface{}) *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call {
+	return &MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call{Call: _e.mock.On("FindPersistedLocalMATLABSessions", logger)}
+}
+
+func (_c *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call) Run(run func(logger entities.Logger)) *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call) Return(persistedLocalSessions []datatypes.PersistedLocalSession, err error) *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call {
+	_c.Call.Return(persistedLocalSessions, err)
+	return _c
+}
+
+func (_c *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call) RunAndReturn(run func(logger entities.Logger) ([]datatypes.PersistedLocalSession, error)) *MockLocalMATLABSessionLauncher_FindPersistedLocalMATLABSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreLocalMATLABSession provides a mock function for the type MockLocalMATLABSessionLauncher
+func (_mock *MockLocalMATLABSessionLauncher) RestoreLocalMATLABSession(logger entities.Logger, session datatypes.PersistedLocalSession) func() error {
+	ret := _mock.Called(logger, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreLocalMATLABSession")
+	}
+
+	var r0 func() error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.PersistedLocalSession) func() error); ok {
+		r0 = returnFunc(logger, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func() error)
+		}
+	}
+	return r0
+}
+
+// MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreLocalMATLABSession'
+type MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call struct {
+	*mock.Call
+}
+
+// RestoreLocalMATLABSession is a helper method to define mock.On call
+//   - logger entities.Logger
+//   - session datatypes.PersistedLocalSession
+func (_e *MockLocalMATLABSessionLauncher_Expecter) RestoreLocalMATLABSession(logger interface{}, session interface{}) *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call {
+	return &MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call{Call: _e.mock.On("RestoreLocalMATLABSession", logger, session)}
+}
+
+func (_c *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call) Run(run func(logger entities.Logger, session datatypes.PersistedLocalSession)) *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		var arg1 datatypes.PersistedLocalSession
+		if args[1] != nil {
+			arg1 = args[1].(datatypes.PersistedLocalSession)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call) Return(fn func() error) *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call {
+	_c.Call.Return(fn)
+	return _c
+}
+
+func (_c *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, session datatypes.PersistedLocalSession) func() error) *MockLocalMATLABSessionLauncher_RestoreLocalMATLABSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartLocalMATLABSession provides a mock function for the type MockLocalMATLABSessionLauncher
-func (_mock *MockLocalMATLABSessionLauncher) StartLocalMATLABSession(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error) {
-	ret := _mock.Called(logger, request)
+func (_mock *MockLocalMATLABSessionLauncher) StartLocalMATLABSession(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error) {
+	ret := _mock.Called(ctx, logger, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartLocalMATLABSession")
 	}
 
-	var r0 embeddedconnector.ConnectionDetails
+	var r0 datatypes.LocalSession
 	var r1 func() error
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)); ok {
-		return returnFunc(logger, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error)); ok {
+		return returnFunc(ctx, logger, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, datatypes.LocalSessionDetails) embeddedconnector.ConnectionDetails); ok {
-		r0 = returnFunc(logger, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) datatypes.LocalSession); ok {
+		r0 = returnFunc(ctx, logger, request)
 	} else {
-		r0 = ret.Get(0).(embeddedconnector.ConnectionDetails)
+		r0 = ret.Get(0).(datatypes.LocalSession)
 	}
-	if returnFunc, ok := ret.Get(1).(func(entities.Logger, datatypes.LocalSessionDetails) func() error); ok {
-		r1 = returnFunc(logger, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) func() error); ok {
+		r1 = returnFunc(ctx, logger, request)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(func() error)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(entities.Logger, datatypes.LocalSessionDetails) error); ok {
-		r2 = returnFunc(logger, request)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, entities.Logger, datatypes.LocalSessionDetails) error); ok {
+		r2 = returnFunc(ctx, logger, request)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -78,36 +257,42 @@ type MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call struct {
 }
 
 // StartLocalMATLABSession is a helper method to define mock.On call
+//   - ctx context.Context
 //   - logger entities.Logger
 //   - request datatypes.LocalSessionDetails
-func (_e *MockLocalMATLABSessionLauncher_Expecter) StartLocalMATLABSession(logger interface{}, request interface{}) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
-	return &MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call{Call: _e.mock.On("StartLocalMATLABSession", logger, request)}
+func (_e *MockLocalMATLABSessionLauncher_Expecter) StartLocalMATLABSession(ctx interface{}, logger interface{}, request interface{}) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
+	return &MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call{Call: _e.mock.On("StartLocalMATLABSession", ctx, logger, request)}
 }
 
-func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Run(run func(logger entities.Logger, request datatypes.LocalSessionDetails)) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
+func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Run(run func(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails)) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 entities.Logger
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(entities.Logger)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 datatypes.LocalSessionDetails
+		var arg1 entities.Logger
 		if args[1] != nil {
-			arg1 = args[1].(datatypes.LocalSessionDetails)
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 datatypes.LocalSessionDetails
+		if args[2] != nil {
+			arg2 = args[2].(datatypes.LocalSessionDetails)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Return(connectionDetails embeddedconnector.ConnectionDetails, fn func() error, err error) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
-	_c.Call.Return(connectionDetails, fn, err)
+func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) Return(localSession datatypes.LocalSession, fn func() error, err error) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
+	_c.Call.Return(localSession, fn, err)
 	return _c
 }
 
-func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) RunAndReturn(run func(logger entities.Logger, request datatypes.LocalSessionDetails) (embeddedconnector.ConnectionDetails, func() error, error)) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
+func (_c *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, request datatypes.LocalSessionDetails) (datatypes.LocalSession, func() error, error)) *MockLocalMATLABSessionLauncher_StartLocalMATLABSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -37,37 +37,53 @@ func (_m *MockMATLABProcessLauncher) EXPECT() *MockMATLABProcessLauncher_Expecte
 }
 
 // Launch provides a mock function for the type MockMATLABProcessLauncher
-func (_mock *MockMATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), error) {
-	ret := _mock.Called(logger, sessionRoot, matlabRoot, workingDir, args, env)
+func (_mock *MockMATLABProcessLauncher) Launch(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string, keepStdinOpen bool) (int, *entities.MATLABProcessMonitor, func(), func(), error) {
+	ret := _mock.Called(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Launch")
 	}
 
 	var r0 int
-	var r1 func()
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, []string, []string) (int, func(), error)); ok {
-		return returnFunc(logger, sessionRoot, matlabRoot, workingDir, args, env)
+	var r1 *entities.MATLABProcessMonitor
+	var r2 func()
+	var r3 func()
+	var r4 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, string, []string, []string, bool) (int, *entities.MATLABProcessMonitor, func(), func(), error)); ok {
+		return returnFunc(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
 	}
-	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, []string, []string) int); ok {
-		r0 = returnFunc(logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger, string, string, string, string, []string, []string, bool) int); ok {
+		r0 = returnFunc(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(entities.Logger, string, string, string, []string, []string) func()); ok {
-		r1 = returnFunc(logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger, string, string, string, string, []string, []string, bool) *entities.MATLABProcessMonitor); ok {
+		r1 = returnFunc(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(func())
+			r1 = ret.Get(1).(*entities.MATLABProcessMonitor)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(entities.Logger, string, string, string, []string, []string) error); ok {
-		r2 = returnFunc(logger, sessionRoot, matlabRoot, workingDir, args, env)
+	if returnFunc, ok := ret.Get(2).(func(entities.Logger, string, string, string, string, []string, []string, bool) func()); ok {
+		r2 = returnFunc(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(func())
+		}
+	}
+	if returnFunc, ok := ret.Get(3).(func(entities.Logger, string, string, string, string, []string, []string, bool) func()); ok {
+		r3 = returnFunc(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
+	} else {
+		if ret.Get(3) != nil {
+			r3 = ret.Get(3).(func())
+		}
 	}
-	return r0, r1, r2
+	if returnFunc, ok := ret.Get(4).(func(entities.Logger, string, string, string, string, []string, []string, bool) error); ok {
+		r4 = returnFunc(logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)
+	} else {
+		r4 = ret.Error(4)
+	}
+	return r0, r1, r2, r3, r4
 }
 
 // MockMATLABProcessLauncher_Launch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Launch'
@@ -79,14 +95,16 @@ type MockMATLABProcessLauncher_Launch_Call struct {
 //   - logger entities.Logger
 //   - sessionRoot string
 //   - matlabRoot string
+//   - vmcRoot string
 //   - workingDir string
 //   - args []string
 //   - env []string
-func (_e *MockMATLABProcessLauncher_Expecter) Launch(logger interface{}, sessionRoot interface{}, matlabRoot interface{}, workingDir interface{}, args interface{}, env interface{}) *MockMATLABProcessLauncher_Launch_Call {
-	return &MockMATLABProcessLauncher_Launch_Call{Call: _e.mock.On("Launch", logger, sessionRoot, matlabRoot, workingDir, args, env)}
+//   - keepStdinOpen bool
+func (_e *MockMATLABProcessLauncher_Expecter) Launch(logger interface{}, sessionRoot interface{}, matlabRoot interface{}, vmcRoot interface{}, workingDir interface{}, args interface{}, env interface{}, keepStdinOpen interface{}) *MockMATLABProcessLauncher_Launch_Call {
+	return &MockMATLABProcessLauncher_Launch_Call{Call: _e.mock.On("Launch", logger, sessionRoot, matlabRoot, vmcRoot, workingDir, args, env, keepStdinOpen)}
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string)) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string, keepStdinOpen bool)) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 entities.Logger
 		if args[0] != nil {
@@ -104,14 +122,22 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(logger entities.Lo
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
-		var arg4 []string
+		var arg4 string
 		if args[4] != nil {
-			arg4 = args[4].([]string)
+			arg4 = args[4].(string)
 		}
 		var arg5 []string
 		if args[5] != nil {
 			arg5 = args[5].([]string)
 		}
+		var arg6 []string
+		if args[6] != nil {
+			arg6 = args[6].([]string)
+		}
+		var arg7 bool
+		if args[7] != nil {
+			arg7 = args[7].(bool)
+		}
 		run(
 			arg0,
 			arg1,
@@ -119,17 +145,19 @@ func (_c *MockMATLABProcessLauncher_Launch_Call) Run(run func(logger entities.Lo
 			arg3,
 			arg4,
 			arg5,
+			arg6,
+			arg7,
 		)
 	})
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, fn func(), err error) *MockMATLABProcessLauncher_Launch_Call {
-	_c.Call.Return(n, fn, err)
+func (_c *MockMATLABProcessLauncher_Launch_Call) Return(n int, mATLABProcessMonitor *entities.MATLABProcessMonitor, fn func(), fn1 func(), err error) *MockMATLABProcessLauncher_Launch_Call {
+	_c.Call.Return(n, mATLABProcessMonitor, fn, fn1, err)
 	return _c
 }
 
-func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(logger entities.Logger, sessionRoot string, matlabRoot string, workingDir string, args []string, env []string) (int, func(), error)) *MockMATLABProcessLauncher_Launch_Call {
+func (_c *MockMATLABProcessLauncher_Launch_Call) RunAndReturn(run func(logger entities.Logger, sessionRoot string, matlabRoot string, vmcRoot string, workingDir string, args []string, env []string, keepStdinOpen bool) (int, *entities.MATLABProcessMonitor, func(), func(), error)) *MockMATLABProcessLauncher_Launch_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -36,16 +36,16 @@ func (_m *MockProcessDetails) EXPECT() *MockProcessDetails_Expecter {
 }
 
 // EnvironmentVariables provides a mock function for the type MockProcessDetails
-func (_mock *MockProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string {
-	ret := _mock.Called(sessionDirPath, apiKey, certificateFile, certificateKey)
+func (_mock *MockProcessDetails) EnvironmentVariables(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, display string) []string {
+	ret := _mock.Called(sessionDirPath, apiKey, certificateFile, certificateKey, display)
 
 	if len(ret) == 0 {
 		panic("no return value specified for EnvironmentVariables")
 	}
 
 	var r0 []string
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) []string); ok {
-		r0 = returnFunc(sessionDirPath, apiKey, certificateFile, certificateKey)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string) []string); ok {
+		r0 = returnFunc(sessionDirPath, apiKey, certificateFile, certificateKey, display)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -64,11 +64,12 @@ type MockProcessDetails_EnvironmentVariables_Call struct {
 //   - apiKey string
 //   - certificateFile string
 //   - certificateKey string
-func (_e *MockProcessDetails_Expecter) EnvironmentVariables(sessionDirPath interface{}, apiKey interface{}, certificateFile interface{}, certificateKey interface{}) *MockProcessDetails_EnvironmentVariables_Call {
-	return &MockProcessDetails_EnvironmentVariables_Call{Call: _e.mock.On("EnvironmentVariables", sessionDirPath, apiKey, certificateFile, certificateKey)}
+//   - display string
+func (_e *MockProcessDetails_Expecter) EnvironmentVariables(sessionDirPath interface{}, apiKey interface{}, certificateFile interface{}, certificateKey interface{}, display interface{}) *MockProcessDetails_EnvironmentVariables_Call {
+	return &MockProcessDetails_EnvironmentVariables_Call{Call: _e.mock.On("EnvironmentVariables", sessionDirPath, apiKey, certificateFile, certificateKey, display)}
 }
 
-func (_c *MockProcessDetails_EnvironmentVariables_Call) Run(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string)) *MockProcessDetails_EnvironmentVariables_Call {
+func (_c *MockProcessDetails_EnvironmentVariables_Call) Run(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, display string)) *MockProcessDetails_EnvironmentVariables_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -86,11 +87,16 @@ func (_c *MockProcessDetails_EnvironmentVariables_Call) Run(run func(sessionDirP
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -101,7 +107,7 @@ func (_c *MockProcessDetails_EnvironmentVariables_Call) Return(strings []string)
 	return _c
 }
 
-func (_c *MockProcessDetails_EnvironmentVariables_Call) RunAndReturn(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string) []string) *MockProcessDetails_EnvironmentVariables_Call {
+func (_c *MockProcessDetails_EnvironmentVariables_Call) RunAndReturn(run func(sessionDirPath string, apiKey string, certificateFile string, certificateKey string, display string) []string) *MockProcessDetails_EnvironmentVariables_Call {
 	_c.Call.Return(run)
 	return _c
 }
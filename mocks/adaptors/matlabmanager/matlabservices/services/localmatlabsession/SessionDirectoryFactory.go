@@ -98,3 +98,118 @@ func (_c *MockSessionDirectoryFactory_Create_Call) RunAndReturn(run func(logger
 	_c.Call.Return(run)
 	return _c
 }
+
+// FindRecorded provides a mock function for the type MockSessionDirectoryFactory
+func (_mock *MockSessionDirectoryFactory) FindRecorded(logger entities.Logger) ([]directorymanager.Directory, error) {
+	ret := _mock.Called(logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecorded")
+	}
+
+	var r0 []directorymanager.Directory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) ([]directorymanager.Directory, error)); ok {
+		return returnFunc(logger)
+	}
+	if returnFunc, ok := ret.Get(0).(func(entities.Logger) []directorymanager.Directory); ok {
+		r0 = returnFunc(logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]directorymanager.Directory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(entities.Logger) error); ok {
+		r1 = returnFunc(logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionDirectoryFactory_FindRecorded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecorded'
+type MockSessionDirectoryFactory_FindRecorded_Call struct {
+	*mock.Call
+}
+
+// FindRecorded is a helper method to define mock.On call
+//   - logger entities.Logger
+func (_e *MockSessionDirectoryFactory_Expecter) FindRecorded(logger interface{}) *MockSessionDirectoryFactory_FindRecorded_Call {
+	return &MockSessionDirectoryFactory_FindRecorded_Call{Call: _e.mock.On("FindRecorded", logger)}
+}
+
+func (_c *MockSessionDirectoryFactory_FindRecorded_Call) Run(run func(logger entities.Logger)) *MockSessionDirectoryFactory_FindRecorded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 entities.Logger
+		if args[0] != nil {
+			arg0 = args[0].(entities.Logger)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionDirectoryFactory_FindRecorded_Call) Return(directorys []directorymanager.Directory, err error) *MockSessionDirectoryFactory_FindRecorded_Call {
+	_c.Call.Return(directorys, err)
+	return _c
+}
+
+func (_c *MockSessionDirectoryFactory_FindRecorded_Call) RunAndReturn(run func(logger entities.Logger) ([]directorymanager.Directory, error)) *MockSessionDirectoryFactory_FindRecorded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function for the type MockSessionDirectoryFactory
+func (_mock *MockSessionDirectoryFactory) Open(sessionDir string) directorymanager.Directory {
+	ret := _mock.Called(sessionDir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 directorymanager.Directory
+	if returnFunc, ok := ret.Get(0).(func(string) directorymanager.Directory); ok {
+		r0 = returnFunc(sessionDir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(directorymanager.Directory)
+		}
+	}
+	return r0
+}
+
+// MockSessionDirectoryFactory_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockSessionDirectoryFactory_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - sessionDir string
+func (_e *MockSessionDirectoryFactory_Expecter) Open(sessionDir interface{}) *MockSessionDirectoryFactory_Open_Call {
+	return &MockSessionDirectoryFactory_Open_Call{Call: _e.mock.On("Open", sessionDir)}
+}
+
+func (_c *MockSessionDirectoryFactory_Open_Call) Run(run func(sessionDir string)) *MockSessionDirectoryFactory_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionDirectoryFactory_Open_Call) Return(directory directorymanager.Directory) *MockSessionDirectoryFactory_Open_Call {
+	_c.Call.Return(directory)
+	return _c
+}
+
+func (_c *MockSessionDirectoryFactory_Open_Call) RunAndReturn(run func(sessionDir string) directorymanager.Directory) *MockSessionDirectoryFactory_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -35,6 +35,57 @@ func (_m *MockWatchdog) EXPECT() *MockWatchdog_Expecter {
 	return &MockWatchdog_Expecter{mock: &_m.Mock}
 }
 
+// RegisterPersistentProcessPIDWithWatchdog provides a mock function for the type MockWatchdog
+func (_mock *MockWatchdog) RegisterPersistentProcessPIDWithWatchdog(processPID int) error {
+	ret := _mock.Called(processPID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterPersistentProcessPIDWithWatchdog")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(processPID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterPersistentProcessPIDWithWatchdog'
+type MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call struct {
+	*mock.Call
+}
+
+// RegisterPersistentProcessPIDWithWatchdog is a helper method to define mock.On call
+//   - processPID int
+func (_e *MockWatchdog_Expecter) RegisterPersistentProcessPIDWithWatchdog(processPID interface{}) *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call {
+	return &MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call{Call: _e.mock.On("RegisterPersistentProcessPIDWithWatchdog", processPID)}
+}
+
+func (_c *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call) Run(run func(processPID int)) *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call) Return(err error) *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call) RunAndReturn(run func(processPID int) error) *MockWatchdog_RegisterPersistentProcessPIDWithWatchdog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterProcessPIDWithWatchdog provides a mock function for the type MockWatchdog
 func (_mock *MockWatchdog) RegisterProcessPIDWithWatchdog(processPID int) error {
 	ret := _mock.Called(processPID)
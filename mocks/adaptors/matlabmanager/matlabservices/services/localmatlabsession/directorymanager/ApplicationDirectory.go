@@ -35,6 +35,50 @@ func (_m *MockApplicationDirectory) EXPECT() *MockApplicationDirectory_Expecter
 	return &MockApplicationDirectory_Expecter{mock: &_m.Mock}
 }
 
+// BaseDir provides a mock function for the type MockApplicationDirectory
+func (_mock *MockApplicationDirectory) BaseDir() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BaseDir")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockApplicationDirectory_BaseDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BaseDir'
+type MockApplicationDirectory_BaseDir_Call struct {
+	*mock.Call
+}
+
+// BaseDir is a helper method to define mock.On call
+func (_e *MockApplicationDirectory_Expecter) BaseDir() *MockApplicationDirectory_BaseDir_Call {
+	return &MockApplicationDirectory_BaseDir_Call{Call: _e.mock.On("BaseDir")}
+}
+
+func (_c *MockApplicationDirectory_BaseDir_Call) Run(run func()) *MockApplicationDirectory_BaseDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplicationDirectory_BaseDir_Call) Return(s string) *MockApplicationDirectory_BaseDir_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockApplicationDirectory_BaseDir_Call) RunAndReturn(run func() string) *MockApplicationDirectory_BaseDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSubDir provides a mock function for the type MockApplicationDirectory
 func (_mock *MockApplicationDirectory) CreateSubDir(pattern string) (string, error) {
 	ret := _mock.Called(pattern)
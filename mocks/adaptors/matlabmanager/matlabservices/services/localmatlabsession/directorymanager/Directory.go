@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/matlabmanager/matlabservices/services/localmatlabsession/directorymanager"
+	"github.com/matlab/matlab-mcp-core-server/internal/entities"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -168,8 +170,8 @@ func (_c *MockDirectory_Cleanup_Call) RunAndReturn(run func() error) *MockDirect
 }
 
 // GetEmbeddedConnectorDetails provides a mock function for the type MockDirectory
-func (_mock *MockDirectory) GetEmbeddedConnectorDetails() (string, []byte, error) {
-	ret := _mock.Called()
+func (_mock *MockDirectory) GetEmbeddedConnectorDetails(processMonitor *entities.MATLABProcessMonitor) (string, []byte, error) {
+	ret := _mock.Called(processMonitor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetEmbeddedConnectorDetails")
@@ -178,23 +180,23 @@ func (_mock *MockDirectory) GetEmbeddedConnectorDetails() (string, []byte, error
 	var r0 string
 	var r1 []byte
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func() (string, []byte, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(*entities.MATLABProcessMonitor) (string, []byte, error)); ok {
+		return returnFunc(processMonitor)
 	}
-	if returnFunc, ok := ret.Get(0).(func() string); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(*entities.MATLABProcessMonitor) string); ok {
+		r0 = returnFunc(processMonitor)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func() []byte); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(*entities.MATLABProcessMonitor) []byte); ok {
+		r1 = returnFunc(processMonitor)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).([]byte)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func() error); ok {
-		r2 = returnFunc()
+	if returnFunc, ok := ret.Get(2).(func(*entities.MATLABProcessMonitor) error); ok {
+		r2 = returnFunc(processMonitor)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -207,13 +209,20 @@ type MockDirectory_GetEmbeddedConnectorDetails_Call struct {
 }
 
 // GetEmbeddedConnectorDetails is a helper method to define mock.On call
-func (_e *MockDirectory_Expecter) GetEmbeddedConnectorDetails() *MockDirectory_GetEmbeddedConnectorDetails_Call {
-	return &MockDirectory_GetEmbeddedConnectorDetails_Call{Call: _e.mock.On("GetEmbeddedConnectorDetails")}
+//   - processMonitor *entities.MATLABProcessMonitor
+func (_e *MockDirectory_Expecter) GetEmbeddedConnectorDetails(processMonitor interface{}) *MockDirectory_GetEmbeddedConnectorDetails_Call {
+	return &MockDirectory_GetEmbeddedConnectorDetails_Call{Call: _e.mock.On("GetEmbeddedConnectorDetails", processMonitor)}
 }
 
-func (_c *MockDirectory_GetEmbeddedConnectorDetails_Call) Run(run func()) *MockDirectory_GetEmbeddedConnectorDetails_Call {
+func (_c *MockDirectory_GetEmbeddedConnectorDetails_Call) Run(run func(processMonitor *entities.MATLABProcessMonitor)) *MockDirectory_GetEmbeddedConnectorDetails_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 *entities.MATLABProcessMonitor
+		if args[0] != nil {
+			arg0 = args[0].(*entities.MATLABProcessMonitor)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -223,7 +232,7 @@ func (_c *MockDirectory_GetEmbeddedConnectorDetails_Call) Return(s string, bytes
 	return _c
 }
 
-func (_c *MockDirectory_GetEmbeddedConnectorDetails_Call) RunAndReturn(run func() (string, []byte, error)) *MockDirectory_GetEmbeddedConnectorDetails_Call {
+func (_c *MockDirectory_GetEmbeddedConnectorDetails_Call) RunAndReturn(run func(processMonitor *entities.MATLABProcessMonitor) (string, []byte, error)) *MockDirectory_GetEmbeddedConnectorDetails_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -271,3 +280,115 @@ func (_c *MockDirectory_Path_Call) RunAndReturn(run func() string) *MockDirector
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReadSessionRecord provides a mock function for the type MockDirectory
+func (_mock *MockDirectory) ReadSessionRecord() (directorymanager.SessionRecord, []byte, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSessionRecord")
+	}
+
+	var r0 directorymanager.SessionRecord
+	var r1 []byte
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func() (directorymanager.SessionRecord, []byte, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() directorymanager.SessionRecord); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(directorymanager.SessionRecord)
+	}
+	if returnFunc, ok := ret.Get(1).(func() []byte); ok {
+		r1 = returnFunc()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func() error); ok {
+		r2 = returnFunc()
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockDirectory_ReadSessionRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSessionRecord'
+type MockDirectory_ReadSessionRecord_Call struct {
+	*mock.Call
+}
+
+// ReadSessionRecord is a helper method to define mock.On call
+func (_e *MockDirectory_Expecter) ReadSessionRecord() *MockDirectory_ReadSessionRecord_Call {
+	return &MockDirectory_ReadSessionRecord_Call{Call: _e.mock.On("ReadSessionRecord")}
+}
+
+func (_c *MockDirectory_ReadSessionRecord_Call) Run(run func()) *MockDirectory_ReadSessionRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDirectory_ReadSessionRecord_Call) Return(sessionRecord directorymanager.SessionRecord, bytes []byte, err error) *MockDirectory_ReadSessionRecord_Call {
+	_c.Call.Return(sessionRecord, bytes, err)
+	return _c
+}
+
+func (_c *MockDirectory_ReadSessionRecord_Call) RunAndReturn(run func() (directorymanager.SessionRecord, []byte, error)) *MockDirectory_ReadSessionRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteSessionRecord provides a mock function for the type MockDirectory
+func (_mock *MockDirectory) WriteSessionRecord(record directorymanager.SessionRecord) error {
+	ret := _mock.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteSessionRecord")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(directorymanager.SessionRecord) error); ok {
+		r0 = returnFunc(record)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDirectory_WriteSessionRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteSessionRecord'
+type MockDirectory_WriteSessionRecord_Call struct {
+	*mock.Call
+}
+
+// WriteSessionRecord is a helper method to define mock.On call
+//   - record directorymanager.SessionRecord
+func (_e *MockDirectory_Expecter) WriteSessionRecord(record interface{}) *MockDirectory_WriteSessionRecord_Call {
+	return &MockDirectory_WriteSessionRecord_Call{Call: _e.mock.On("WriteSessionRecord", record)}
+}
+
+func (_c *MockDirectory_WriteSessionRecord_Call) Run(run func(record directorymanager.SessionRecord)) *MockDirectory_WriteSessionRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 directorymanager.SessionRecord
+		if args[0] != nil {
+			arg0 = args[0].(directorymanager.SessionRecord)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDirectory_WriteSessionRecord_Call) Return(err error) *MockDirectory_WriteSessionRecord_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDirectory_WriteSessionRecord_Call) RunAndReturn(run func(record directorymanager.SessionRecord) error) *MockDirectory_WriteSessionRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/prompts"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/resources"
 	"github.com/matlab/matlab-mcp-core-server/internal/adaptors/mcp/tools"
 	mock "github.com/stretchr/testify/mock"
@@ -37,6 +38,52 @@ func (_m *MockMCPServerConfigurator) EXPECT() *MockMCPServerConfigurator_Expecte
 	return &MockMCPServerConfigurator_Expecter{mock: &_m.Mock}
 }
 
+// GetPromptsToAdd provides a mock function for the type MockMCPServerConfigurator
+func (_mock *MockMCPServerConfigurator) GetPromptsToAdd() []prompts.Prompt {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromptsToAdd")
+	}
+
+	var r0 []prompts.Prompt
+	if returnFunc, ok := ret.Get(0).(func() []prompts.Prompt); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]prompts.Prompt)
+		}
+	}
+	return r0
+}
+
+// MockMCPServerConfigurator_GetPromptsToAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromptsToAdd'
+type MockMCPServerConfigurator_GetPromptsToAdd_Call struct {
+	*mock.Call
+}
+
+// GetPromptsToAdd is a helper method to define mock.On call
+func (_e *MockMCPServerConfigurator_Expecter) GetPromptsToAdd() *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	return &MockMCPServerConfigurator_GetPromptsToAdd_Call{Call: _e.mock.On("GetPromptsToAdd")}
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) Run(run func()) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) Return(prompts1 []prompts.Prompt) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Return(prompts1)
+	return _c
+}
+
+func (_c *MockMCPServerConfigurator_GetPromptsToAdd_Call) RunAndReturn(run func() []prompts.Prompt) *MockMCPServerConfigurator_GetPromptsToAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResourcesToAdd provides a mock function for the type MockMCPServerConfigurator
 func (_mock *MockMCPServerConfigurator) GetResourcesToAdd() []resources.Resource {
 	ret := _mock.Called()
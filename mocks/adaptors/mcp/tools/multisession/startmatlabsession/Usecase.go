@@ -40,8 +40,8 @@ func (_m *MockUsecase) EXPECT() *MockUsecase_Expecter {
 }
 
 // Execute provides a mock function for the type MockUsecase
-func (_mock *MockUsecase) Execute(ctx context.Context, sessionLogger entities.Logger, request entities.SessionDetails) (startmatlabsession.ReturnArgs, error) {
-	ret := _mock.Called(ctx, sessionLogger, request)
+func (_mock *MockUsecase) Execute(ctx context.Context, sessionLogger entities.Logger, args startmatlabsession.Args) (startmatlabsession.ReturnArgs, error) {
+	ret := _mock.Called(ctx, sessionLogger, args)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
@@ -49,16 +49,16 @@ func (_mock *MockUsecase) Execute(ctx context.Context, sessionLogger entities.Lo
 
 	var r0 startmatlabsession.ReturnArgs
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionDetails) (startmatlabsession.ReturnArgs, error)); ok {
-		return returnFunc(ctx, sessionLogger, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, startmatlabsession.Args) (startmatlabsession.ReturnArgs, error)); ok {
+		return returnFunc(ctx, sessionLogger, args)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.SessionDetails) startmatlabsession.ReturnArgs); ok {
-		r0 = returnFunc(ctx, sessionLogger, request)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, startmatlabsession.Args) startmatlabsession.ReturnArgs); ok {
+		r0 = returnFunc(ctx, sessionLogger, args)
 	} else {
 		r0 = ret.Get(0).(startmatlabsession.ReturnArgs)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.SessionDetails) error); ok {
-		r1 = returnFunc(ctx, sessionLogger, request)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, startmatlabsession.Args) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, args)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,12 +73,12 @@ type MockUsecase_Execute_Call struct {
 // Execute is a helper method to define mock.On call
 //   - ctx context.Context
 //   - sessionLogger entities.Logger
-//   - request entities.SessionDetails
-func (_e *MockUsecase_Expecter) Execute(ctx interface{}, sessionLogger interface{}, request interface{}) *MockUsecase_Execute_Call {
-	return &MockUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, sessionLogger, request)}
+//   - args startmatlabsession.Args
+func (_e *MockUsecase_Expecter) Execute(ctx interface{}, sessionLogger interface{}, args interface{}) *MockUsecase_Execute_Call {
+	return &MockUsecase_Execute_Call{Call: _e.mock.On("Execute", ctx, sessionLogger, args)}
 }
 
-func (_c *MockUsecase_Execute_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, request entities.SessionDetails)) *MockUsecase_Execute_Call {
+func (_c *MockUsecase_Execute_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, args startmatlabsession.Args)) *MockUsecase_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,9 +88,9 @@ func (_c *MockUsecase_Execute_Call) Run(run func(ctx context.Context, sessionLog
 		if args[1] != nil {
 			arg1 = args[1].(entities.Logger)
 		}
-		var arg2 entities.SessionDetails
+		var arg2 startmatlabsession.Args
 		if args[2] != nil {
-			arg2 = args[2].(entities.SessionDetails)
+			arg2 = args[2].(startmatlabsession.Args)
 		}
 		run(
 			arg0,
@@ -106,7 +106,7 @@ func (_c *MockUsecase_Execute_Call) Return(returnArgs startmatlabsession.ReturnA
 	return _c
 }
 
-func (_c *MockUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, request entities.SessionDetails) (startmatlabsession.ReturnArgs, error)) *MockUsecase_Execute_Call {
+func (_c *MockUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, args startmatlabsession.Args) (startmatlabsession.ReturnArgs, error)) *MockUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,8 +39,8 @@ func (_m *MockGlobalMATLAB) EXPECT() *MockGlobalMATLAB_Expecter {
 }
 
 // Client provides a mock function for the type MockGlobalMATLAB
-func (_mock *MockGlobalMATLAB) Client(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error) {
-	ret := _mock.Called(ctx, logger)
+func (_mock *MockGlobalMATLAB) Client(ctx context.Context, logger entities.Logger, mcpSession entities.MCPSession) (entities.MATLABSessionClient, error) {
+	ret := _mock.Called(ctx, logger, mcpSession)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Client")
@@ -48,18 +48,18 @@ func (_mock *MockGlobalMATLAB) Client(ctx context.Context, logger entities.Logge
 
 	var r0 entities.MATLABSessionClient
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) (entities.MATLABSessionClient, error)); ok {
-		return returnFunc(ctx, logger)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MCPSession) (entities.MATLABSessionClient, error)); ok {
+		return returnFunc(ctx, logger, mcpSession)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) entities.MATLABSessionClient); ok {
-		r0 = returnFunc(ctx, logger)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, entities.MCPSession) entities.MATLABSessionClient); ok {
+		r0 = returnFunc(ctx, logger, mcpSession)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(entities.MATLABSessionClient)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger) error); ok {
-		r1 = returnFunc(ctx, logger)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, entities.MCPSession) error); ok {
+		r1 = returnFunc(ctx, logger, mcpSession)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,11 +74,12 @@ type MockGlobalMATLAB_Client_Call struct {
 // Client is a helper method to define mock.On call
 //   - ctx context.Context
 //   - logger entities.Logger
-func (_e *MockGlobalMATLAB_Expecter) Client(ctx interface{}, logger interface{}) *MockGlobalMATLAB_Client_Call {
-	return &MockGlobalMATLAB_Client_Call{Call: _e.mock.On("Client", ctx, logger)}
+//   - mcpSession entities.MCPSession
+func (_e *MockGlobalMATLAB_Expecter) Client(ctx interface{}, logger interface{}, mcpSession interface{}) *MockGlobalMATLAB_Client_Call {
+	return &MockGlobalMATLAB_Client_Call{Call: _e.mock.On("Client", ctx, logger, mcpSession)}
 }
 
-func (_c *MockGlobalMATLAB_Client_Call) Run(run func(ctx context.Context, logger entities.Logger)) *MockGlobalMATLAB_Client_Call {
+func (_c *MockGlobalMATLAB_Client_Call) Run(run func(ctx context.Context, logger entities.Logger, mcpSession entities.MCPSession)) *MockGlobalMATLAB_Client_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,9 +89,14 @@ func (_c *MockGlobalMATLAB_Client_Call) Run(run func(ctx context.Context, logger
 		if args[1] != nil {
 			arg1 = args[1].(entities.Logger)
 		}
+		var arg2 entities.MCPSession
+		if args[2] != nil {
+			arg2 = args[2].(entities.MCPSession)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -101,7 +107,7 @@ func (_c *MockGlobalMATLAB_Client_Call) Return(mATLABSessionClient entities.MATL
 	return _c
 }
 
-func (_c *MockGlobalMATLAB_Client_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger) (entities.MATLABSessionClient, error)) *MockGlobalMATLAB_Client_Call {
+func (_c *MockGlobalMATLAB_Client_Call) RunAndReturn(run func(ctx context.Context, logger entities.Logger, mcpSession entities.MCPSession) (entities.MATLABSessionClient, error)) *MockGlobalMATLAB_Client_Call {
 	_c.Call.Return(run)
 	return _c
 }
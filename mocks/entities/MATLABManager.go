@@ -171,6 +171,65 @@ func (_c *MockMATLABManager_ListEnvironments_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListMATLABSessions provides a mock function for the type MockMATLABManager
+func (_mock *MockMATLABManager) ListMATLABSessions(ctx context.Context, sessionLogger entities.Logger) []entities.MATLABSessionInfo {
+	ret := _mock.Called(ctx, sessionLogger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMATLABSessions")
+	}
+
+	var r0 []entities.MATLABSessionInfo
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger) []entities.MATLABSessionInfo); ok {
+		r0 = returnFunc(ctx, sessionLogger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.MATLABSessionInfo)
+		}
+	}
+	return r0
+}
+
+// MockMATLABManager_ListMATLABSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMATLABSessions'
+type MockMATLABManager_ListMATLABSessions_Call struct {
+	*mock.Call
+}
+
+// ListMATLABSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+func (_e *MockMATLABManager_Expecter) ListMATLABSessions(ctx interface{}, sessionLogger interface{}) *MockMATLABManager_ListMATLABSessions_Call {
+	return &MockMATLABManager_ListMATLABSessions_Call{Call: _e.mock.On("ListMATLABSessions", ctx, sessionLogger)}
+}
+
+func (_c *MockMATLABManager_ListMATLABSessions_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger)) *MockMATLABManager_ListMATLABSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABManager_ListMATLABSessions_Call) Return(mATLABSessionInfos []entities.MATLABSessionInfo) *MockMATLABManager_ListMATLABSessions_Call {
+	_c.Call.Return(mATLABSessionInfos)
+	return _c
+}
+
+func (_c *MockMATLABManager_ListMATLABSessions_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger) []entities.MATLABSessionInfo) *MockMATLABManager_ListMATLABSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartMATLABSession provides a mock function for the type MockMATLABManager
 func (_mock *MockMATLABManager) StartMATLABSession(ctx context.Context, sessionLogger entities.Logger, startRequest entities.SessionDetails) (entities.SessionID, error) {
 	ret := _mock.Called(ctx, sessionLogger, startRequest)
@@ -38,6 +38,80 @@ func (_m *MockMATLABSessionClient) EXPECT() *MockMATLABSessionClient_Expecter {
 	return &MockMATLABSessionClient_Expecter{mock: &_m.Mock}
 }
 
+// Batch provides a mock function for the type MockMATLABSessionClient
+func (_mock *MockMATLABSessionClient) Batch(ctx context.Context, sessionLogger entities.Logger, requests []entities.BatchRequest) ([]entities.BatchResponse, error) {
+	ret := _mock.Called(ctx, sessionLogger, requests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 []entities.BatchResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, []entities.BatchRequest) ([]entities.BatchResponse, error)); ok {
+		return returnFunc(ctx, sessionLogger, requests)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.Logger, []entities.BatchRequest) []entities.BatchResponse); ok {
+		r0 = returnFunc(ctx, sessionLogger, requests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.BatchResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.Logger, []entities.BatchRequest) error); ok {
+		r1 = returnFunc(ctx, sessionLogger, requests)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMATLABSessionClient_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type MockMATLABSessionClient_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionLogger entities.Logger
+//   - requests []entities.BatchRequest
+func (_e *MockMATLABSessionClient_Expecter) Batch(ctx interface{}, sessionLogger interface{}, requests interface{}) *MockMATLABSessionClient_Batch_Call {
+	return &MockMATLABSessionClient_Batch_Call{Call: _e.mock.On("Batch", ctx, sessionLogger, requests)}
+}
+
+func (_c *MockMATLABSessionClient_Batch_Call) Run(run func(ctx context.Context, sessionLogger entities.Logger, requests []entities.BatchRequest)) *MockMATLABSessionClient_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.Logger
+		if args[1] != nil {
+			arg1 = args[1].(entities.Logger)
+		}
+		var arg2 []entities.BatchRequest
+		if args[2] != nil {
+			arg2 = args[2].([]entities.BatchRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMATLABSessionClient_Batch_Call) Return(batchResponses []entities.BatchResponse, err error) *MockMATLABSessionClient_Batch_Call {
+	_c.Call.Return(batchResponses, err)
+	return _c
+}
+
+func (_c *MockMATLABSessionClient_Batch_Call) RunAndReturn(run func(ctx context.Context, sessionLogger entities.Logger, requests []entities.BatchRequest) ([]entities.BatchResponse, error)) *MockMATLABSessionClient_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Eval provides a mock function for the type MockMATLABSessionClient
 func (_mock *MockMATLABSessionClient) Eval(ctx context.Context, sessionLogger entities.Logger, request entities.EvalRequest) (entities.EvalResponse, error) {
 	ret := _mock.Called(ctx, sessionLogger, request)
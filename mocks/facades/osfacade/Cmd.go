@@ -38,6 +38,170 @@ func (_m *MockCmd) EXPECT() *MockCmd_Expecter {
 	return &MockCmd_Expecter{mock: &_m.Mock}
 }
 
+// Run provides a mock function for the type MockCmd
+func (_mock *MockCmd) Run() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCmd_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockCmd_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+func (_e *MockCmd_Expecter) Run() *MockCmd_Run_Call {
+	return &MockCmd_Run_Call{Call: _e.mock.On("Run")}
+}
+
+func (_c *MockCmd_Run_Call) Run(run func()) *MockCmd_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCmd_Run_Call) Return(err error) *MockCmd_Run_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCmd_Run_Call) RunAndReturn(run func() error) *MockCmd_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnv provides a mock function for the type MockCmd
+func (_mock *MockCmd) SetEnv(env []string) {
+	_mock.Called(env)
+	return
+}
+
+// MockCmd_SetEnv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnv'
+type MockCmd_SetEnv_Call struct {
+	*mock.Call
+}
+
+// SetEnv is a helper method to define mock.On call
+//   - env []string
+func (_e *MockCmd_Expecter) SetEnv(env interface{}) *MockCmd_SetEnv_Call {
+	return &MockCmd_SetEnv_Call{Call: _e.mock.On("SetEnv", env)}
+}
+
+func (_c *MockCmd_SetEnv_Call) Run(run func(env []string)) *MockCmd_SetEnv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCmd_SetEnv_Call) Return() *MockCmd_SetEnv_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCmd_SetEnv_Call) RunAndReturn(run func(env []string)) *MockCmd_SetEnv_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetStderr provides a mock function for the type MockCmd
+func (_mock *MockCmd) SetStderr(stderr io.Writer) {
+	_mock.Called(stderr)
+	return
+}
+
+// MockCmd_SetStderr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStderr'
+type MockCmd_SetStderr_Call struct {
+	*mock.Call
+}
+
+// SetStderr is a helper method to define mock.On call
+//   - stderr io.Writer
+func (_e *MockCmd_Expecter) SetStderr(stderr interface{}) *MockCmd_SetStderr_Call {
+	return &MockCmd_SetStderr_Call{Call: _e.mock.On("SetStderr", stderr)}
+}
+
+func (_c *MockCmd_SetStderr_Call) Run(run func(stderr io.Writer)) *MockCmd_SetStderr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 io.Writer
+		if args[0] != nil {
+			arg0 = args[0].(io.Writer)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCmd_SetStderr_Call) Return() *MockCmd_SetStderr_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCmd_SetStderr_Call) RunAndReturn(run func(stderr io.Writer)) *MockCmd_SetStderr_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetStdout provides a mock function for the type MockCmd
+func (_mock *MockCmd) SetStdout(stdout io.Writer) {
+	_mock.Called(stdout)
+	return
+}
+
+// MockCmd_SetStdout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStdout'
+type MockCmd_SetStdout_Call struct {
+	*mock.Call
+}
+
+// SetStdout is a helper method to define mock.On call
+//   - stdout io.Writer
+func (_e *MockCmd_Expecter) SetStdout(stdout interface{}) *MockCmd_SetStdout_Call {
+	return &MockCmd_SetStdout_Call{Call: _e.mock.On("SetStdout", stdout)}
+}
+
+func (_c *MockCmd_SetStdout_Call) Run(run func(stdout io.Writer)) *MockCmd_SetStdout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 io.Writer
+		if args[0] != nil {
+			arg0 = args[0].(io.Writer)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCmd_SetStdout_Call) Return() *MockCmd_SetStdout_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCmd_SetStdout_Call) RunAndReturn(run func(stdout io.Writer)) *MockCmd_SetStdout_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SetSysProcAttr provides a mock function for the type MockCmd
 func (_mock *MockCmd) SetSysProcAttr(attr *syscall.SysProcAttr) {
 	_mock.Called(attr)
@@ -5,6 +5,8 @@
 package mocks
 
 import (
+	"context"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -36,8 +38,8 @@ func (_m *MockPathValidator) EXPECT() *MockPathValidator_Expecter {
 }
 
 // ValidateMATLABScript provides a mock function for the type MockPathValidator
-func (_mock *MockPathValidator) ValidateMATLABScript(filePath string) (string, error) {
-	ret := _mock.Called(filePath)
+func (_mock *MockPathValidator) ValidateMATLABScript(ctx context.Context, filePath string) (string, error) {
+	ret := _mock.Called(ctx, filePath)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ValidateMATLABScript")
@@ -45,16 +47,16 @@ func (_mock *MockPathValidator) ValidateMATLABScript(filePath string) (string, e
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return returnFunc(filePath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, filePath)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(filePath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, filePath)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(filePath)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, filePath)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -67,19 +69,25 @@ type MockPathValidator_ValidateMATLABScript_Call struct {
 }
 
 // ValidateMATLABScript is a helper method to define mock.On call
+//   - ctx context.Context
 //   - filePath string
-func (_e *MockPathValidator_Expecter) ValidateMATLABScript(filePath interface{}) *MockPathValidator_ValidateMATLABScript_Call {
-	return &MockPathValidator_ValidateMATLABScript_Call{Call: _e.mock.On("ValidateMATLABScript", filePath)}
+func (_e *MockPathValidator_Expecter) ValidateMATLABScript(ctx interface{}, filePath interface{}) *MockPathValidator_ValidateMATLABScript_Call {
+	return &MockPathValidator_ValidateMATLABScript_Call{Call: _e.mock.On("ValidateMATLABScript", ctx, filePath)}
 }
 
-func (_c *MockPathValidator_ValidateMATLABScript_Call) Run(run func(filePath string)) *MockPathValidator_ValidateMATLABScript_Call {
+func (_c *MockPathValidator_ValidateMATLABScript_Call) Run(run func(ctx context.Context, filePath string)) *MockPathValidator_ValidateMATLABScript_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -90,7 +98,7 @@ func (_c *MockPathValidator_ValidateMATLABScript_Call) Return(s string, err erro
 	return _c
 }
 
-func (_c *MockPathValidator_ValidateMATLABScript_Call) RunAndReturn(run func(filePath string) (string, error)) *MockPathValidator_ValidateMATLABScript_Call {
+func (_c *MockPathValidator_ValidateMATLABScript_Call) RunAndReturn(run func(ctx context.Context, filePath string) (string, error)) *MockPathValidator_ValidateMATLABScript_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -36,6 +36,66 @@ func (_m *MockOSLayer) EXPECT() *MockOSLayer_Expecter {
 	return &MockOSLayer_Expecter{mock: &_m.Mock}
 }
 
+// EvalSymlinks provides a mock function for the type MockOSLayer
+func (_mock *MockOSLayer) EvalSymlinks(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvalSymlinks")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOSLayer_EvalSymlinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvalSymlinks'
+type MockOSLayer_EvalSymlinks_Call struct {
+	*mock.Call
+}
+
+// EvalSymlinks is a helper method to define mock.On call
+//   - path string
+func (_e *MockOSLayer_Expecter) EvalSymlinks(path interface{}) *MockOSLayer_EvalSymlinks_Call {
+	return &MockOSLayer_EvalSymlinks_Call{Call: _e.mock.On("EvalSymlinks", path)}
+}
+
+func (_c *MockOSLayer_EvalSymlinks_Call) Run(run func(path string)) *MockOSLayer_EvalSymlinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOSLayer_EvalSymlinks_Call) Return(s string, err error) *MockOSLayer_EvalSymlinks_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockOSLayer_EvalSymlinks_Call) RunAndReturn(run func(path string) (string, error)) *MockOSLayer_EvalSymlinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stat provides a mock function for the type MockOSLayer
 func (_mock *MockOSLayer) Stat(filePath string) (osfacade.FileInfo, error) {
 	ret := _mock.Called(filePath)
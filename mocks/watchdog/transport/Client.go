@@ -35,6 +35,57 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
+// SendPersistentProcessPID provides a mock function for the type MockClient
+func (_mock *MockClient) SendPersistentProcessPID(processPID int) error {
+	ret := _mock.Called(processPID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPersistentProcessPID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(processPID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_SendPersistentProcessPID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPersistentProcessPID'
+type MockClient_SendPersistentProcessPID_Call struct {
+	*mock.Call
+}
+
+// SendPersistentProcessPID is a helper method to define mock.On call
+//   - processPID int
+func (_e *MockClient_Expecter) SendPersistentProcessPID(processPID interface{}) *MockClient_SendPersistentProcessPID_Call {
+	return &MockClient_SendPersistentProcessPID_Call{Call: _e.mock.On("SendPersistentProcessPID", processPID)}
+}
+
+func (_c *MockClient_SendPersistentProcessPID_Call) Run(run func(processPID int)) *MockClient_SendPersistentProcessPID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_SendPersistentProcessPID_Call) Return(err error) *MockClient_SendPersistentProcessPID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_SendPersistentProcessPID_Call) RunAndReturn(run func(processPID int) error) *MockClient_SendPersistentProcessPID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendProcessPID provides a mock function for the type MockClient
 func (_mock *MockClient) SendProcessPID(processPID int) error {
 	ret := _mock.Called(processPID)